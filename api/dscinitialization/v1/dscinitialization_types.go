@@ -44,11 +44,22 @@ type DSCInitializationSpec struct {
 	// authentication giving a Single Sign On experience.
 	// +optional
 	ServiceMesh *infrav1.ServiceMeshSpec `json:"serviceMesh,omitempty"`
+	// Configures a platform Gateway of the Kubernetes Gateway API exposing the Dashboard and the KServe
+	// inference services through HTTPRoutes, instead of OpenShift Routes.
+	// +optional
+	Gateway *serviceApi.DSCIGateway `json:"gateway,omitempty"`
 	// When set to `Managed`, adds odh-trusted-ca-bundle Configmap to all namespaces that includes
 	// cluster-wide Trusted CA Bundle in .data["ca-bundle.crt"].
 	// Additionally, this fields allows admins to add custom CA bundles to the configmap using the .CustomCABundle field.
 	// +optional
 	TrustedCABundle *TrustedCABundleSpec `json:"trustedCABundle,omitempty"`
+	// Configures the namespaces and objects the workload admission webhooks of the operator
+	// (hardware profile, connection and Kueue webhooks) apply to.
+	// +optional
+	Webhooks *WebhooksSpec `json:"webhooks,omitempty"`
+	// Configures the TLS certificates issued by the operator, and their renewal.
+	// +optional
+	Certificates *CertificatesSpec `json:"certificates,omitempty"`
 	// Internal development useful field to test customizations.
 	// This is not recommended to be used in production environment.
 	// +optional
@@ -81,6 +92,95 @@ type TrustedCABundleSpec struct {
 	// ConfigMap .data.odh-ca-bundle.crt .
 	// +kubebuilder:default=""
 	CustomCABundle string `json:"customCABundle"`
+	// ConfigMaps and Secrets, in the operator namespace, holding CA certificates added to the custom CA bundle.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Sources []TrustedCABundleSource `json:"sources,omitempty"`
+	// Adds the CA certificates of the trustedCA ConfigMap of the cluster proxy to the custom CA bundle.
+	// +optional
+	IncludeProxyTrustedCA bool `json:"includeProxyTrustedCA,omitempty"`
+	// Label selector of the namespaces the trusted CA bundle is injected in, all namespaces when not set.
+	// The ConfigMaps of the namespaces no longer selected are deleted.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// TrustedCABundleSource references the key of a ConfigMap or of a Secret holding PEM encoded CA certificates.
+// +kubebuilder:validation:XValidation:rule="has(self.configMap) != has(self.secret)",message="exactly one of configMap or secret must be set"
+type TrustedCABundleSource struct {
+	// ConfigMap holding the CA certificates, under the ca-bundle.crt key unless set otherwise.
+	// +optional
+	ConfigMap *CABundleKeySelector `json:"configMap,omitempty"`
+	// Secret holding the CA certificates, under the ca.crt key unless set otherwise.
+	// +optional
+	Secret *CABundleKeySelector `json:"secret,omitempty"`
+}
+
+// CABundleKeySelector selects a key of a ConfigMap or of a Secret.
+type CABundleKeySelector struct {
+	// Name of the ConfigMap or Secret
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Key holding the PEM encoded CA certificates
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// WebhooksSpec defines the namespace and object selectors of the workload admission webhooks.
+type WebhooksSpec struct {
+	// Label key a namespace must have, set to "true", for the workload webhooks to apply to it.
+	// When empty, the workload webhooks apply to all namespaces that are not opted out or excluded.
+	// +optional
+	NamespaceOptInLabel string `json:"namespaceOptInLabel,omitempty"`
+	// Label key which, set to "true" on a namespace, opts the namespace out of the workload webhooks.
+	// Set on a workload, it opts the workload out of the mutating workload webhooks.
+	// Defaults to "opendatahub.io/webhooks-opt-out".
+	// +optional
+	OptOutLabel string `json:"optOutLabel,omitempty"`
+	// Namespaces the workload webhooks never apply to, in addition to the Kubernetes system namespaces.
+	// +optional
+	// +listType=set
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+}
+
+// KeyAlgorithm is the algorithm of the private keys of the certificates issued by the operator.
+type KeyAlgorithm string
+
+const (
+	RSAKeyAlgorithm   KeyAlgorithm = "RSA"
+	ECDSAKeyAlgorithm KeyAlgorithm = "ECDSA"
+)
+
+// CertificatesSpec defines the key algorithm, the validity and the renewal window of the certificates issued by
+// the operator, signed by its internal CA or by a cert-manager issuer.
+type CertificatesSpec struct {
+	// Algorithm of the private keys, RSA (2048 bits) or ECDSA (P-256).
+	// +kubebuilder:validation:Enum=RSA;ECDSA
+	// +kubebuilder:default=RSA
+	// +optional
+	KeyAlgorithm KeyAlgorithm `json:"keyAlgorithm,omitempty"`
+	// Validity of the issued certificates, defaults to 90 days.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// Window before the expiry of a certificate in which it is renewed, defaults to 30 days.
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+	// cert-manager issuer signing the certificates instead of the internal CA of the operator,
+	// used when the cert-manager Certificate CRD is present in the cluster.
+	// +optional
+	CertManagerIssuer *CertManagerIssuerReference `json:"certManagerIssuer,omitempty"`
+}
+
+// CertManagerIssuerReference references a cert-manager Issuer, in the namespace of the certificates, or ClusterIssuer.
+type CertManagerIssuerReference struct {
+	// Name of the issuer
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Kind of the issuer, Issuer or ClusterIssuer
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	// +kubebuilder:default=ClusterIssuer
+	// +optional
+	Kind string `json:"kind,omitempty"`
 }
 
 // DSCInitializationStatus defines the observed state of DSCInitialization.
@@ -101,6 +201,32 @@ type DSCInitializationStatus struct {
 
 	// Version and release type
 	Release common.Release `json:"release,omitempty"`
+
+	// Observed state of the custom CA bundle, when the trusted CA bundle is managed
+	// +optional
+	TrustedCABundle *TrustedCABundleStatus `json:"trustedCABundle,omitempty"`
+}
+
+// TrustedCABundleStatus describes the CA certificates of the custom CA bundle.
+type TrustedCABundleStatus struct {
+	// Number of distinct CA certificates in the bundle
+	Certificates int `json:"certificates"`
+	// CA certificates of the bundle which expired, or expire within 30 days
+	// +optional
+	ExpiringCertificates []TrustedCACertificate `json:"expiringCertificates,omitempty"`
+}
+
+// TrustedCACertificate identifies a CA certificate of the custom CA bundle.
+type TrustedCACertificate struct {
+	// Subject of the certificate
+	Subject string `json:"subject"`
+	// Source of the certificate: customCABundle, configmap/<name>, secret/<name> or proxy
+	Source string `json:"source"`
+	// Expiry time of the certificate
+	NotAfter metav1.Time `json:"notAfter"`
+	// Whether the certificate expired
+	// +optional
+	Expired bool `json:"expired,omitempty"`
 }
 
 // GetConditions returns the conditions slice
@@ -16,7 +16,7 @@ type CertificateSpec struct {
 	SecretName string `json:"secretName,omitempty"`
 	// Type specifies if the TLS certificate should be generated automatically, or if the certificate
 	// is provided by the user. Allowed values are:
-	// * SelfSigned: A certificate is going to be generated using an own private key.
+	// * SelfSigned: A certificate is going to be generated, signed by the internal CA of the operator.
 	// * Provided: Pre-existence of the TLS Secret (see SecretName) with a valid certificate is assumed.
 	// * OpenshiftDefaultIngress: Default ingress certificate configured for OpenShift
 	// +kubebuilder:validation:Enum=SelfSigned;Provided;OpenshiftDefaultIngress
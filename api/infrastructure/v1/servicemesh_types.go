@@ -2,11 +2,33 @@ package v1
 
 import operatorv1 "github.com/openshift/api/operator/v1"
 
+// ServiceMeshProvider is the implementation of the service mesh.
+type ServiceMeshProvider string
+
+const (
+	// MaistraProvider sets up OpenShift Service Mesh 2 with a ServiceMeshControlPlane.
+	MaistraProvider ServiceMeshProvider = "Maistra"
+	// SailProvider sets up OpenShift Service Mesh 3, or upstream Istio, with the Istio resource of the Sail operator.
+	SailProvider ServiceMeshProvider = "Sail"
+)
+
+// Data plane modes of the namespaces added to the mesh.
+const (
+	SidecarDataPlaneMode = "Sidecar"
+	AmbientDataPlaneMode = "Ambient"
+)
+
 // ServiceMeshSpec configures Service Mesh.
+// +kubebuilder:validation:XValidation:rule="!has(self.controlPlane) || !has(self.controlPlane.dataPlaneMode) || self.controlPlane.dataPlaneMode == 'Sidecar' || (has(self.provider) && self.provider == 'Sail')",message="the Ambient data plane mode requires the Sail provider"
 type ServiceMeshSpec struct {
 	// +kubebuilder:validation:Enum=Managed;Unmanaged;Removed
 	// +kubebuilder:default=Removed
 	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
+	// Provider of the service mesh: Maistra for OpenShift Service Mesh 2, Sail for OpenShift Service Mesh 3 or
+	// upstream Istio installed by the Sail operator.
+	// +kubebuilder:validation:Enum=Maistra;Sail
+	// +kubebuilder:default=Maistra
+	Provider ServiceMeshProvider `json:"provider,omitempty"`
 	// ControlPlane holds configuration of Service Mesh used by Opendatahub.
 	ControlPlane ControlPlaneSpec `json:"controlPlane,omitempty"`
 	// Auth holds configuration of authentication and authorization services
@@ -30,6 +52,14 @@ type ControlPlaneSpec struct {
 	// +kubebuilder:validation:Enum=Istio;None
 	// +kubebuilder:default=Istio
 	MetricsCollection string `json:"metricsCollection,omitempty"`
+	// DataPlaneMode is the mode of the namespaces added to the mesh with the Sail provider: Sidecar injects a proxy
+	// in their pods, Ambient redirects their traffic to the ztunnel node proxies.
+	// +kubebuilder:validation:Enum=Sidecar;Ambient
+	// +kubebuilder:default=Sidecar
+	DataPlaneMode string `json:"dataPlaneMode,omitempty"`
+	// Version of Istio installed with the Sail provider, the default version of the Sail operator when not set.
+	// +kubebuilder:validation:Pattern="^(v[0-9]+\\.[0-9]+(\\.[0-9]+)?|latest)?$"
+	Version string `json:"version,omitempty"`
 }
 
 // GatewaySpec represents the configuration of the Ingress Gateways.
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -39,11 +40,94 @@ type AuthSpec struct {
 	// AllowedGroups cannot contain empty strings, but 'system:authenticated' is allowed for general access
 	// +kubebuilder:validation:XValidation:rule="self.all(group, group != '')",message="AllowedGroups cannot contain empty strings"
 	AllowedGroups []string `json:"allowedGroups"`
+	// AdminUsers are granted the same permissions as AdminGroups, they cannot contain 'system:anonymous' or empty strings
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.all(user, user != 'system:anonymous' && user != '')",message="AdminUsers cannot contain 'system:anonymous' or empty strings"
+	AdminUsers []string `json:"adminUsers,omitempty"`
+	// AllowedUsers are granted the same permissions as AllowedGroups, they cannot contain empty strings
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.all(user, user != '')",message="AllowedUsers cannot contain empty strings"
+	AllowedUsers []string `json:"allowedUsers,omitempty"`
+	// OIDC overrides the prefixes of the user and group names, detected from the OIDC provider of the cluster
+	// when it authenticates users directly against an external OIDC provider
+	// +optional
+	OIDC *OIDCPrefixes `json:"oidc,omitempty"`
+	// Roles are personas granted a set of component capabilities, cluster-wide or in selected namespaces
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=32
+	Roles []AuthRole `json:"roles,omitempty"`
+}
+
+// AuthRole is a persona, e.g. model-deployer, granted to groups and users. The Auth service materializes it into
+// a ClusterRole aggregating the RBAC fragments contributed by the components for each of its capabilities.
+// +kubebuilder:validation:XValidation:rule="(has(self.groups) && size(self.groups) > 0) || (has(self.users) && size(self.users) > 0)",message="Roles must be granted to at least one group or user"
+type AuthRole struct {
+	// Name of the role, used to name the ClusterRole data-science-role-<name>
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=40
+	Name string `json:"name"`
+	// Groups granted the role, the OIDC groups prefix is added as for AllowedGroups
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.all(group, group != '')",message="Groups cannot contain empty strings"
+	Groups []string `json:"groups,omitempty"`
+	// Users granted the role, the OIDC username prefix is added as for AllowedUsers
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.all(user, user != '')",message="Users cannot contain empty strings"
+	Users []string `json:"users,omitempty"`
+	// Capabilities granted by the role, as <component>/<capability>, e.g. kserve/deploy
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Pattern=`^[a-z0-9]+/[a-z0-9-]+$`
+	Capabilities []string `json:"capabilities"`
+	// Namespaces the role is bound in, the role is bound cluster-wide when empty
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// OIDCPrefixes defines the prefixes the cluster adds to the claims of the OIDC tokens to build the user and group
+// names. The prefixes are added to the names of the AuthSpec, unless they already start with them.
+type OIDCPrefixes struct {
+	// UsernamePrefix is added to AdminUsers and AllowedUsers
+	// +optional
+	UsernamePrefix *string `json:"usernamePrefix,omitempty"`
+	// GroupsPrefix is added to AdminGroups and AllowedGroups, except to the system: groups
+	// +optional
+	GroupsPrefix *string `json:"groupsPrefix,omitempty"`
+}
+
+// AuthenticationMode is the way the cluster authenticates users.
+type AuthenticationMode string
+
+const (
+	AuthenticationModeIntegratedOAuth AuthenticationMode = "IntegratedOAuth"
+	AuthenticationModeOIDC            AuthenticationMode = "OIDC"
+	AuthenticationModeNone            AuthenticationMode = "None"
+)
+
+// AuthBinding is a RoleBinding, or a ClusterRoleBinding, granting the admin, allowed or role permissions.
+type AuthBinding struct {
+	// Kind of the binding, RoleBinding or ClusterRoleBinding
+	Kind string `json:"kind"`
+	// Name of the binding
+	Name string `json:"name"`
+	// Namespace of a RoleBinding
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Subjects bound to the role
+	// +optional
+	Subjects []rbacv1.Subject `json:"subjects,omitempty"`
 }
 
 // AuthStatus defines the observed state of Auth
 type AuthStatus struct {
 	common.Status `json:",inline"`
+	// AuthenticationMode is the way the cluster authenticates users, as detected from its Authentication config
+	// +optional
+	AuthenticationMode AuthenticationMode `json:"authenticationMode,omitempty"`
+	// Bindings are the RoleBindings and ClusterRoleBindings produced from the AuthSpec
+	// +optional
+	Bindings []AuthBinding `json:"bindings,omitempty"`
 }
 
 //+kubebuilder:object:root=true
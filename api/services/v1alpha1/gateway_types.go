@@ -0,0 +1,139 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	GatewayServiceName = "gateway"
+	// GatewayInstanceName the name of the GatewayConfig instance singleton.
+	// value should match whats set in the XValidation below
+	GatewayInstanceName = "default-gateway"
+	GatewayConfigKind   = "GatewayConfig"
+)
+
+// Check that the component implements common.PlatformObject.
+var _ common.PlatformObject = (*GatewayConfig)(nil)
+
+// GatewayConfigSpec defines the desired state of GatewayConfig
+type GatewayConfigSpec struct {
+	// gateway spec exposed to DSCI api
+	GatewayCommonSpec `json:",inline"`
+}
+
+// GatewayCommonSpec defines the platform Gateway, exposing the Dashboard and the KServe inference services
+// through the Kubernetes Gateway API.
+type GatewayCommonSpec struct {
+	// Name of the Gateway. Defaults to "data-science-gateway".
+	// +kubebuilder:default=data-science-gateway
+	// +kubebuilder:validation:Pattern="^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$"
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name,omitempty"`
+	// Namespace where the Gateway is deployed. Defaults to "openshift-ingress", the namespace watched by the
+	// Gateway API implementation of OpenShift.
+	// +kubebuilder:default=openshift-ingress
+	// +kubebuilder:validation:Pattern="^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$"
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace,omitempty"`
+	// GatewayClass of the Gateway, created by the operator.
+	GatewayClass GatewayClassSpec `json:"gatewayClass,omitempty"`
+	// Domain the Gateway listens on, the HTTPRoutes of the components getting a host name in it.
+	// Defaults to the ingress domain of the cluster.
+	// +kubebuilder:validation:Pattern="^([a-z0-9]([-a-z0-9]*[a-z0-9])?\\.)*[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	Domain string `json:"domain,omitempty"`
+	// Certificate specifies the TLS certificate of the HTTPS listener of the Gateway. When SecretName is not set,
+	// the Secret is named after the Gateway with a "-tls" suffix.
+	Certificate infrav1.CertificateSpec `json:"certificate,omitempty"`
+}
+
+// GatewayClassSpec defines the GatewayClass of the platform Gateway.
+type GatewayClassSpec struct {
+	// Name of the GatewayClass. Defaults to "data-science-gateway-class".
+	// +kubebuilder:default=data-science-gateway-class
+	// +kubebuilder:validation:Pattern="^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$"
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name,omitempty"`
+	// ControllerName of the Gateway API implementation managing the Gateway, e.g. "istio.io/gateway-controller"
+	// for upstream Istio. Defaults to "openshift.io/gateway-controller/v1", OpenShift Service Mesh 3 as set up by
+	// the ingress operator of OpenShift.
+	// +kubebuilder:default="openshift.io/gateway-controller/v1"
+	// +kubebuilder:validation:MaxLength=253
+	ControllerName string `json:"controllerName,omitempty"`
+}
+
+// GatewayConfigStatus defines the observed state of GatewayConfig
+type GatewayConfigStatus struct {
+	common.Status `json:",inline"`
+
+	// Domain the Gateway listens on.
+	Domain string `json:"domain,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-gateway'",message="GatewayConfig name must be default-gateway"
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
+// +kubebuilder:printcolumn:name="Domain",type=string,JSONPath=`.status.domain`,description="Domain"
+
+// GatewayConfig is the Schema for the gatewayconfigs API
+type GatewayConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GatewayConfigSpec   `json:"spec,omitempty"`
+	Status GatewayConfigStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// GatewayConfigList contains a list of GatewayConfig
+type GatewayConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GatewayConfig `json:"items"`
+}
+
+func (m *GatewayConfig) GetStatus() *common.Status {
+	return &m.Status.Status
+}
+
+func (c *GatewayConfig) GetConditions() []common.Condition {
+	return c.Status.GetConditions()
+}
+
+func (c *GatewayConfig) SetConditions(conditions []common.Condition) {
+	c.Status.SetConditions(conditions)
+}
+
+func init() {
+	SchemeBuilder.Register(&GatewayConfig{}, &GatewayConfigList{})
+}
+
+type DSCIGateway struct {
+	// configuration fields common across services
+	common.ManagementSpec `json:",inline"`
+	// gateway specific fields
+	GatewayCommonSpec `json:",inline"`
+}
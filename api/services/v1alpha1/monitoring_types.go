@@ -55,6 +55,232 @@ type Metrics struct {
 	// +kubebuilder:validation:XValidation:rule="!('otlp/tempo' in self)",message="exporter name 'otlp/tempo' is reserved and cannot be used"
 	// +kubebuilder:validation:XValidation:rule="self.all(k, self[k] != '')",message="exporter configuration values must be non-empty strings"
 	Exporters map[string]string `json:"exporters,omitempty"`
+	// Destinations defines typed metrics exporters for sending metrics to external observability tools,
+	// rendered into the OpenTelemetry Collector configuration as <type>/<name> exporters.
+	// Exporters remains available for the exporter types and settings not covered by destinations.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Destinations []MetricsDestination `json:"destinations,omitempty"`
+	// RemoteWrite defines Prometheus remote-write targets the metrics of the MonitoringStack are sent to,
+	// labelled with the cluster they come from.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	RemoteWrite []RemoteWriteTarget `json:"remoteWrite,omitempty"`
+	// Federation exposes the federation endpoint of the MonitoringStack through a Route, authenticating and
+	// authorizing the requests against the OpenShift API
+	// +optional
+	Federation *MetricsFederation `json:"federation,omitempty"`
+}
+
+// RemoteWriteTarget defines a Prometheus remote-write endpoint, the Secrets are read from the monitoring namespace.
+// +kubebuilder:validation:XValidation:rule="!has(self.tls) || !has(self.tls.insecure) || !self.tls.insecure",message="tls.insecure is not supported by remote-write targets"
+type RemoteWriteTarget struct {
+	// Name of the target
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+	// URL of the remote-write endpoint
+	// +kubebuilder:validation:Pattern="^https?://"
+	// +kubebuilder:validation:MaxLength=2048
+	URL string `json:"url"`
+	// Auth configures the authentication to the endpoint
+	// +optional
+	Auth *RemoteWriteAuth `json:"auth,omitempty"`
+	// TLS configures the connection to the endpoint
+	// +optional
+	TLS *ExporterTLS `json:"tls,omitempty"`
+	// QueueConfig tunes the queue of the samples sent to the endpoint
+	// +optional
+	QueueConfig *RemoteWriteQueueConfig `json:"queueConfig,omitempty"`
+	// WriteRelabelConfigs are applied to the samples before they are sent, a cluster label identifying the
+	// cluster being added after them
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	WriteRelabelConfigs []RelabelConfig `json:"writeRelabelConfigs,omitempty"`
+}
+
+// RemoteWriteAuth configures the authentication to a remote-write endpoint, exactly one of the methods must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.bearerTokenSecret) != has(self.basicAuthSecret)",message="exactly one of bearerTokenSecret or basicAuthSecret must be set"
+type RemoteWriteAuth struct {
+	// BearerTokenSecret references the Secret key holding the bearer token sent to the endpoint
+	// +optional
+	BearerTokenSecret *SecretKeyReference `json:"bearerTokenSecret,omitempty"`
+	// BasicAuthSecret is the name of a Secret holding the username and password keys
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	BasicAuthSecret string `json:"basicAuthSecret,omitempty"`
+}
+
+// RemoteWriteQueueConfig tunes the queue of a remote-write target, the Prometheus defaults apply to the unset fields.
+type RemoteWriteQueueConfig struct {
+	// Capacity is the number of samples buffered per shard
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Capacity int32 `json:"capacity,omitempty"`
+	// MinShards is the minimum number of shards sending samples concurrently
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinShards int32 `json:"minShards,omitempty"`
+	// MaxShards is the maximum number of shards sending samples concurrently
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxShards int32 `json:"maxShards,omitempty"`
+	// MaxSamplesPerSend is the maximum number of samples per request
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxSamplesPerSend int32 `json:"maxSamplesPerSend,omitempty"`
+	// BatchSendDeadline is the maximum time a sample waits in the queue before being sent
+	// +optional
+	BatchSendDeadline *metav1.Duration `json:"batchSendDeadline,omitempty"`
+}
+
+// RelabelConfig defines a Prometheus relabeling rule.
+type RelabelConfig struct {
+	// SourceLabels are the labels whose values, joined with a semicolon, are matched against Regex
+	// +optional
+	SourceLabels []string `json:"sourceLabels,omitempty"`
+	// Regex matched against the source labels, (.*) by default
+	// +optional
+	Regex string `json:"regex,omitempty"`
+	// TargetLabel is the label set by the replace action
+	// +optional
+	TargetLabel string `json:"targetLabel,omitempty"`
+	// Replacement is the value of the target label, $1 by default
+	// +optional
+	Replacement string `json:"replacement,omitempty"`
+	// Action to perform
+	// +optional
+	// +kubebuilder:default=replace
+	// +kubebuilder:validation:Enum=replace;keep;drop;labelmap;labeldrop;labelkeep
+	Action string `json:"action,omitempty"`
+}
+
+// MetricsFederation configures the federation endpoint of the MonitoringStack.
+type MetricsFederation struct {
+	// Host of the federation Route, generated by OpenShift when not set
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	Host string `json:"host,omitempty"`
+}
+
+// MetricsDestination defines a metrics exporter of the OpenTelemetry Collector.
+// +kubebuilder:validation:XValidation:rule="self.type == 'kafka' ? has(self.kafka) && !has(self.endpoint) : has(self.endpoint) && !has(self.kafka)",message="kafka must be set for the kafka type, endpoint for the other types"
+// +kubebuilder:validation:XValidation:rule="self.type != 'kafka' || !has(self.headers)",message="headers are not supported by the kafka type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'kafka' || !has(self.auth) || !has(self.auth.bearerTokenSecret)",message="bearer token authentication is not supported by the kafka type"
+// +kubebuilder:validation:XValidation:rule="self.type == 'otlpgrpc' || !has(self.tls) || !has(self.tls.insecure) || !self.tls.insecure",message="tls.insecure is only supported by the otlpgrpc type"
+type MetricsDestination struct {
+	// Name of the destination
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+	// Type of the exporter
+	Type MetricsDestinationType `json:"type"`
+	// Endpoint metrics are sent to, an URL for the prometheusremotewrite and otlphttp types,
+	// a host:port address for the otlpgrpc type
+	// +optional
+	// +kubebuilder:validation:MaxLength=2048
+	Endpoint string `json:"endpoint,omitempty"`
+	// TLS configures the connection to the endpoint
+	// +optional
+	TLS *ExporterTLS `json:"tls,omitempty"`
+	// Auth configures the authentication to the endpoint
+	// +optional
+	Auth *ExporterAuth `json:"auth,omitempty"`
+	// Headers are added to the requests sent to the endpoint
+	// +optional
+	// +kubebuilder:validation:MaxProperties=32
+	Headers map[string]string `json:"headers,omitempty"`
+	// Kafka configures the kafka type
+	// +optional
+	Kafka *KafkaDestination `json:"kafka,omitempty"`
+	// Filter restricts the metrics sent to the destination
+	// +optional
+	Filter *MetricsFilter `json:"filter,omitempty"`
+}
+
+// MetricsDestinationType is the type of a metrics exporter.
+// +kubebuilder:validation:Enum=prometheusremotewrite;otlphttp;otlpgrpc;kafka
+type MetricsDestinationType string
+
+const (
+	MetricsDestinationPrometheusRemoteWrite MetricsDestinationType = "prometheusremotewrite"
+	MetricsDestinationOTLPHTTP              MetricsDestinationType = "otlphttp"
+	MetricsDestinationOTLPGRPC              MetricsDestinationType = "otlpgrpc"
+	MetricsDestinationKafka                 MetricsDestinationType = "kafka"
+)
+
+// ExporterTLS configures the TLS connection of an exporter, the Secrets are read from the monitoring namespace.
+type ExporterTLS struct {
+	// Insecure disables TLS
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+	// InsecureSkipVerify disables the verification of the server certificate
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// CASecret references the Secret key holding the CA bundle verifying the server certificate
+	// +optional
+	CASecret *SecretKeyReference `json:"caSecret,omitempty"`
+	// ClientCertSecret is the name of a kubernetes.io/tls Secret holding the client certificate for mutual TLS
+	// +optional
+	ClientCertSecret string `json:"clientCertSecret,omitempty"`
+}
+
+// ExporterAuth configures the authentication of an exporter, exactly one of the methods must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.bearerTokenSecret) != has(self.basic)",message="exactly one of bearerTokenSecret or basic must be set"
+type ExporterAuth struct {
+	// BearerTokenSecret references the Secret key holding the bearer token sent to the endpoint
+	// +optional
+	BearerTokenSecret *SecretKeyReference `json:"bearerTokenSecret,omitempty"`
+	// Basic configures basic authentication, or SASL PLAIN authentication for the kafka type
+	// +optional
+	Basic *BasicAuth `json:"basic,omitempty"`
+}
+
+// BasicAuth configures username and password authentication.
+type BasicAuth struct {
+	// Username to authenticate with
+	// +kubebuilder:validation:MinLength=1
+	Username string `json:"username"`
+	// PasswordSecret references the Secret key holding the password
+	PasswordSecret SecretKeyReference `json:"passwordSecret"`
+}
+
+// KafkaDestination configures a kafka exporter.
+type KafkaDestination struct {
+	// Brokers are the host:port addresses of the Kafka brokers
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Brokers []string `json:"brokers"`
+	// Topic metrics are published to
+	// +optional
+	// +kubebuilder:default=otlp_metrics
+	Topic string `json:"topic,omitempty"`
+	// Encoding of the published metrics
+	// +optional
+	// +kubebuilder:default=otlp_proto
+	// +kubebuilder:validation:Enum=otlp_proto;otlp_json
+	Encoding string `json:"encoding,omitempty"`
+}
+
+// MetricsFilter restricts the metrics sent to a destination by component.
+// The component of a metric is the component label of the metric, set from the app.kubernetes.io/part-of
+// label of the scraped pod.
+// +kubebuilder:validation:XValidation:rule="has(self.includeComponents) || has(self.excludeComponents)",message="at least one of includeComponents or excludeComponents must be set"
+type MetricsFilter struct {
+	// IncludeComponents restricts the metrics to the ones of the given components
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:items:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	IncludeComponents []string `json:"includeComponents,omitempty"`
+	// ExcludeComponents drops the metrics of the given components
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:items:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	ExcludeComponents []string `json:"excludeComponents,omitempty"`
 }
 
 // MetricsStorage defines the storage configuration for the monitoring service
@@ -98,6 +324,133 @@ type Traces struct {
 	// +kubebuilder:default="0.1"
 	// +kubebuilder:validation:Pattern="^(0(\\.[0-9]+)?|1(\\.0+)?)$"
 	SampleRatio string `json:"sampleRatio,omitempty"`
+	// Sampling configures tail-based sampling policies in the OpenTelemetry Collector.
+	// When set, the instrumented workloads send all their traces to the collector, the traces not kept by
+	// any of the policies being sampled with SampleRatio.
+	// +optional
+	Sampling *TracesSampling `json:"sampling,omitempty"`
+	// Instrumentation declares the namespaces and workloads whose pods get the OpenTelemetry auto-instrumentation
+	// injected, an Instrumentation CR being deployed in each of the namespaces.
+	// +optional
+	// +listType=map
+	// +listMapKey=namespace
+	// +kubebuilder:validation:MaxItems=64
+	Instrumentation []InstrumentationTarget `json:"instrumentation,omitempty"`
+}
+
+// TracesSampling defines the tail-based sampling policies, a trace is kept when any of the policies keeps it.
+type TracesSampling struct {
+	// DecisionWait is how long the spans of a trace are buffered before the sampling decision is taken
+	// +kubebuilder:default="10s"
+	DecisionWait metav1.Duration `json:"decisionWait,omitempty"`
+	// Errors keeps all the traces having a span in error
+	// +optional
+	Errors bool `json:"errors,omitempty"`
+	// LatencyThreshold keeps all the traces lasting longer than the threshold
+	// +optional
+	LatencyThreshold *metav1.Duration `json:"latencyThreshold,omitempty"`
+	// Services overrides the sampling ratio of the traces of the given services
+	// +optional
+	// +listType=map
+	// +listMapKey=service
+	// +kubebuilder:validation:MaxItems=32
+	Services []ServiceSampling `json:"services,omitempty"`
+}
+
+// ServiceSampling defines the sampling ratio of the traces of a service.
+type ServiceSampling struct {
+	// Service is the name of the service, as set in the service.name resource attribute
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Service string `json:"service"`
+	// SampleRatio determines the sampling rate for the traces of the service
+	// Value should be between 0.0 (no sampling) and 1.0 (sample all traces)
+	// +kubebuilder:validation:Pattern="^(0(\\.[0-9]+)?|1(\\.0+)?)$"
+	SampleRatio string `json:"sampleRatio"`
+}
+
+// InstrumentationTarget declares the workloads of a namespace getting the OpenTelemetry auto-instrumentation injected.
+type InstrumentationTarget struct {
+	// Namespace of the instrumented workloads
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace"`
+	// Languages of the injected auto-instrumentation
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	Languages []InstrumentationLanguage `json:"languages"`
+	// WorkloadSelector restricts the instrumentation to the Deployments with the given labels.
+	// All the pods of the namespace are instrumented if not set. The Deployments are not watched, the ones
+	// created later are instrumented at the next reconciliation of the Monitoring service.
+	// +optional
+	WorkloadSelector map[string]string `json:"workloadSelector,omitempty"`
+}
+
+// InstrumentationLanguage is a language supported by the OpenTelemetry auto-instrumentation.
+// +kubebuilder:validation:Enum=java;nodejs;python;dotnet
+type InstrumentationLanguage string
+
+const (
+	InstrumentationLanguageJava   InstrumentationLanguage = "java"
+	InstrumentationLanguageNodeJS InstrumentationLanguage = "nodejs"
+	InstrumentationLanguagePython InstrumentationLanguage = "python"
+	InstrumentationLanguageDotNet InstrumentationLanguage = "dotnet"
+)
+
+// Logs enables and defines the configuration for the collection of the logs of the component pods
+// +kubebuilder:validation:XValidation:rule="has(self.storage) || has(self.otlp)",message="at least one of storage or otlp must be set"
+type Logs struct {
+	// Storage configures the LokiStack storing the logs, deployed when the Loki operator is installed
+	// +optional
+	Storage *LogsStorage `json:"storage,omitempty"`
+	// OTLP configures an OpenTelemetry Protocol endpoint the logs are sent to
+	// +optional
+	OTLP *LogsOTLP `json:"otlp,omitempty"`
+}
+
+// LogsStorage defines the LokiStack storing the logs in the monitoring namespace
+type LogsStorage struct {
+	// Backend is the type of the object storage
+	// +kubebuilder:validation:Enum=s3;gcs;azure;swift
+	// +kubebuilder:default=s3
+	Backend string `json:"backend,omitempty"`
+	// Secret is the name of the Secret holding the object storage credentials
+	// +kubebuilder:validation:MinLength=1
+	Secret string `json:"secret"`
+	// StorageClassName is the name of the storage class of the LokiStack volumes
+	// +kubebuilder:validation:MinLength=1
+	StorageClassName string `json:"storageClassName"`
+	// Size is the size of the LokiStack deployment
+	// +kubebuilder:validation:Enum="1x.demo";"1x.pico";"1x.extra-small";"1x.small";"1x.medium"
+	// +kubebuilder:default="1x.extra-small"
+	Size string `json:"size,omitempty"`
+	// RetentionDays is the number of days logs are retained
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=7
+	RetentionDays int32 `json:"retentionDays,omitempty"`
+}
+
+// LogsOTLP defines an OpenTelemetry Protocol endpoint the logs are sent to
+// +kubebuilder:validation:XValidation:rule="self.protocol == 'grpc' || !has(self.tls) || !has(self.tls.insecure) || !self.tls.insecure",message="tls.insecure is only supported by the grpc protocol"
+type LogsOTLP struct {
+	// Endpoint logs are sent to, an URL for the http protocol, a host:port address for the grpc protocol
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=2048
+	Endpoint string `json:"endpoint"`
+	// Protocol used to send the logs
+	// +kubebuilder:validation:Enum=grpc;http
+	// +kubebuilder:default=grpc
+	Protocol string `json:"protocol,omitempty"`
+	// TLS configures the connection to the endpoint
+	// +optional
+	TLS *ExporterTLS `json:"tls,omitempty"`
+	// Auth configures the authentication to the endpoint
+	// +optional
+	Auth *ExporterAuth `json:"auth,omitempty"`
+	// Headers are added to the requests sent to the endpoint
+	// +optional
+	// +kubebuilder:validation:MaxProperties=32
+	Headers map[string]string `json:"headers,omitempty"`
 }
 
 // TracesStorage defines the storage configuration for tracing.
@@ -126,9 +479,280 @@ type TracesStorage struct {
 }
 
 // Alerting configuration for Prometheus
+// +kubebuilder:validation:XValidation:rule="!has(self.defaultReceiver) || (has(self.receivers) && self.receivers.exists(r, r.name == self.defaultReceiver))",message="defaultReceiver must reference a receiver defined in receivers"
+// +kubebuilder:validation:XValidation:rule="!has(self.routes) || self.routes.all(rt, has(self.receivers) && self.receivers.exists(r, r.name == rt.receiver))",message="routes must reference receivers defined in receivers"
 type Alerting struct {
+	// DefaultReceiver is the name of the receiver notified of the alerts not matched by any route.
+	// Alerts not matched by any route are not notified if not set.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	DefaultReceiver string `json:"defaultReceiver,omitempty"`
+	// Receivers defines where alert notifications are sent.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=32
+	Receivers []AlertReceiver `json:"receivers,omitempty"`
+	// Routes defines which receiver is notified of an alert, the first matching route wins.
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	Routes []AlertRoute `json:"routes,omitempty"`
+	// Inhibitions mute the notifications of alerts while alerts of a higher severity are firing.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Inhibitions []AlertInhibition `json:"inhibitions,omitempty"`
+	// Silences mute the notifications of alerts during scheduled maintenance windows.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=32
+	Silences []AlertSilence `json:"silences,omitempty"`
+	// Rules customizes the alerting rules deployed for the components, 'operator' customizes the rules of the operator.
+	// +optional
+	// +listType=map
+	// +listMapKey=component
+	// +kubebuilder:validation:MaxItems=32
+	Rules []ComponentAlertRules `json:"rules,omitempty"`
+}
+
+// AlertReceiver defines a destination of alert notifications, exactly one of the receiver types must be set.
+// +kubebuilder:validation:XValidation:rule="[has(self.webhook), has(self.email), has(self.pagerDuty), has(self.slack)].exists_one(x, x)",message="exactly one of webhook, email, pagerDuty or slack must be set"
+type AlertReceiver struct {
+	// Name of the receiver, 'null' is reserved for the receiver dropping notifications
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self != 'null'",message="receiver name 'null' is reserved"
+	Name string `json:"name"`
+	// Webhook sends notifications to a generic webhook endpoint
+	// +optional
+	Webhook *WebhookReceiver `json:"webhook,omitempty"`
+	// Email sends notifications through an SMTP server
+	// +optional
+	Email *EmailReceiver `json:"email,omitempty"`
+	// PagerDuty sends notifications to a PagerDuty service through the Events API v2
+	// +optional
+	PagerDuty *PagerDutyReceiver `json:"pagerDuty,omitempty"`
+	// Slack sends notifications to a Slack compatible incoming webhook
+	// +optional
+	Slack *SlackReceiver `json:"slack,omitempty"`
+}
+
+// SecretKeyReference references a key of a Secret in the monitoring namespace.
+type SecretKeyReference struct {
+	// Name of the Secret
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Key of the Secret holding the value
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
+// WebhookReceiver defines a generic webhook receiver.
+type WebhookReceiver struct {
+	// URLSecret references the Secret key holding the URL of the webhook
+	URLSecret SecretKeyReference `json:"urlSecret"`
+	// SendResolved notifies about resolved alerts
+	// +optional
+	SendResolved bool `json:"sendResolved,omitempty"`
+}
+
+// EmailReceiver defines an email receiver.
+// +kubebuilder:validation:XValidation:rule="has(self.authUsername) == has(self.authPasswordSecret)",message="authUsername and authPasswordSecret must be set together"
+type EmailReceiver struct {
+	// To is the email address notifications are sent to
+	// +kubebuilder:validation:MinLength=1
+	To string `json:"to"`
+	// From is the sender email address
+	// +kubebuilder:validation:MinLength=1
+	From string `json:"from"`
+	// Smarthost is the SMTP server notifications are sent through, in the host:port format
+	// +kubebuilder:validation:Pattern="^[^:]+:[0-9]+$"
+	Smarthost string `json:"smarthost"`
+	// AuthUsername is the username to authenticate to the SMTP server
+	// +optional
+	AuthUsername string `json:"authUsername,omitempty"`
+	// AuthPasswordSecret references the Secret key holding the password to authenticate to the SMTP server
+	// +optional
+	AuthPasswordSecret *SecretKeyReference `json:"authPasswordSecret,omitempty"`
+	// SendResolved notifies about resolved alerts
+	// +optional
+	SendResolved bool `json:"sendResolved,omitempty"`
+}
+
+// PagerDutyReceiver defines a PagerDuty receiver.
+type PagerDutyReceiver struct {
+	// RoutingKeySecret references the Secret key holding the integration key of the PagerDuty service
+	RoutingKeySecret SecretKeyReference `json:"routingKeySecret"`
+	// SendResolved notifies about resolved alerts
+	// +optional
+	SendResolved bool `json:"sendResolved,omitempty"`
 }
 
+// SlackReceiver defines a Slack receiver.
+type SlackReceiver struct {
+	// APIURLSecret references the Secret key holding the URL of the incoming webhook
+	APIURLSecret SecretKeyReference `json:"apiURLSecret"`
+	// Channel overrides the default channel of the incoming webhook
+	// +optional
+	Channel string `json:"channel,omitempty"`
+	// SendResolved notifies about resolved alerts
+	// +optional
+	SendResolved bool `json:"sendResolved,omitempty"`
+}
+
+// AlertRoute routes the alerts matching all of its criteria to a receiver.
+type AlertRoute struct {
+	// Receiver is the name of the receiver notified of the matching alerts
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Receiver string `json:"receiver"`
+	// Components restricts the route to the alerts of the given components, 'operator' matches the alerts of the operator.
+	// All components match if not set.
+	// +optional
+	// +listType=set
+	Components []string `json:"components,omitempty"`
+	// Severities restricts the route to the alerts of the given severities.
+	// All severities match if not set.
+	// +optional
+	// +listType=set
+	Severities []AlertSeverity `json:"severities,omitempty"`
+}
+
+// AlertSeverity is the severity of an alert.
+// +kubebuilder:validation:Enum=critical;warning;info
+type AlertSeverity string
+
+const (
+	AlertSeverityCritical AlertSeverity = "critical"
+	AlertSeverityWarning  AlertSeverity = "warning"
+	AlertSeverityInfo     AlertSeverity = "info"
+)
+
+// AlertInhibition mutes the notifications of the target alerts while a source alert is firing.
+// +kubebuilder:validation:XValidation:rule="self.sourceSeverity != self.targetSeverity",message="sourceSeverity and targetSeverity must be different"
+type AlertInhibition struct {
+	// SourceSeverity is the severity of the firing alerts muting the target alerts
+	SourceSeverity AlertSeverity `json:"sourceSeverity"`
+	// TargetSeverity is the severity of the muted alerts
+	TargetSeverity AlertSeverity `json:"targetSeverity"`
+	// Equal lists the labels that must have the same value in the source and target alerts
+	// +optional
+	// +kubebuilder:default={"alertname","component","namespace"}
+	// +listType=set
+	Equal []string `json:"equal,omitempty"`
+}
+
+// AlertSilence mutes the notifications of the matching alerts during a recurring maintenance window.
+// +kubebuilder:validation:XValidation:rule="self.startTime < self.endTime",message="startTime must be before endTime"
+type AlertSilence struct {
+	// Name of the silence
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+	// Comment describes the reason of the silence
+	// +optional
+	Comment string `json:"comment,omitempty"`
+	// Components restricts the silence to the alerts of the given components.
+	// All components match if not set.
+	// +optional
+	// +listType=set
+	Components []string `json:"components,omitempty"`
+	// Alerts restricts the silence to the alerts with the given names.
+	// All alerts match if not set.
+	// +optional
+	// +listType=set
+	Alerts []string `json:"alerts,omitempty"`
+	// Weekdays restricts the maintenance window to the given days of the week.
+	// The window applies every day if not set.
+	// +optional
+	// +listType=set
+	Weekdays []Weekday `json:"weekdays,omitempty"`
+	// StartTime is the UTC time of the day the maintenance window starts at, in the HH:MM format
+	// +kubebuilder:validation:Pattern="^([01][0-9]|2[0-3]):[0-5][0-9]$"
+	// +kubebuilder:validation:MaxLength=5
+	StartTime string `json:"startTime"`
+	// EndTime is the UTC time of the day the maintenance window ends at, in the HH:MM format
+	// +kubebuilder:validation:Pattern="^(([01][0-9]|2[0-3]):[0-5][0-9]|24:00)$"
+	// +kubebuilder:validation:MaxLength=5
+	EndTime string `json:"endTime"`
+}
+
+// ComponentAlertRules customizes the alerting rules deployed for a component.
+type ComponentAlertRules struct {
+	// Component is the name of the component the alerting rules belong to, or 'operator'
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:MaxLength=63
+	Component string `json:"component"`
+	// Disabled lists the names of the alerts of the component which are not deployed
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=64
+	Disabled []string `json:"disabled,omitempty"`
+	// Overrides changes how long the conditions of alerts of the component must hold before firing
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	Overrides []AlertRuleOverride `json:"overrides,omitempty"`
+	// Parameters overrides the thresholds exposed as parameters by the alerting rules of the component,
+	// such as the probeSuccessObjective of the SLO burn rate alerts.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=16
+	// +kubebuilder:validation:XValidation:rule="self.all(k, self[k].matches('^[0-9]+([.][0-9]+)?$'))",message="parameters must be decimal numbers"
+	Parameters map[string]string `json:"parameters,omitempty"`
+	// CustomRules are additional alerting rules deployed along with the rules of the component
+	// +optional
+	// +listType=map
+	// +listMapKey=alert
+	// +kubebuilder:validation:MaxItems=32
+	CustomRules []CustomAlertRule `json:"customRules,omitempty"`
+}
+
+// AlertRuleOverride overrides the pending duration of the alerting rules with the given name.
+type AlertRuleOverride struct {
+	// Alert is the name of the overridden alert
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Alert string `json:"alert"`
+	// Severity restricts the override to the rules of the alert with the given severity.
+	// All the rules of the alert are overridden if not set.
+	// +optional
+	Severity AlertSeverity `json:"severity,omitempty"`
+	// For is how long the condition of the alert must hold before firing, as a Prometheus duration
+	// +kubebuilder:validation:Pattern="^(0|([0-9]+(ms|s|m|h|d|w|y))+)$"
+	// +kubebuilder:validation:MaxLength=32
+	For string `json:"for"`
+}
+
+// CustomAlertRule defines an additional alerting rule.
+type CustomAlertRule struct {
+	// Alert is the name of the alert
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Alert string `json:"alert"`
+	// Expr is the PromQL expression of the alert, it is validated before the rule is deployed
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	Expr string `json:"expr"`
+	// For is how long the condition of the alert must hold before firing, as a Prometheus duration
+	// +optional
+	// +kubebuilder:validation:Pattern="^(0|([0-9]+(ms|s|m|h|d|w|y))+)$"
+	// +kubebuilder:validation:MaxLength=32
+	For string `json:"for,omitempty"`
+	// Severity of the alert
+	// +optional
+	// +kubebuilder:default=warning
+	Severity AlertSeverity `json:"severity,omitempty"`
+	// Labels are added to the alert
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations are added to the alert, such as summary and description
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Weekday is a day of the week.
+// +kubebuilder:validation:Enum=monday;tuesday;wednesday;thursday;friday;saturday;sunday
+type Weekday string
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster
@@ -162,6 +786,8 @@ type MonitoringCommonSpec struct {
 	Traces *Traces `json:"traces,omitempty"`
 	// Alerting configuration for Prometheus
 	Alerting *Alerting `json:"alerting,omitempty"`
+	// Logs configuration for the collection of the component logs
+	Logs *Logs `json:"logs,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -33,10 +34,16 @@ const (
 // Check that the component implements common.PlatformObject.
 var _ common.PlatformObject = (*ServiceMesh)(nil)
 
+// +kubebuilder:validation:XValidation:rule="!has(self.controlPlane) || !has(self.controlPlane.dataPlaneMode) || self.controlPlane.dataPlaneMode == 'Sidecar' || (has(self.provider) && self.provider == 'Sail')",message="the Ambient data plane mode requires the Sail provider"
 type ServiceMeshSpec struct {
 	// +kubebuilder:validation:Enum=Managed;Unmanaged;Removed
 	// +kubebuilder:default=Removed
 	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
+	// Provider of the service mesh: Maistra for OpenShift Service Mesh 2, Sail for OpenShift Service Mesh 3 or
+	// upstream Istio installed by the Sail operator.
+	// +kubebuilder:validation:Enum=Maistra;Sail
+	// +kubebuilder:default=Maistra
+	Provider infrav1.ServiceMeshProvider `json:"provider,omitempty"`
 	// servicemesh spec exposed to DSCI api
 	// ControlPlane holds configuration of Service Mesh used by Opendatahub.
 	ControlPlane ServiceMeshControlPlaneSpec `json:"controlPlane,omitempty"`
@@ -61,6 +68,14 @@ type ServiceMeshControlPlaneSpec struct {
 	// +kubebuilder:validation:Enum=Istio;None
 	// +kubebuilder:default=Istio
 	MetricsCollection string `json:"metricsCollection,omitempty"`
+	// DataPlaneMode is the mode of the namespaces added to the mesh with the Sail provider: Sidecar injects a proxy
+	// in their pods, Ambient redirects their traffic to the ztunnel node proxies.
+	// +kubebuilder:validation:Enum=Sidecar;Ambient
+	// +kubebuilder:default=Sidecar
+	DataPlaneMode string `json:"dataPlaneMode,omitempty"`
+	// Version of Istio installed with the Sail provider, the default version of the Sail operator when not set.
+	// +kubebuilder:validation:Pattern="^(v[0-9]+\\.[0-9]+(\\.[0-9]+)?|latest)?$"
+	Version string `json:"version,omitempty"`
 }
 
 type ServiceMeshAuthSpec struct {
@@ -48,6 +48,7 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/fields"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -98,10 +99,14 @@ import (
 	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/workbenches"
 	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/auth"
 	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/certconfigmapgenerator"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/certificates"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/connectionrollout"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/gateway"
 	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/monitoring"
 	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/secretgenerator"
 	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/servicemesh"
 	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/setup"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/webhookselector"
 )
 
 var (
@@ -141,6 +146,9 @@ func init() { //nolint:gochecknoinits
 
 func initComponents(_ context.Context, p common.Platform) error {
 	return cr.ForEach(func(ch cr.ComponentHandler) error {
+		if !cr.IsSupported(ch, p) {
+			return nil
+		}
 		return ch.Init(p)
 	})
 }
@@ -266,6 +274,12 @@ func main() { //nolint:funlen,maintidx,gocyclo
 		os.Exit(1)
 	}
 
+	roleBindingCache, err := createRoleBindingCacheConfig(ctx, setupClient, platform)
+	if err != nil {
+		setupLog.Error(err, "unable to get application namespace into cache")
+		os.Exit(1)
+	}
+
 	cacheOptions := cache.Options{
 		Scheme: scheme,
 		ByObject: map[client.Object]cache.ByObject{
@@ -306,8 +320,9 @@ func main() { //nolint:funlen,maintidx,gocyclo
 			&rbacv1.Role{}: {
 				Namespaces: oDHCache,
 			},
+			// the Auth service binds its roles in the project namespaces
 			&rbacv1.RoleBinding{}: {
-				Namespaces: oDHCache,
+				Namespaces: roleBindingCache,
 			},
 		},
 		DefaultTransform: func(in any) (any, error) {
@@ -543,10 +558,33 @@ func createODHGeneralCacheConfig(ctx context.Context, cli client.Client, platfor
 	return namespaceConfigs, nil
 }
 
+func createRoleBindingCacheConfig(ctx context.Context, cli client.Client, platform common.Platform) (map[string]cache.Config, error) {
+	namespaceConfigs, err := createODHGeneralCacheConfig(ctx, cli, platform)
+	if err != nil {
+		return nil, err
+	}
+
+	// the RoleBindings of the Auth roles in the other namespaces
+	namespaceConfigs[cache.AllNamespaces] = cache.Config{
+		LabelSelector: k8slabels.SelectorFromSet(k8slabels.Set{
+			labels.PlatformPartOf: strings.ToLower(serviceApi.AuthKind),
+		}),
+	}
+
+	return namespaceConfigs, nil
+}
+
 func CreateComponentReconcilers(ctx context.Context, mgr manager.Manager) error {
 	l := logf.FromContext(ctx)
 
+	platform := cluster.GetRelease().Name
+
 	return cr.ForEach(func(ch cr.ComponentHandler) error {
+		if !cr.IsSupported(ch, platform) {
+			l.Info("skipping reconciler, component not supported on the platform", "type", "component", "name", ch.GetName(), "platform", platform)
+			return nil
+		}
+
 		l.Info("creating reconciler", "type", "component", "name", ch.GetName())
 		if err := ch.NewComponentReconciler(ctx, mgr); err != nil {
 			return fmt.Errorf("error creating %s component reconciler: %w", ch.GetName(), err)
@@ -32,6 +32,10 @@ func (s *componentHandler) GetName() string {
 	return componentApi.CodeFlareComponentName
 }
 
+func (s *componentHandler) PrometheusRulesPrefix() string {
+	return "codeflare"
+}
+
 func (s *componentHandler) NewCRObject(dsc *dscv1.DataScienceCluster) common.PlatformObject {
 	return &componentApi.CodeFlare{
 		TypeMeta: metav1.TypeMeta{
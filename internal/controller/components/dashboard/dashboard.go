@@ -32,6 +32,10 @@ func (s *componentHandler) GetName() string {
 	return componentApi.DashboardComponentName
 }
 
+func (s *componentHandler) PrometheusRulesPrefix() string {
+	return "rhods-dashboard"
+}
+
 func (s *componentHandler) Init(platform common.Platform) error {
 	mi := defaultManifestInfo(platform)
 
@@ -69,6 +73,12 @@ func (s *componentHandler) IsEnabled(dsc *dscv1.DataScienceCluster) bool {
 	return dsc.Spec.Components.Dashboard.ManagementState == operatorv1.Managed
 }
 
+// IsSupported returns true on every platform, the Route and the ConsoleLink of the dashboard being replaced on
+// Kubernetes.
+func (s *componentHandler) IsSupported(_ common.Platform) bool {
+	return true
+}
+
 func (s *componentHandler) UpdateDSCStatus(ctx context.Context, rr *types.ReconciliationRequest) (metav1.ConditionStatus, error) {
 	cs := metav1.ConditionUnknown
 
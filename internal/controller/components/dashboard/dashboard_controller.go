@@ -24,6 +24,7 @@ import (
 	routev1 "github.com/openshift/api/route/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,8 +34,11 @@ import (
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/gc"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/httproute"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/openshift"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/kustomize"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/deployments"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
@@ -63,8 +67,22 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 		// hence we need a dedicated predicate to react to replicas status change
 		Owns(&appsv1.Deployment{}, reconciler.WithPredicates(resources.NewDeploymentPredicate())).
 		// operands - openshift
-		Owns(&routev1.Route{}).
-		Owns(&consolev1.ConsoleLink{}).
+		Owns(&routev1.Route{}, reconciler.Dynamic(actions.IfOpenShift())).
+		Owns(&consolev1.ConsoleLink{}, reconciler.Dynamic(actions.IfOpenShift())).
+		// operands - kubernetes, replacing the route when the platform gateway is not managed
+		Owns(&networkingv1.Ingress{}).
+		// operands - gateway api, replacing the route when the platform gateway is managed
+		OwnsGVK(gvk.HTTPRoute, reconciler.Dynamic(actions.IfGVKInstalled(gvk.HTTPRoute))).
+		OwnsGVK(gvk.BackendTLSPolicy, reconciler.Dynamic(actions.IfGVKInstalled(gvk.BackendTLSPolicy))).
+		// the service CA is copied in the CA ConfigMap of the BackendTLSPolicy, it must be re-copied on rotation
+		Watches(
+			&corev1.ConfigMap{},
+			reconciler.WithEventHandler(
+				handlers.ToNamed(componentApi.DashboardInstanceName)),
+			reconciler.WithPredicates(
+				resources.CreatedOrUpdatedName(httproute.ServiceCAConfigMap)),
+			reconciler.Dynamic(actions.IfGVKInstalled(gvk.BackendTLSPolicy)),
+		).
 		// Those APIs are provided by the component itself hence they should
 		// be watched dynamically
 		OwnsGVK(gvk.AcceleratorProfile, reconciler.Dynamic()).
@@ -112,6 +130,8 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 			kustomize.WithLabel(labels.K8SCommon.PartOf, componentName),
 		)).
 		WithAction(customizeResources).
+		WithAction(httproute.NewAction()).
+		WithAction(openshift.NewAction()).
 		WithAction(deploy.NewAction()).
 		WithAction(deployments.NewAction()).
 		WithAction(reconcileHardwareProfiles).
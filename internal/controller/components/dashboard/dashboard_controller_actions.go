@@ -11,7 +11,9 @@ import (
 	"github.com/go-logr/logr"
 	routev1 "github.com/openshift/api/route/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -107,7 +109,7 @@ func setKustomizedParams(ctx context.Context, rr *odhtypes.ReconciliationRequest
 }
 
 func configureDependencies(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
-	if rr.Release.Name == cluster.OpenDataHub {
+	if rr.Release.Name == cluster.OpenDataHub || rr.Release.Name == cluster.Kubernetes {
 		return nil
 	}
 
@@ -136,6 +138,28 @@ func updateStatus(ctx context.Context, rr *odhtypes.ReconciliationRequest) error
 		return errors.New("instance is not of type *odhTypes.Dashboard")
 	}
 
+	if cluster.IsGatewayManaged(&rr.DSCI.Spec) {
+		url, err := httpRouteHost(ctx, rr)
+		if err != nil {
+			return err
+		}
+
+		d.Status.URL = url
+
+		return nil
+	}
+
+	if rr.Release.Name == cluster.Kubernetes {
+		url, err := ingressHost(ctx, rr)
+		if err != nil {
+			return err
+		}
+
+		d.Status.URL = url
+
+		return nil
+	}
+
 	// url
 	rl := routev1.RouteList{}
 	err := rr.Client.List(
@@ -159,6 +183,61 @@ func updateStatus(ctx context.Context, rr *odhtypes.ReconciliationRequest) error
 	return nil
 }
 
+// httpRouteHost returns the host name of the HTTPRoute of the dashboard once accepted by the platform Gateway.
+func httpRouteHost(ctx context.Context, rr *odhtypes.ReconciliationRequest) (string, error) {
+	hrl := unstructured.UnstructuredList{}
+	hrl.SetGroupVersionKind(gvk.HTTPRoute)
+
+	err := rr.Client.List(
+		ctx,
+		&hrl,
+		client.InNamespace(rr.DSCI.Spec.ApplicationsNamespace),
+		client.MatchingLabels(map[string]string{
+			labels.PlatformPartOf: strings.ToLower(componentApi.DashboardKind),
+		}),
+	)
+	if meta.IsNoMatchError(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to list httproutes: %w", err)
+	}
+
+	if len(hrl.Items) != 1 || !resources.HTTPRouteAccepted(hrl.Items[0]) {
+		return "", nil
+	}
+
+	hostnames, _, err := unstructured.NestedStringSlice(hrl.Items[0].Object, "spec", "hostnames")
+	if err != nil || len(hostnames) == 0 {
+		return "", err
+	}
+
+	return hostnames[0], nil
+}
+
+// ingressHost returns the host name of the Ingress of the dashboard, empty when it matches any host.
+func ingressHost(ctx context.Context, rr *odhtypes.ReconciliationRequest) (string, error) {
+	il := networkingv1.IngressList{}
+
+	err := rr.Client.List(
+		ctx,
+		&il,
+		client.InNamespace(rr.DSCI.Spec.ApplicationsNamespace),
+		client.MatchingLabels(map[string]string{
+			labels.PlatformPartOf: strings.ToLower(componentApi.DashboardKind),
+		}),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to list ingresses: %w", err)
+	}
+
+	if len(il.Items) != 1 || len(il.Items[0].Spec.Rules) == 0 {
+		return "", nil
+	}
+
+	return il.Items[0].Spec.Rules[0].Host, nil
+}
+
 func reconcileHardwareProfiles(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	dashboardHardwareProfiles := &unstructured.UnstructuredList{}
 	dashboardHardwareProfiles.SetGroupVersionKind(gvk.DashboardHardwareProfile)
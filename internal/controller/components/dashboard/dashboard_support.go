@@ -45,6 +45,7 @@ var (
 		cluster.SelfManagedRhoai: "/rhoai/onprem",
 		cluster.ManagedRhoai:     "/rhoai/addon",
 		cluster.OpenDataHub:      "/odh",
+		cluster.Kubernetes:       "/odh",
 	}
 
 	imagesMap = map[string]string{
@@ -74,7 +75,21 @@ func bffManifestsPath() odhtypes.ManifestInfo {
 }
 
 func computeKustomizeVariable(ctx context.Context, cli client.Client, platform common.Platform, dscispec *dsciv1.DSCInitializationSpec) (map[string]string, error) {
-	consoleLinkDomain, err := cluster.GetDomain(ctx, cli)
+	var consoleLinkDomain string
+	var err error
+
+	// the console link is only deployed on OpenShift, the dashboard being exposed through an Ingress matching any
+	// host on Kubernetes when the platform gateway is not managed
+	if platform == cluster.Kubernetes && !cluster.IsGatewayManaged(dscispec) {
+		return map[string]string{}, nil
+	}
+
+	// the dashboard is exposed in the domain of the platform gateway when managed
+	if cluster.IsGatewayManaged(dscispec) {
+		consoleLinkDomain, err = cluster.GetGatewayDomain(ctx, cli, &dscispec.Gateway.GatewayCommonSpec)
+	} else {
+		consoleLinkDomain, err = cluster.GetDomain(ctx, cli)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error getting console route URL %s : %w", consoleLinkDomain, err)
 	}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -34,6 +35,10 @@ func (s *componentHandler) GetName() string {
 	return componentApi.DataSciencePipelinesComponentName
 }
 
+func (s *componentHandler) PrometheusRulesPrefix() string {
+	return "data-science-pipelines-operator"
+}
+
 func (s *componentHandler) Init(_ common.Platform) error {
 	release := cluster.GetRelease()
 	clusterInfo := cluster.GetClusterInfo()
@@ -70,6 +75,36 @@ func (s *componentHandler) IsEnabled(dsc *dscv1.DataScienceCluster) bool {
 	return dsc.Spec.Components.DataSciencePipelines.ManagementState == operatorv1.Managed
 }
 
+// Capabilities grants the authoring of pipelines and runs through the API servers of the pipelines applications,
+// or the read access to them.
+func (s *componentHandler) Capabilities() []cr.Capability {
+	return []cr.Capability{
+		{
+			Name: "author",
+			Rules: []rbacv1.PolicyRule{
+				{
+					APIGroups: []string{"datasciencepipelinesapplications.opendatahub.io"},
+					Resources: []string{"datasciencepipelinesapplications"},
+					Verbs:     []string{"get", "list", "watch", "create", "update", "patch", "delete"},
+				},
+				{
+					APIGroups: []string{"datasciencepipelinesapplications.opendatahub.io"},
+					Resources: []string{"datasciencepipelinesapplications/api"},
+					Verbs:     []string{"get", "list", "watch", "create", "update", "patch", "delete"},
+				},
+			},
+		},
+		{
+			Name: "view",
+			Rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{"datasciencepipelinesapplications.opendatahub.io"},
+				Resources: []string{"datasciencepipelinesapplications", "datasciencepipelinesapplications/api"},
+				Verbs:     []string{"get", "list", "watch"},
+			}},
+		},
+	}
+}
+
 func (s *componentHandler) UpdateDSCStatus(ctx context.Context, rr *types.ReconciliationRequest) (metav1.ConditionStatus, error) {
 	cs := metav1.ConditionUnknown
 
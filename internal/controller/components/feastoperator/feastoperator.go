@@ -32,6 +32,10 @@ func (s *componentHandler) GetName() string {
 	return componentApi.FeastOperatorComponentName
 }
 
+func (s *componentHandler) PrometheusRulesPrefix() string {
+	return "feast-operator"
+}
+
 func (s *componentHandler) NewCRObject(dsc *dscv1.DataScienceCluster) common.PlatformObject {
 	return &componentApi.FeastOperator{
 		TypeMeta: metav1.TypeMeta{
@@ -6,8 +6,10 @@ import (
 	"fmt"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
@@ -21,6 +23,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 )
 
 const (
@@ -28,6 +31,7 @@ const (
 	authorinoOperator        = "authorino-operator"
 	serviceMeshOperator      = "servicemeshoperator"
 	serverlessOperator       = "serverless-operator"
+	knativeServingNamespace  = "knative-serving"
 	kserveConfigMapName      = "inferenceservice-config"
 	kserveManifestSourcePath = "overlays/odh"
 
@@ -74,6 +78,10 @@ func (s *componentHandler) GetName() string {
 	return componentName
 }
 
+func (s *componentHandler) PrometheusRulesPrefix() string {
+	return "kserve"
+}
+
 // for DSC to get compoment Kserve's CR.
 func (s *componentHandler) NewCRObject(dsc *dscv1.DataScienceCluster) common.PlatformObject {
 	return &componentApi.Kserve{
@@ -97,6 +105,49 @@ func (s *componentHandler) IsEnabled(dsc *dscv1.DataScienceCluster) bool {
 	return dsc.Spec.Components.Kserve.ManagementState == operatorv1.Managed
 }
 
+// IsSupported returns true on every platform, the inference services being exposed through the Ingresses created by
+// KServe on Kubernetes.
+func (s *componentHandler) IsSupported(_ common.Platform) bool {
+	return true
+}
+
+// Capabilities grants the deployment of models with KServe, or the read access to the deployed models.
+func (s *componentHandler) Capabilities() []cr.Capability {
+	resources := []string{"inferenceservices", "inferencegraphs", "servingruntimes", "trainedmodels"}
+
+	return []cr.Capability{
+		{
+			Name: "deploy",
+			Rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{"serving.kserve.io"},
+				Resources: resources,
+				Verbs:     []string{"get", "list", "watch", "create", "update", "patch", "delete"},
+			}},
+		},
+		{
+			Name: "view",
+			Rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{"serving.kserve.io"},
+				Resources: resources,
+				Verbs:     []string{"get", "list", "watch"},
+			}},
+		},
+	}
+}
+
+// MetricsEndpoints exposes the metrics of the KServe controller manager.
+func (s *componentHandler) MetricsEndpoints() []cr.MetricsEndpoint {
+	return []cr.MetricsEndpoint{{
+		Name: "controller-manager",
+		Kind: cr.PodMonitorEndpoint,
+		Selector: map[string]string{
+			labels.ODH.Component(LegacyComponentName): labels.True,
+			"control-plane": "kserve-controller-manager",
+		},
+		Port: intstr.FromInt32(8080),
+	}}
+}
+
 func (s *componentHandler) UpdateDSCStatus(ctx context.Context, rr *types.ReconciliationRequest) (metav1.ConditionStatus, error) {
 	cs := metav1.ConditionUnknown
 
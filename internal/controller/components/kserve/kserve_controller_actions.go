@@ -19,6 +19,8 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	featuresv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/features/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/invariants"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
@@ -37,6 +39,18 @@ func checkPreConditions(ctx context.Context, rr *odhtypes.ReconciliationRequest)
 
 	rr.Conditions.MarkUnknown(status.ConditionServingAvailable)
 
+	// the same invariants are enforced by the DataScienceCluster validating webhook
+	if err := invariants.CheckKserveDeploymentMode(&k.Spec.KserveCommonSpec, rr.DSCI); err != nil {
+		rr.Conditions.MarkFalse(
+			status.ConditionServingAvailable,
+			conditions.WithObservedGeneration(rr.Instance.GetGeneration()),
+			conditions.WithReason(status.InvariantViolationReason),
+			conditions.WithMessage("%s", err.Error()),
+		)
+
+		return odherrors.NewStopErrorW(err)
+	}
+
 	if k.Spec.Serving.ManagementState != operatorv1.Managed {
 		rr.Conditions.MarkFalse(
 			status.ConditionServingAvailable,
@@ -60,7 +74,7 @@ func checkPreConditions(ctx context.Context, rr *odhtypes.ReconciliationRequest)
 
 	var operatorsErr error
 
-	if found, err := cluster.OperatorExists(ctx, rr.Client, serviceMeshOperator); err != nil || !found {
+	if found, err := serviceMeshOperatorExists(ctx, rr); err != nil || !found {
 		if err != nil {
 			return odherrors.NewStopErrorW(err)
 		}
@@ -346,6 +360,39 @@ func cleanUpTemplatedResources(ctx context.Context, rr *odhtypes.ReconciliationR
 	return nil
 }
 
+// addKnativeServingToMesh adds the knative-serving namespace to the mesh of the Sail provider through its labels,
+// in place of the ServiceMeshMember used with the Maistra provider.
+func addKnativeServingToMesh(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	k, ok := rr.Instance.(*componentApi.Kserve)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.Kserve)", rr.Instance)
+	}
+
+	sm := rr.DSCI.Spec.ServiceMesh
+	if sm == nil || cluster.GetServiceMeshProvider(sm) != infrav1.SailProvider {
+		return nil
+	}
+
+	if err := rr.RemoveResources(isServiceMeshMember); err != nil {
+		return odherrors.NewStopErrorW(err)
+	}
+
+	if k.Spec.Serving.ManagementState != operatorv1.Managed || sm.ManagementState != operatorv1.Managed {
+		return nil
+	}
+
+	if _, err := cluster.CreateNamespace(
+		ctx,
+		rr.Client,
+		knativeServingNamespace,
+		cluster.WithLabels(cluster.MeshMemberLabels(sm.ControlPlane.Name, sm.ControlPlane.DataPlaneMode)...),
+	); err != nil {
+		return fmt.Errorf("failed to add namespace %s to the mesh: %w", knativeServingNamespace, err)
+	}
+
+	return nil
+}
+
 func customizeKserveConfigMap(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	k, ok := rr.Instance.(*componentApi.Kserve)
 	if !ok {
@@ -380,7 +427,7 @@ func customizeKserveConfigMap(ctx context.Context, rr *odhtypes.ReconciliationRe
 		}
 	case operatorv1.Removed:
 		if k.Spec.DefaultDeploymentMode == componentApi.Serverless {
-			return errors.New("setting defaultdeployment mode as Serverless is incompatible with having Serving 'Removed'")
+			return invariants.ErrServerlessWithServingRemoved
 		}
 		if k.Spec.DefaultDeploymentMode == "" {
 			logger.Info("Serving is removed, Kserve will default to RawDeployment")
@@ -390,6 +437,25 @@ func customizeKserveConfigMap(ctx context.Context, rr *odhtypes.ReconciliationRe
 		}
 	}
 
+	switch {
+	case cluster.IsGatewayManaged(&rr.DSCI.Spec):
+		gateway := &rr.DSCI.Spec.Gateway.GatewayCommonSpec
+
+		domain, err := cluster.GetGatewayDomain(ctx, rr.Client, gateway)
+		if err != nil {
+			return err
+		}
+
+		name, namespace := cluster.GatewayName(gateway)
+		if err := updateInferenceCMGateway(&kserveConfigMap, namespace+"/"+name, domain); err != nil {
+			return err
+		}
+	case rr.Release.Name == cluster.Kubernetes:
+		if err := updateInferenceCMIngress(&kserveConfigMap); err != nil {
+			return err
+		}
+	}
+
 	if err = replaceResourceAtIndex(rr.Resources, cmidx, &kserveConfigMap); err != nil {
 		return err
 	}
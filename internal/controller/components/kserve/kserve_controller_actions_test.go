@@ -8,13 +8,17 @@ import (
 	operatorv1 "github.com/openshift/api/operator/v1"
 	ofapiv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	ofapiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
 	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/invariants"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/template"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
@@ -59,6 +63,7 @@ func TestCheckPreConditions_ServiceMeshUnmanaged(t *testing.T) {
 
 	ks := componentApi.Kserve{}
 	ks.Spec.Serving.ManagementState = operatorv1.Managed
+	ks.Spec.DefaultDeploymentMode = componentApi.Serverless
 
 	dsci := dsciv1.DSCInitialization{}
 	dsci.Spec.ServiceMesh = &infrav1.ServiceMeshSpec{
@@ -74,12 +79,13 @@ func TestCheckPreConditions_ServiceMeshUnmanaged(t *testing.T) {
 
 	err = checkPreConditions(ctx, &rr)
 	g.Expect(err).Should(
-		MatchError(ContainSubstring(status.ServiceMeshNeedConfiguredMessage)),
+		MatchError(invariants.ErrServerlessWithServiceMeshRemoved.Error()),
 	)
 	g.Expect(&ks).Should(
-		WithTransform(resources.ToUnstructured,
+		WithTransform(resources.ToUnstructured, And(
 			jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "%s"`, status.ConditionServingAvailable, metav1.ConditionFalse),
-		),
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionServingAvailable, status.InvariantViolationReason),
+		)),
 	)
 }
 
@@ -251,6 +257,130 @@ func TestCheckPreConditions_ServiceMeshManaged_AllOperator(t *testing.T) {
 	)
 }
 
+func TestCheckPreConditions_ServiceMeshManaged_SailOperator(t *testing.T) {
+	ctx := t.Context()
+	g := NewWithT(t)
+
+	cli, err := fakeclient.New(
+		fakeclient.WithObjects(
+			&ofapiv1alpha1.Subscription{ObjectMeta: metav1.ObjectMeta{
+				Name: cluster.OSSM3OperatorName,
+			}},
+			&ofapiv2.OperatorCondition{ObjectMeta: metav1.ObjectMeta{
+				Name: serverlessOperator,
+			}},
+		),
+	)
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	ks := componentApi.Kserve{}
+	ks.Spec.Serving.ManagementState = operatorv1.Managed
+
+	dsci := dsciv1.DSCInitialization{}
+	dsci.Spec.ServiceMesh = &infrav1.ServiceMeshSpec{
+		ManagementState: operatorv1.Managed,
+		Provider:        infrav1.SailProvider,
+	}
+	dsci.Status.Conditions = []common.Condition{
+		{
+			Type:   status.CapabilityServiceMesh,
+			Status: metav1.ConditionTrue,
+			Reason: "ServiceMeshReady",
+		},
+	}
+
+	rr := types.ReconciliationRequest{
+		Client:     cli,
+		Instance:   &ks,
+		DSCI:       &dsci,
+		Conditions: conditions.NewManager(&ks, status.ConditionTypeReady),
+	}
+
+	err = checkPreConditions(ctx, &rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(&ks).Should(
+		WithTransform(resources.ToUnstructured, And(
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "%s"`, status.ConditionServingAvailable, metav1.ConditionTrue),
+		)),
+	)
+}
+
+func TestAddKnativeServingToMesh_SailRemovesServiceMeshMember(t *testing.T) {
+	ctx := t.Context()
+	g := NewWithT(t)
+
+	cli, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	ks := componentApi.Kserve{}
+	ks.Spec.Serving.ManagementState = operatorv1.Removed
+
+	dsci := dsciv1.DSCInitialization{}
+	dsci.Spec.ServiceMesh = &infrav1.ServiceMeshSpec{
+		ManagementState: operatorv1.Managed,
+		Provider:        infrav1.SailProvider,
+	}
+
+	smm := unstructured.Unstructured{}
+	smm.SetGroupVersionKind(gvk.ServiceMeshMember)
+	smm.SetName("default")
+	smm.SetNamespace(knativeServingNamespace)
+
+	cm := unstructured.Unstructured{}
+	cm.SetGroupVersionKind(gvk.ConfigMap)
+	cm.SetName(kserveConfigMapName)
+
+	rr := types.ReconciliationRequest{
+		Client:    cli,
+		Instance:  &ks,
+		DSCI:      &dsci,
+		Resources: []unstructured.Unstructured{smm, cm},
+	}
+
+	err = addKnativeServingToMesh(ctx, &rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(rr.Resources).Should(HaveLen(1))
+	g.Expect(rr.Resources[0].GroupVersionKind()).Should(Equal(gvk.ConfigMap))
+}
+
+func TestUpdateInferenceCMGateway(t *testing.T) {
+	g := NewWithT(t)
+
+	cm := corev1.ConfigMap{
+		Data: map[string]string{
+			IngressConfigKeyName: `{"ingressGateway": "knative-serving/knative-ingress-gateway", "disableIngressCreation": true}`,
+		},
+	}
+
+	err := updateInferenceCMGateway(&cm, "openshift-ingress/data-science-gateway", "apps.example.com")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(cm.Data[IngressConfigKeyName]).Should(And(
+		jq.Match(`.enableGatewayApi == true`),
+		jq.Match(`.kserveIngressGateway == "openshift-ingress/data-science-gateway"`),
+		jq.Match(`.ingressDomain == "apps.example.com"`),
+		jq.Match(`.disableIngressCreation == false`),
+		jq.Match(`.ingressGateway == "knative-serving/knative-ingress-gateway"`),
+	))
+}
+
+func TestUpdateInferenceCMIngress(t *testing.T) {
+	g := NewWithT(t)
+
+	cm := corev1.ConfigMap{
+		Data: map[string]string{
+			IngressConfigKeyName: `{"ingressGateway": "knative-serving/knative-ingress-gateway", "disableIngressCreation": true}`,
+		},
+	}
+
+	err := updateInferenceCMIngress(&cm)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(cm.Data[IngressConfigKeyName]).Should(And(
+		jq.Match(`.disableIngressCreation == false`),
+		jq.Match(`.ingressGateway == "knative-serving/knative-ingress-gateway"`),
+	))
+}
+
 func TestCheckPreConditions_ServiceMeshConditionNotTrue(t *testing.T) {
 	ctx := t.Context()
 	g := NewWithT(t)
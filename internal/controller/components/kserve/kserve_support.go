@@ -24,6 +24,7 @@ import (
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
 	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
@@ -52,16 +53,24 @@ var isRequiredOperators = predicate.Funcs{
 		return false
 	},
 	CreateFunc: func(e event.CreateEvent) bool {
-		return strings.HasPrefix(e.Object.GetName(), serverlessOperator) || strings.HasPrefix(e.Object.GetName(), serviceMeshOperator)
+		return isRequiredOperator(e.Object.GetName())
 	},
 	DeleteFunc: func(e event.DeleteEvent) bool {
-		return strings.HasPrefix(e.Object.GetName(), serverlessOperator) || strings.HasPrefix(e.Object.GetName(), serviceMeshOperator)
+		return isRequiredOperator(e.Object.GetName())
 	},
 	GenericFunc: func(e event.GenericEvent) bool {
 		return false
 	},
 }
 
+// isRequiredOperator returns true for the operators KServe Serverless depends on, the servicemeshoperator prefix
+// matching both OpenShift Service Mesh 2 and 3.
+func isRequiredOperator(name string) bool {
+	return strings.HasPrefix(name, serverlessOperator) ||
+		strings.HasPrefix(name, serviceMeshOperator) ||
+		strings.HasPrefix(name, cluster.SailOperatorName)
+}
+
 func kserveManifestInfo(sourcePath string) odhtypes.ManifestInfo {
 	return odhtypes.ManifestInfo{
 		Path:       odhdeploy.DefaultManifestPath,
@@ -76,8 +85,9 @@ func createServingCertResource(ctx context.Context, cli client.Client, dscispec
 
 	switch kserve.Spec.Serving.IngressGateway.Certificate.Type {
 	case infrav1.SelfSigned:
-		return cluster.CreateSelfSignedCertificate(ctx, cli, secretName,
+		return cluster.CreateCertificate(ctx, cli, secretName,
 			domain, dscispec.ServiceMesh.ControlPlane.Namespace,
+			cluster.NewCertificateOptions(dscispec.Certificates),
 			cluster.OwnedBy(kserve, cli.Scheme()))
 	case infrav1.Provided:
 		return nil
@@ -193,6 +203,49 @@ func updateInferenceCM(inferenceServiceConfigMap *corev1.ConfigMap, defaultmode
 	return nil
 }
 
+// updateInferenceCMGateway exposes the inference services through HTTPRoutes attached to the platform Gateway,
+// named namespace/name, in its domain.
+func updateInferenceCMGateway(inferenceServiceConfigMap *corev1.ConfigMap, gateway string, domain string) error {
+	var ingressData map[string]interface{}
+	if err := json.Unmarshal([]byte(inferenceServiceConfigMap.Data[IngressConfigKeyName]), &ingressData); err != nil {
+		return fmt.Errorf("error retrieving value for key '%s' from configmap %s. %w", IngressConfigKeyName, kserveConfigMapName, err)
+	}
+
+	ingressData["enableGatewayApi"] = true
+	ingressData["kserveIngressGateway"] = gateway
+	ingressData["ingressDomain"] = domain
+	ingressData["urlScheme"] = "https"
+	// the HTTPRoutes of the raw deployments are created with their ingress
+	ingressData["disableIngressCreation"] = false
+
+	ingressDataBytes, err := json.MarshalIndent(ingressData, "", " ")
+	if err != nil {
+		return fmt.Errorf("could not set values in configmap %s. %w", kserveConfigMapName, err)
+	}
+	inferenceServiceConfigMap.Data[IngressConfigKeyName] = string(ingressDataBytes)
+
+	return nil
+}
+
+// updateInferenceCMIngress lets KServe create the Ingresses of the raw deployments, exposed by the Routes of the model
+// controller on OpenShift.
+func updateInferenceCMIngress(inferenceServiceConfigMap *corev1.ConfigMap) error {
+	var ingressData map[string]interface{}
+	if err := json.Unmarshal([]byte(inferenceServiceConfigMap.Data[IngressConfigKeyName]), &ingressData); err != nil {
+		return fmt.Errorf("error retrieving value for key '%s' from configmap %s. %w", IngressConfigKeyName, kserveConfigMapName, err)
+	}
+
+	ingressData["disableIngressCreation"] = false
+
+	ingressDataBytes, err := json.MarshalIndent(ingressData, "", " ")
+	if err != nil {
+		return fmt.Errorf("could not set values in configmap %s. %w", kserveConfigMapName, err)
+	}
+	inferenceServiceConfigMap.Data[IngressConfigKeyName] = string(ingressDataBytes)
+
+	return nil
+}
+
 func getIndexedResource(rs []unstructured.Unstructured, obj any, g schema.GroupVersionKind, name string) (int, error) {
 	var idx = -1
 	for i, r := range rs {
@@ -297,6 +350,20 @@ func isForDependency(s string) func(u *unstructured.Unstructured) bool {
 	}
 }
 
+func isServiceMeshMember(u *unstructured.Unstructured) bool {
+	return u.GroupVersionKind() == gvk.ServiceMeshMember
+}
+
+// serviceMeshOperatorExists returns true when the operator of the service mesh provider configured in the DSCI is
+// installed.
+func serviceMeshOperatorExists(ctx context.Context, rr *odhtypes.ReconciliationRequest) (bool, error) {
+	if cluster.GetServiceMeshProvider(rr.DSCI.Spec.ServiceMesh) == infrav1.SailProvider {
+		return cluster.SailOperatorExists(ctx, rr.Client)
+	}
+
+	return cluster.OperatorExists(ctx, rr.Client, serviceMeshOperator)
+}
+
 func isKserveOwnerRef(or metav1.OwnerReference) bool {
 	return or.APIVersion == componentApi.GroupVersion.String() &&
 		or.Kind == componentApi.KserveKind
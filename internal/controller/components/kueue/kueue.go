@@ -16,7 +16,6 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components"
 	cr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/registry"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
-	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
@@ -29,11 +28,6 @@ const (
 	kueueCRDname           = "kueues.kueue.openshift.io"
 )
 
-var (
-	ErrKueueOperatorAlreadyInstalled = odherrors.NewStopError(status.KueueOperatorAlreadyInstalledMessage)
-	ErrKueueOperatorNotInstalled     = odherrors.NewStopError(status.KueueOperatorNotInstalledMessage)
-)
-
 type componentHandler struct{}
 
 func init() { //nolint:gochecknoinits
@@ -44,6 +38,10 @@ func (s *componentHandler) GetName() string {
 	return componentApi.KueueComponentName
 }
 
+func (s *componentHandler) PrometheusRulesPrefix() string {
+	return "kueue"
+}
+
 func (s *componentHandler) NewCRObject(dsc *dscv1.DataScienceCluster) common.PlatformObject {
 	return &componentApi.Kueue{
 		TypeMeta: metav1.TypeMeta{
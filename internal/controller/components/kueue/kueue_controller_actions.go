@@ -13,8 +13,7 @@ import (
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
-	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
-	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/invariants"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
@@ -27,39 +26,9 @@ func checkPreConditions(ctx context.Context, rr *odhtypes.ReconciliationRequest)
 		return fmt.Errorf("resource instance %v is not a componentApi.Kueue)", rr.Instance)
 	}
 
-	rConfig, err := cluster.HasCRD(ctx, rr.Client, gvk.MultiKueueConfigV1Alpha1)
-	if err != nil {
-		return odherrors.NewStopError("failed to check %s CRDs version: %w", gvk.MultiKueueConfigV1Alpha1, err)
-	}
-
-	rCluster, err := cluster.HasCRD(ctx, rr.Client, gvk.MultikueueClusterV1Alpha1)
-	if err != nil {
-		return odherrors.NewStopError("failed to check %s CRDs version: %w", gvk.MultikueueClusterV1Alpha1, err)
-	}
-
-	if rConfig || rCluster {
-		return odherrors.NewStopError(status.MultiKueueCRDMessage)
-	}
-
-	switch kueueCRInstance.Spec.ManagementState {
-	case operatorv1.Managed:
-		if found, err := cluster.OperatorExists(ctx, rr.Client, kueueOperator); err != nil || found {
-			if err != nil {
-				return odherrors.NewStopErrorW(err)
-			}
-
-			return odherrors.NewStopErrorW(ErrKueueOperatorAlreadyInstalled)
-		}
-	case operatorv1.Unmanaged:
-		if found, err := cluster.OperatorExists(ctx, rr.Client, kueueOperator); err != nil || !found {
-			if err != nil {
-				return odherrors.NewStopErrorW(err)
-			}
-
-			return odherrors.NewStopErrorW(ErrKueueOperatorNotInstalled)
-		}
-	default:
-		return nil
+	// the rules are shared with the DataScienceCluster validating webhook
+	if err := invariants.CheckKueue(ctx, rr.Client, kueueCRInstance.Spec.ManagementState); err != nil {
+		return odherrors.NewStopErrorW(err)
 	}
 
 	return nil
@@ -32,6 +32,10 @@ func (s *componentHandler) GetName() string {
 	return componentApi.LlamaStackOperatorComponentName
 }
 
+func (s *componentHandler) PrometheusRulesPrefix() string {
+	return "llama-stack-k8s-operator"
+}
+
 func (s *componentHandler) NewCRObject(dsc *dscv1.DataScienceCluster) common.PlatformObject {
 	return &componentApi.LlamaStackOperator{
 		TypeMeta: metav1.TypeMeta{
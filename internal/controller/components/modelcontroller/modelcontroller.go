@@ -31,6 +31,10 @@ func (s *componentHandler) GetName() string {
 	return componentApi.ModelControllerComponentName
 }
 
+func (s *componentHandler) PrometheusRulesPrefix() string {
+	return "odh-model-controller"
+}
+
 func (s *componentHandler) NewCRObject(dsc *dscv1.DataScienceCluster) common.PlatformObject {
 	// extra logic to set the management .spec.component.managementState, to not leave blank {}
 	kState := operatorv1.Removed
@@ -102,6 +106,12 @@ func (s *componentHandler) IsEnabled(dsc *dscv1.DataScienceCluster) bool {
 	}
 }
 
+// IsSupported returns true on every platform, as KServe depends on the model controller, its Templates being only
+// deployed on OpenShift.
+func (s *componentHandler) IsSupported(_ common.Platform) bool {
+	return true
+}
+
 func (s *componentHandler) UpdateDSCStatus(ctx context.Context, rr *types.ReconciliationRequest) (metav1.ConditionStatus, error) {
 	cs := metav1.ConditionUnknown
 
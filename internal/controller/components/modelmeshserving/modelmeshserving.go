@@ -32,6 +32,10 @@ func (s *componentHandler) GetName() string {
 	return componentApi.ModelMeshServingComponentName
 }
 
+func (s *componentHandler) PrometheusRulesPrefix() string {
+	return "model-mesh"
+}
+
 func (s *componentHandler) Init(_ common.Platform) error {
 	// Update image parameters
 	if err := odhdeploy.ApplyParams(manifestsPath().String(), "params.env", imageParamMap); err != nil {
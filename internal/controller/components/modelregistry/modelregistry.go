@@ -6,8 +6,10 @@ import (
 	"fmt"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
@@ -20,6 +22,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 )
 
 type componentHandler struct{}
@@ -32,6 +35,10 @@ func (s *componentHandler) GetName() string {
 	return componentApi.ModelRegistryComponentName
 }
 
+func (s *componentHandler) PrometheusRulesPrefix() string {
+	return "model-registry-operator"
+}
+
 func (s *componentHandler) Init(_ common.Platform) error {
 	mi := baseManifestInfo(BaseManifestsSourcePath)
 
@@ -64,6 +71,43 @@ func (s *componentHandler) IsEnabled(dsc *dscv1.DataScienceCluster) bool {
 	return dsc.Spec.Components.ModelRegistry.ManagementState == operatorv1.Managed
 }
 
+// Capabilities grants the management of the model registries, or the read access to them.
+func (s *componentHandler) Capabilities() []cr.Capability {
+	return []cr.Capability{
+		{
+			Name: "manage",
+			Rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{"modelregistry.opendatahub.io"},
+				Resources: []string{"modelregistries"},
+				Verbs:     []string{"get", "list", "watch", "create", "update", "patch", "delete"},
+			}},
+		},
+		{
+			Name: "view",
+			Rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{"modelregistry.opendatahub.io"},
+				Resources: []string{"modelregistries"},
+				Verbs:     []string{"get", "list", "watch"},
+			}},
+		},
+	}
+}
+
+// MetricsEndpoints exposes the metrics of the model registry operator, served over https.
+func (s *componentHandler) MetricsEndpoints() []cr.MetricsEndpoint {
+	return []cr.MetricsEndpoint{{
+		Name: "controller-manager",
+		Kind: cr.ServiceMonitorEndpoint,
+		Selector: map[string]string{
+			labels.ODH.Component(LegacyComponentName): labels.True,
+		},
+		Port: intstr.FromInt32(8443),
+		TLS: &cr.MetricsEndpointTLS{
+			InsecureSkipVerify: true,
+		},
+	}}
+}
+
 func (s *componentHandler) UpdateDSCStatus(ctx context.Context, rr *types.ReconciliationRequest) (metav1.ConditionStatus, error) {
 	cs := metav1.ConditionUnknown
 
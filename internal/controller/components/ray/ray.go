@@ -32,6 +32,10 @@ func (s *componentHandler) GetName() string {
 	return componentApi.RayComponentName
 }
 
+func (s *componentHandler) PrometheusRulesPrefix() string {
+	return "ray"
+}
+
 func (s *componentHandler) NewCRObject(dsc *dscv1.DataScienceCluster) common.PlatformObject {
 	return &componentApi.Ray{
 		TypeMeta: metav1.TypeMeta{
@@ -62,6 +66,12 @@ func (s *componentHandler) IsEnabled(dsc *dscv1.DataScienceCluster) bool {
 	return dsc.Spec.Components.Ray.ManagementState == operatorv1.Managed
 }
 
+// IsSupported returns true on every platform, the SecurityContextConstraints of the KubeRay operator being only
+// deployed on OpenShift.
+func (s *componentHandler) IsSupported(_ common.Platform) bool {
+	return true
+}
+
 func (s *componentHandler) UpdateDSCStatus(ctx context.Context, rr *types.ReconciliationRequest) (metav1.ConditionStatus, error) {
 	cs := metav1.ConditionUnknown
 
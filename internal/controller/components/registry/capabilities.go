@@ -0,0 +1,21 @@
+package registry
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// Capability is a set of permissions on the resources of a component, granted to the roles of the Auth CR
+// referencing it as <component>/<name>.
+type Capability struct {
+	// Name identifies the capability among the capabilities of the component.
+	Name string
+	// Rules are the permissions of the ClusterRole fragment generated for the capability.
+	Rules []rbacv1.PolicyRule
+}
+
+// CapabilitiesProvider is implemented by the ComponentHandlers contributing capabilities to the roles of the Auth CR,
+// the Auth service generates a ClusterRole fragment for each of them, aggregated into the ClusterRoles of the roles
+// referencing it.
+type CapabilitiesProvider interface {
+	Capabilities() []Capability
+}
@@ -0,0 +1,43 @@
+package registry
+
+import (
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// MetricsEndpointKind is the kind of monitor generated to scrape a MetricsEndpoint.
+type MetricsEndpointKind string
+
+const (
+	// ServiceMonitorEndpoint scrapes the endpoints of the selected Services.
+	ServiceMonitorEndpoint MetricsEndpointKind = "ServiceMonitor"
+	// PodMonitorEndpoint scrapes the selected Pods directly.
+	PodMonitorEndpoint MetricsEndpointKind = "PodMonitor"
+)
+
+// MetricsEndpoint describes an endpoint exposing the metrics of a component.
+type MetricsEndpoint struct {
+	// Name identifies the endpoint among the endpoints of the component, the generated monitor
+	// is named <component>-<name>.
+	Name string
+	Kind MetricsEndpointKind
+	// Selector holds the labels of the Services or of the Pods exposing the metrics, depending on Kind.
+	Selector map[string]string
+	// Port is the name or the number of the container port serving the metrics.
+	Port intstr.IntOrString
+	// Path defaults to /metrics.
+	Path string
+	// TLS, when set, makes the endpoint scraped over https.
+	TLS *MetricsEndpointTLS
+}
+
+// MetricsEndpointTLS holds the TLS configuration used to scrape a MetricsEndpoint.
+type MetricsEndpointTLS struct {
+	ServerName         string
+	InsecureSkipVerify bool
+}
+
+// MetricsEndpointsProvider is implemented by the ComponentHandlers exposing metrics, the Monitoring service
+// generates a ServiceMonitor or a PodMonitor for each of the endpoints once the component is enabled and ready.
+type MetricsEndpointsProvider interface {
+	MetricsEndpoints() []MetricsEndpoint
+}
@@ -0,0 +1,22 @@
+package registry
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+// PlatformSupport is implemented by the ComponentHandlers whose manifests can be deployed on upstream Kubernetes,
+// the other components are only deployed on OpenShift.
+type PlatformSupport interface {
+	IsSupported(platform common.Platform) bool
+}
+
+// IsSupported returns whether the manifests of the component can be deployed on the platform. The components not
+// implementing PlatformSupport are not supported on Kubernetes.
+func IsSupported(ch ComponentHandler, platform common.Platform) bool {
+	if s, ok := ch.(PlatformSupport); ok {
+		return s.IsSupported(platform)
+	}
+
+	return platform != cluster.Kubernetes
+}
@@ -0,0 +1,17 @@
+package registry
+
+// PrometheusRules is implemented by the ComponentHandlers whose rules are shipped in the legacy prometheus
+// ConfigMap of the managed clusters, as <prefix>-recording.rules and <prefix>-alerting.rules files.
+type PrometheusRules interface {
+	PrometheusRulesPrefix() string
+}
+
+// PrometheusRulesPrefix returns the prefix of the rules files of the component in the legacy prometheus ConfigMap,
+// or an empty string when the component has none.
+func PrometheusRulesPrefix(ch ComponentHandler) string {
+	if r, ok := ch.(PrometheusRules); ok {
+		return r.PrometheusRulesPrefix()
+	}
+
+	return ""
+}
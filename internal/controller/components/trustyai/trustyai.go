@@ -8,6 +8,7 @@ import (
 	operatorv1 "github.com/openshift/api/operator/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
@@ -20,6 +21,7 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 )
 
 type componentHandler struct{}
@@ -32,6 +34,10 @@ func (s *componentHandler) GetName() string {
 	return componentApi.TrustyAIComponentName
 }
 
+func (s *componentHandler) PrometheusRulesPrefix() string {
+	return "trustyai"
+}
+
 func (s *componentHandler) NewCRObject(dsc *dscv1.DataScienceCluster) common.PlatformObject {
 	return &componentApi.TrustyAI{
 		TypeMeta: metav1.TypeMeta{
@@ -64,6 +70,18 @@ func (s *componentHandler) IsEnabled(dsc *dscv1.DataScienceCluster) bool {
 	return dsc.Spec.Components.TrustyAI.ManagementState == operatorv1.Managed
 }
 
+// MetricsEndpoints exposes the metrics of the TrustyAI service operator.
+func (s *componentHandler) MetricsEndpoints() []cr.MetricsEndpoint {
+	return []cr.MetricsEndpoint{{
+		Name: "controller-manager",
+		Kind: cr.PodMonitorEndpoint,
+		Selector: map[string]string{
+			labels.ODH.Component(LegacyComponentName): labels.True,
+		},
+		Port: intstr.FromInt32(8080),
+	}}
+}
+
 func (s *componentHandler) UpdateDSCStatus(ctx context.Context, rr *types.ReconciliationRequest) (metav1.ConditionStatus, error) {
 	cs := metav1.ConditionUnknown
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -32,6 +33,10 @@ func (s *componentHandler) GetName() string {
 	return componentApi.WorkbenchesComponentName
 }
 
+func (s *componentHandler) PrometheusRulesPrefix() string {
+	return "workbenches"
+}
+
 func (s *componentHandler) NewCRObject(dsc *dscv1.DataScienceCluster) common.PlatformObject {
 	return &componentApi.Workbenches{
 		TypeMeta: metav1.TypeMeta{
@@ -132,6 +137,28 @@ func (s *componentHandler) IsEnabled(dsc *dscv1.DataScienceCluster) bool {
 	return dsc.Spec.Components.Workbenches.ManagementState == operatorv1.Managed
 }
 
+// Capabilities grants the creation of workbenches, or the read access to them.
+func (s *componentHandler) Capabilities() []cr.Capability {
+	return []cr.Capability{
+		{
+			Name: "author",
+			Rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{"kubeflow.org"},
+				Resources: []string{"notebooks"},
+				Verbs:     []string{"get", "list", "watch", "create", "update", "patch", "delete"},
+			}},
+		},
+		{
+			Name: "view",
+			Rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{"kubeflow.org"},
+				Resources: []string{"notebooks"},
+				Verbs:     []string{"get", "list", "watch"},
+			}},
+		},
+	}
+}
+
 func (s *componentHandler) UpdateDSCStatus(ctx context.Context, rr *types.ReconciliationRequest) (metav1.ConditionStatus, error) {
 	cs := metav1.ConditionUnknown
 
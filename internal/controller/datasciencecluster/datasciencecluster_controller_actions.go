@@ -85,7 +85,7 @@ func provisionComponents(_ context.Context, rr *odhtype.ReconciliationRequest) e
 	rr.Generated = true
 
 	err := cr.ForEach(func(component cr.ComponentHandler) error {
-		if !component.IsEnabled(instance) {
+		if !component.IsEnabled(instance) || !cr.IsSupported(component, rr.Release.Name) {
 			return nil
 		}
 
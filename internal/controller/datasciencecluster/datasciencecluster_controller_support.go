@@ -36,6 +36,7 @@ func computeComponentsStatus(
 	}
 
 	notReadyComponents := make([]string, 0)
+	unsupportedComponents := make([]string, 0)
 	managedComponent := 0
 
 	err := reg.ForEach(func(component cr.ComponentHandler) error {
@@ -49,6 +50,11 @@ func computeComponentsStatus(
 			return nil
 		}
 
+		if !cr.IsSupported(component, rr.Release.Name) {
+			unsupportedComponents = append(unsupportedComponents, component.GetName())
+			return nil
+		}
+
 		managedComponent++
 
 		if cs == metav1.ConditionFalse {
@@ -66,6 +72,13 @@ func computeComponentsStatus(
 			Reason:  status.NotReadyReason,
 			Message: fmt.Sprintf("Some components are not ready: %s", strings.Join(notReadyComponents, ",")),
 		})
+	case len(unsupportedComponents) > 0:
+		rr.Conditions.SetCondition(common.Condition{
+			Type:    status.ConditionTypeComponentsReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  status.UnsupportedPlatformReason,
+			Message: fmt.Sprintf("Some components are not supported on %s: %s", rr.Release.Name, strings.Join(unsupportedComponents, ",")),
+		})
 	case managedComponent == 0:
 		rr.Conditions.SetCondition(common.Condition{
 			Type:     status.ConditionTypeComponentsReady,
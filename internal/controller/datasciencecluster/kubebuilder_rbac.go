@@ -193,6 +193,9 @@ package datasciencecluster
 // +kubebuilder:rbac:groups="kueue.openshift.io",resources=kueues,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="kueue.openshift.io",resources=kueues/status,verbs=get;update;patch
 
+// Connection rollout
+// +kubebuilder:rbac:groups="kubeflow.org",resources=notebooks,verbs=get;list;watch;patch
+
 // CFO
 //+kubebuilder:rbac:groups=components.platform.opendatahub.io,resources=codeflares,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=components.platform.opendatahub.io,resources=codeflares/status,verbs=get;update;patch
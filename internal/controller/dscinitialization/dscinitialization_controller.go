@@ -51,6 +51,7 @@ import (
 	rp "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/resources"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/logger"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade"
 )
@@ -274,6 +275,12 @@ func (r *DSCInitializationReconciler) Reconcile(ctx context.Context, req ctrl.Re
 			}
 		}
 
+		// handle changes to Gateway section of DSCI spec
+		if err := r.handleGateway(ctx, instance); err != nil {
+			log.Error(err, "failed to handle change to Gateway spec in DSCI")
+			return ctrl.Result{}, err
+		}
+
 		// Create Auth
 		if err = r.CreateAuth(ctx, platform); err != nil {
 			log.Info("failed to create Auth")
@@ -296,12 +303,13 @@ func (r *DSCInitializationReconciler) Reconcile(ctx context.Context, req ctrl.Re
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *DSCInitializationReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		// add predicates prevents meaningless reconciliations from being triggered
 		// not use WithEventFilter() because it conflict with secret and configmap predicate
 		For(
 			&dsciv1.DSCInitialization{},
-			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{})),
+			// annotations acknowledge the monitoring storage migrations
+			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}, predicate.AnnotationChangedPredicate{})),
 		).
 		Owns(
 			&corev1.Namespace{},
@@ -336,9 +344,6 @@ func (r *DSCInitializationReconciler) SetupWithManager(ctx context.Context, mgr
 		Owns(
 			&corev1.Service{},
 			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}))).
-		Owns(
-			&routev1.Route{},
-			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}))).
 		Owns(&corev1.PersistentVolumeClaim{},
 			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}))).
 		Owns(&serviceApi.ServiceMesh{},
@@ -348,6 +353,8 @@ func (r *DSCInitializationReconciler) SetupWithManager(ctx context.Context, mgr
 					predicate.LabelChangedPredicate{},
 					rp.ServiceMeshStatusCondition,
 				))).
+		Owns(&serviceApi.GatewayConfig{},
+			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}))).
 		Watches(
 			&dscv1.DataScienceCluster{},
 			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
@@ -368,8 +375,16 @@ func (r *DSCInitializationReconciler) SetupWithManager(ctx context.Context, mgr
 		Watches(
 			&serviceApi.Auth{},
 			handler.EnqueueRequestsFromMapFunc(r.watchAuthResource),
-		).
-		Complete(r)
+		)
+
+	// Routes are not served on Kubernetes
+	if !cluster.IsKubernetes() {
+		b = b.Owns(
+			&routev1.Route{},
+			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{})))
+	}
+
+	return b.Complete(r)
 }
 
 func (r *DSCInitializationReconciler) watchMonitoringConfigMapResource(ctx context.Context, a client.Object) []reconcile.Request {
@@ -472,6 +487,14 @@ func (r *DSCInitializationReconciler) newMonitoringCR(ctx context.Context, dsci
 
 	defaultMonitoring.Spec.Traces = dsci.Spec.Monitoring.Traces
 	defaultMonitoring.Spec.Alerting = dsci.Spec.Monitoring.Alerting
+	defaultMonitoring.Spec.Logs = dsci.Spec.Monitoring.Logs
+
+	// propagate the acknowledgement of a traces storage migration
+	if backend, ok := dsci.GetAnnotations()[annotations.StorageMigrationAnnotation]; ok {
+		defaultMonitoring.SetAnnotations(map[string]string{
+			annotations.StorageMigrationAnnotation: backend,
+		})
+	}
 
 	if err := controllerutil.SetOwnerReference(dsci, defaultMonitoring, r.Client.Scheme()); err != nil {
 		return err
@@ -0,0 +1,64 @@
+package dscinitialization
+
+import (
+	"context"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+func (r *DSCInitializationReconciler) handleGateway(ctx context.Context, dscInit *dsciv1.DSCInitialization) error {
+	log := logf.FromContext(ctx)
+
+	if !cluster.IsGatewayManaged(&dscInit.Spec) {
+		log.Info("Gateway not managed in DSCI, deleting GatewayConfig CR if present")
+
+		return r.deleteGatewayConfig(ctx)
+	}
+
+	desiredGatewayConfig := &serviceApi.GatewayConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       serviceApi.GatewayConfigKind,
+			APIVersion: serviceApi.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: serviceApi.GatewayInstanceName,
+		},
+		Spec: serviceApi.GatewayConfigSpec{
+			GatewayCommonSpec: dscInit.Spec.Gateway.GatewayCommonSpec,
+		},
+	}
+	if err := controllerutil.SetControllerReference(dscInit, desiredGatewayConfig, r.Client.Scheme()); err != nil {
+		return err
+	}
+
+	return resources.Apply(
+		ctx,
+		r.Client,
+		desiredGatewayConfig,
+		client.FieldOwner(fieldManager),
+		client.ForceOwnership,
+	)
+}
+
+func (r *DSCInitializationReconciler) deleteGatewayConfig(ctx context.Context) error {
+	gc := &serviceApi.GatewayConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: serviceApi.GatewayInstanceName,
+		},
+	}
+
+	if err := r.Client.Delete(ctx, gc); err != nil && !k8serr.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
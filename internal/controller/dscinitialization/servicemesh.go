@@ -93,10 +93,13 @@ func (r *DSCInitializationReconciler) handleServiceMesh(ctx context.Context, dsc
 		},
 		Spec: serviceApi.ServiceMeshSpec{
 			ManagementState: dscInit.Spec.ServiceMesh.ManagementState,
+			Provider:        dscInit.Spec.ServiceMesh.Provider,
 			ControlPlane: serviceApi.ServiceMeshControlPlaneSpec{
 				Name:              dscInit.Spec.ServiceMesh.ControlPlane.Name,
 				Namespace:         dscInit.Spec.ServiceMesh.ControlPlane.Namespace,
 				MetricsCollection: dscInit.Spec.ServiceMesh.ControlPlane.MetricsCollection,
+				DataPlaneMode:     dscInit.Spec.ServiceMesh.ControlPlane.DataPlaneMode,
+				Version:           dscInit.Spec.ServiceMesh.ControlPlane.Version,
 			},
 			Auth: serviceApi.ServiceMeshAuthSpec{
 				Namespace: dscInit.Spec.ServiceMesh.Auth.Namespace,
@@ -0,0 +1,157 @@
+// Package invariants contains the platform level rules the components configuration of a DataScienceCluster
+// must satisfy, given the DSCInitialization and the state of the cluster.
+//
+// The rules are evaluated at admission time by the DataScienceCluster validating webhook, and as pre-conditions
+// by the component reconcilers, so that invalid combinations are denied upfront with the same message that
+// would otherwise only be reported on reconcile.
+package invariants
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+const (
+	kueueOperator = "kueue-operator"
+)
+
+var (
+	ErrServerlessWithServingRemoved = errors.New(
+		"setting defaultdeployment mode as Serverless is incompatible with having Serving 'Removed'")
+	ErrServerlessWithServiceMeshRemoved = errors.New(
+		"KServe Serverless deployment mode requires ServiceMesh, set .spec.serviceMesh.managementState to 'Managed' " +
+			"in DSCInitialization or .spec.components.kserve.defaultDeploymentMode to 'RawDeployment'")
+	ErrServerlessWithAmbientMesh = errors.New(
+		"KServe Serverless deployment mode requires the Sidecar data plane mode of the service mesh, set " +
+			".spec.serviceMesh.controlPlane.dataPlaneMode to 'Sidecar' in DSCInitialization or " +
+			".spec.components.kserve.defaultDeploymentMode to 'RawDeployment'")
+	ErrMultiKueueCRDs                = errors.New(status.MultiKueueCRDMessage)
+	ErrKueueOperatorAlreadyInstalled = errors.New(status.KueueOperatorAlreadyInstalledMessage)
+	ErrKueueOperatorNotInstalled     = errors.New(status.KueueOperatorNotInstalledMessage)
+)
+
+// CheckKserveDeploymentMode checks that the default deployment mode of KServe is supported by the
+// serving configuration of KServe and by the service mesh configuration of the DSCInitialization.
+// Serverless requires a service mesh in the Sidecar data plane mode. The DSCInitialization, or its service mesh
+// configuration, may be nil, in which case the service mesh configuration is not checked.
+func CheckKserveDeploymentMode(spec *componentApi.KserveCommonSpec, dsci *dsciv1.DSCInitialization) error {
+	if spec.Serving.ManagementState == operatorv1.Removed && spec.DefaultDeploymentMode == componentApi.Serverless {
+		return ErrServerlessWithServingRemoved
+	}
+
+	// an empty default mode is resolved from the inferenceservice-config ConfigMap on reconcile, only an explicit
+	// Serverless mode is checked
+	if spec.DefaultDeploymentMode != componentApi.Serverless || dsci == nil || dsci.Spec.ServiceMesh == nil {
+		return nil
+	}
+
+	if dsci.Spec.ServiceMesh.ManagementState == operatorv1.Removed {
+		return ErrServerlessWithServiceMeshRemoved
+	}
+
+	// the Knative activator and the predictors rely on the sidecar proxies, and on the AuthorizationPolicies
+	// selecting them, which are not enforced by the ztunnel node proxies of the Ambient mode
+	if dsci.Spec.ServiceMesh.Provider == infrav1.SailProvider && dsci.Spec.ServiceMesh.ControlPlane.DataPlaneMode == infrav1.AmbientDataPlaneMode {
+		return ErrServerlessWithAmbientMesh
+	}
+
+	return nil
+}
+
+// CheckKueue checks that no Kueue CRDs incompatible with the Kueue component are installed, and that the
+// Kueue operator is installed when, and only when, the Kueue component is Unmanaged.
+func CheckKueue(ctx context.Context, cli client.Client, managementState operatorv1.ManagementState) error {
+	for _, crd := range []schema.GroupVersionKind{gvk.MultiKueueConfigV1Alpha1, gvk.MultikueueClusterV1Alpha1} {
+		found, err := cluster.HasCRD(ctx, cli, crd)
+		if err != nil {
+			return fmt.Errorf("failed to check %s CRDs version: %w", crd, err)
+		}
+		if found {
+			return ErrMultiKueueCRDs
+		}
+	}
+
+	if managementState != operatorv1.Managed && managementState != operatorv1.Unmanaged {
+		return nil
+	}
+
+	found, err := cluster.OperatorExists(ctx, cli, kueueOperator)
+	switch {
+	case meta.IsNoMatchError(err):
+		// the installed operators can not be told without OLM, e.g. on Kubernetes
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to check the %s installation: %w", kueueOperator, err)
+	case managementState == operatorv1.Managed && found:
+		return ErrKueueOperatorAlreadyInstalled
+	case managementState == operatorv1.Unmanaged && !found:
+		return ErrKueueOperatorNotInstalled
+	}
+
+	return nil
+}
+
+// IsViolation returns whether the error returned by a check is an invariant violation, and not a failure to
+// inspect the state of the cluster.
+func IsViolation(err error) bool {
+	for _, v := range []error{
+		ErrServerlessWithServingRemoved,
+		ErrServerlessWithServiceMeshRemoved,
+		ErrServerlessWithAmbientMesh,
+		ErrMultiKueueCRDs,
+		ErrKueueOperatorAlreadyInstalled,
+		ErrKueueOperatorNotInstalled,
+	} {
+		if errors.Is(err, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckDataScienceCluster evaluates all the platform invariants against the components configuration
+// of the given DataScienceCluster, and returns the violations found.
+//
+// Parameters:
+//   - ctx: Context for the API calls.
+//   - cli: The client used to inspect the cluster state.
+//   - dsc: The DataScienceCluster to check.
+//   - dsci: The DSCInitialization of the cluster, nil if none exists yet.
+//
+// Returns:
+//   - []error: The violations, empty if all the invariants hold.
+//   - error: The failure to inspect the cluster state, if any.
+func CheckDataScienceCluster(ctx context.Context, cli client.Client, dsc *dscv1.DataScienceCluster, dsci *dsciv1.DSCInitialization) ([]error, error) {
+	components := &dsc.Spec.Components
+	var violations []error
+
+	if components.Kserve.ManagementState == operatorv1.Managed {
+		if err := CheckKserveDeploymentMode(&components.Kserve.KserveCommonSpec, dsci); err != nil {
+			violations = append(violations, err)
+		}
+	}
+
+	if err := CheckKueue(ctx, cli, components.Kueue.ManagementState); err != nil {
+		if !IsViolation(err) {
+			return nil, err
+		}
+		violations = append(violations, err)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,263 @@
+package invariants_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	ofapiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/invariants"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func newDSCI(serviceMesh operatorv1.ManagementState) *dsciv1.DSCInitialization {
+	return &dsciv1.DSCInitialization{
+		Spec: dsciv1.DSCInitializationSpec{
+			ServiceMesh: &infrav1.ServiceMeshSpec{
+				ManagementState: serviceMesh,
+			},
+		},
+	}
+}
+
+func newSailDSCI(dataPlaneMode string) *dsciv1.DSCInitialization {
+	dsci := newDSCI(operatorv1.Managed)
+	dsci.Spec.ServiceMesh.Provider = infrav1.SailProvider
+	dsci.Spec.ServiceMesh.ControlPlane.DataPlaneMode = dataPlaneMode
+
+	return dsci
+}
+
+func TestCheckKserveDeploymentMode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		mode  componentApi.DefaultDeploymentMode
+		state operatorv1.ManagementState
+		dsci  *dsciv1.DSCInitialization
+		err   error
+	}{
+		{
+			name:  "serverless with serving removed",
+			mode:  componentApi.Serverless,
+			state: operatorv1.Removed,
+			dsci:  newDSCI(operatorv1.Managed),
+			err:   invariants.ErrServerlessWithServingRemoved,
+		},
+		{
+			name:  "serverless with service mesh removed",
+			mode:  componentApi.Serverless,
+			state: operatorv1.Managed,
+			dsci:  newDSCI(operatorv1.Removed),
+			err:   invariants.ErrServerlessWithServiceMeshRemoved,
+		},
+		{
+			// the default mode is resolved from the inferenceservice-config ConfigMap
+			name:  "default mode with service mesh removed",
+			state: operatorv1.Managed,
+			dsci:  newDSCI(operatorv1.Removed),
+		},
+		{
+			name:  "raw deployment with service mesh removed",
+			mode:  componentApi.RawDeployment,
+			state: operatorv1.Removed,
+			dsci:  newDSCI(operatorv1.Removed),
+		},
+		{
+			name:  "serverless without DSCInitialization",
+			mode:  componentApi.Serverless,
+			state: operatorv1.Managed,
+		},
+		{
+			name:  "serverless without service mesh configuration",
+			mode:  componentApi.Serverless,
+			state: operatorv1.Managed,
+			dsci:  &dsciv1.DSCInitialization{},
+		},
+		{
+			name:  "serverless with service mesh managed",
+			mode:  componentApi.Serverless,
+			state: operatorv1.Managed,
+			dsci:  newDSCI(operatorv1.Managed),
+		},
+		{
+			name:  "serverless with sail sidecar mode",
+			mode:  componentApi.Serverless,
+			state: operatorv1.Managed,
+			dsci:  newSailDSCI(infrav1.SidecarDataPlaneMode),
+		},
+		{
+			name:  "serverless with sail ambient mode",
+			mode:  componentApi.Serverless,
+			state: operatorv1.Managed,
+			dsci:  newSailDSCI(infrav1.AmbientDataPlaneMode),
+			err:   invariants.ErrServerlessWithAmbientMesh,
+		},
+		{
+			name:  "raw deployment with sail ambient mode",
+			mode:  componentApi.RawDeployment,
+			state: operatorv1.Removed,
+			dsci:  newSailDSCI(infrav1.AmbientDataPlaneMode),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			spec := componentApi.KserveCommonSpec{
+				DefaultDeploymentMode: tt.mode,
+				Serving: infrav1.ServingSpec{
+					ManagementState: tt.state,
+				},
+			}
+
+			err := invariants.CheckKserveDeploymentMode(&spec, tt.dsci)
+			if tt.err == nil {
+				g.Expect(err).ShouldNot(HaveOccurred())
+			} else {
+				g.Expect(err).Should(MatchError(tt.err))
+			}
+		})
+	}
+}
+
+func TestCheckKueue(t *testing.T) {
+	t.Parallel()
+
+	kueueOperator := &ofapiv2.OperatorCondition{ObjectMeta: metav1.ObjectMeta{Name: "kueue-operator.v1.0.0"}}
+
+	noMatch := &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "operators.coreos.com", Kind: "OperatorCondition"}}
+
+	tests := []struct {
+		name    string
+		state   operatorv1.ManagementState
+		objs    []client.Object
+		listErr error
+		err     error
+		failure bool
+	}{
+		{
+			name:  "managed with the operator installed",
+			state: operatorv1.Managed,
+			objs:  []client.Object{kueueOperator},
+			err:   invariants.ErrKueueOperatorAlreadyInstalled,
+		},
+		{
+			name:  "managed without the operator",
+			state: operatorv1.Managed,
+		},
+		{
+			name:  "unmanaged without the operator",
+			state: operatorv1.Unmanaged,
+			err:   invariants.ErrKueueOperatorNotInstalled,
+		},
+		{
+			name:  "unmanaged with the operator installed",
+			state: operatorv1.Unmanaged,
+			objs:  []client.Object{kueueOperator},
+		},
+		{
+			name:    "unmanaged without OLM",
+			state:   operatorv1.Unmanaged,
+			listErr: noMatch,
+		},
+		{
+			name:    "unmanaged with the operators not listed",
+			state:   operatorv1.Unmanaged,
+			listErr: errors.New("connection refused"),
+			failure: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			cli, err := fakeclient.New(
+				fakeclient.WithObjects(tt.objs...),
+				fakeclient.WithInterceptorFuncs(failOperatorConditionList(tt.listErr)),
+			)
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			err = invariants.CheckKueue(t.Context(), cli, tt.state)
+			switch {
+			case tt.failure:
+				g.Expect(err).Should(HaveOccurred())
+				g.Expect(invariants.IsViolation(err)).Should(BeFalse())
+			case tt.err == nil:
+				g.Expect(err).ShouldNot(HaveOccurred())
+			default:
+				g.Expect(err).Should(MatchError(tt.err))
+				g.Expect(invariants.IsViolation(err)).Should(BeTrue())
+			}
+		})
+	}
+}
+
+func TestCheckDataScienceCluster(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	cli, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	dsc := &dscv1.DataScienceCluster{}
+	dsc.Spec.Components.Kserve.ManagementState = operatorv1.Managed
+	dsc.Spec.Components.Kserve.DefaultDeploymentMode = componentApi.Serverless
+	dsc.Spec.Components.Kserve.Serving.ManagementState = operatorv1.Managed
+	dsc.Spec.Components.Kueue.ManagementState = operatorv1.Unmanaged
+
+	violations, err := invariants.CheckDataScienceCluster(t.Context(), cli, dsc, newDSCI(operatorv1.Removed))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(violations).Should(ConsistOf(
+		MatchError(invariants.ErrServerlessWithServiceMeshRemoved),
+		MatchError(invariants.ErrKueueOperatorNotInstalled),
+	))
+
+	dsc.Spec.Components.Kserve.DefaultDeploymentMode = componentApi.RawDeployment
+	dsc.Spec.Components.Kueue.ManagementState = operatorv1.Removed
+
+	violations, err = invariants.CheckDataScienceCluster(t.Context(), cli, dsc, newDSCI(operatorv1.Removed))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(violations).Should(BeEmpty())
+
+	// a failure to inspect the cluster is not reported as a violation
+	cli, err = fakeclient.New(fakeclient.WithInterceptorFuncs(failOperatorConditionList(errors.New("connection refused"))))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	dsc.Spec.Components.Kueue.ManagementState = operatorv1.Unmanaged
+
+	violations, err = invariants.CheckDataScienceCluster(t.Context(), cli, dsc, newDSCI(operatorv1.Removed))
+	g.Expect(err).Should(MatchError(ContainSubstring("connection refused")))
+	g.Expect(violations).Should(BeEmpty())
+}
+
+// failOperatorConditionList makes the listing of the OperatorConditions fail with the given error, if any.
+func failOperatorConditionList(listErr error) interceptor.Funcs {
+	return interceptor.Funcs{
+		List: func(ctx context.Context, cli client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+			if _, ok := list.(*ofapiv2.OperatorConditionList); ok && listErr != nil {
+				return listErr
+			}
+
+			return cli.List(ctx, list, opts...)
+		},
+	}
+}
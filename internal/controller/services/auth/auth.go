@@ -3,10 +3,12 @@ package auth
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	configv1 "github.com/openshift/api/config/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -35,3 +37,73 @@ func IsDefaultAuthMethod(ctx context.Context, cli client.Client) (bool, error) {
 	// we only create userGroups for "IntegratedOAuth" or "" and leave other or new supported type value in the future
 	return authenticationobj.Spec.Type == configv1.AuthenticationTypeIntegratedOAuth || authenticationobj.Spec.Type == "", nil
 }
+
+// authentication is the way the cluster authenticates users, and the prefixes it adds to the claims of the OIDC
+// tokens to build the user and group names.
+type authentication struct {
+	Mode           serviceApi.AuthenticationMode
+	UsernamePrefix string
+	GroupsPrefix   string
+}
+
+// detectAuthentication returns the authentication of the cluster, read from its Authentication config. The mode is
+// empty when the cluster has no Authentication config.
+func detectAuthentication(ctx context.Context, cli client.Client) (authentication, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk.OpenshiftAuthentication)
+
+	err := cli.Get(ctx, client.ObjectKey{Name: cluster.ClusterAuthenticationObj}, obj)
+	switch {
+	case k8serr.IsNotFound(err), meta.IsNoMatchError(err):
+		return authentication{}, nil
+	case err != nil:
+		return authentication{}, fmt.Errorf("failed to get the %s Authentication: %w", cluster.ClusterAuthenticationObj, err)
+	}
+
+	authType, _, err := unstructured.NestedString(obj.Object, "spec", "type")
+	if err != nil {
+		return authentication{}, err
+	}
+
+	switch authType {
+	case "", string(configv1.AuthenticationTypeIntegratedOAuth):
+		return authentication{Mode: serviceApi.AuthenticationModeIntegratedOAuth}, nil
+	case string(serviceApi.AuthenticationModeOIDC):
+		return oidcAuthentication(obj)
+	default:
+		return authentication{Mode: serviceApi.AuthenticationMode(authType)}, nil
+	}
+}
+
+// oidcAuthentication returns the prefixes of the user and group names of the first OIDC provider of the cluster,
+// following the defaults of the Kubernetes structured authentication: without a prefix policy, the usernames
+// taken from a claim other than email are prefixed with the issuer URL.
+func oidcAuthentication(obj *unstructured.Unstructured) (authentication, error) {
+	res := authentication{Mode: serviceApi.AuthenticationModeOIDC}
+
+	providers, _, err := unstructured.NestedSlice(obj.Object, "spec", "oidcProviders")
+	if err != nil || len(providers) == 0 {
+		return res, err
+	}
+
+	provider, ok := providers[0].(map[string]any)
+	if !ok {
+		return res, errors.New("invalid OIDC provider in the Authentication config")
+	}
+
+	res.GroupsPrefix, _, _ = unstructured.NestedString(provider, "claimMappings", "groups", "prefix")
+
+	policy, _, _ := unstructured.NestedString(provider, "claimMappings", "username", "prefixPolicy")
+	switch policy {
+	case "Prefix":
+		res.UsernamePrefix, _, _ = unstructured.NestedString(provider, "claimMappings", "username", "prefix", "prefixString")
+	case "":
+		claim, _, _ := unstructured.NestedString(provider, "claimMappings", "username", "claim")
+		if claim != "email" {
+			issuer, _, _ := unstructured.NestedString(provider, "issuer", "issuerURL")
+			res.UsernamePrefix = issuer + "#"
+		}
+	}
+
+	return res, nil
+}
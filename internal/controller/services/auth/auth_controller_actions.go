@@ -20,11 +20,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	userv1 "github.com/openshift/api/user/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
@@ -54,29 +57,13 @@ func initialize(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	return nil
 }
 
-func bindRole(ctx context.Context, rr *odhtypes.ReconciliationRequest, groups []string, roleBindingName string, roleName string) error {
-	groupsToBind := []rbacv1.Subject{}
-	for _, e := range groups {
-		// we want to disallow adding system:authenticated to the adminGroups
-		if roleName == "admingroup-role" && e == "system:authenticated" || e == "" {
-			log := logf.FromContext(ctx)
-			log.Info("skipping adding invalid group to RoleBinding")
-			continue
-		}
-		rs := rbacv1.Subject{
-			Kind:     gvk.Group.Kind,
-			APIGroup: gvk.Group.Group,
-			Name:     e,
-		}
-		groupsToBind = append(groupsToBind, rs)
-	}
-
+func bindRole(ctx context.Context, rr *odhtypes.ReconciliationRequest, groups []string, users []string, roleBindingName string, roleName string) error {
 	rb := &rbacv1.RoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      roleBindingName,
 			Namespace: rr.DSCI.Spec.ApplicationsNamespace,
 		},
-		Subjects: groupsToBind,
+		Subjects: roleSubjects(ctx, groups, users, roleName == "admingroup-role"),
 		RoleRef: rbacv1.RoleRef{
 			APIGroup: gvk.Role.Group,
 			Kind:     gvk.Role.Kind,
@@ -91,29 +78,12 @@ func bindRole(ctx context.Context, rr *odhtypes.ReconciliationRequest, groups []
 	return nil
 }
 
-func bindClusterRole(ctx context.Context, rr *odhtypes.ReconciliationRequest, groups []string, roleBindingName string, roleName string) error {
-	groupsToBind := []rbacv1.Subject{}
-	for _, e := range groups {
-		// we want to disallow adding system:authenticated to the adminGroups
-		if roleName == "admingroupcluster-role" && e == "system:authenticated" || e == "" {
-			log := logf.FromContext(ctx)
-			log.Info("skipping adding invalid group to ClusterRoleBinding")
-			continue
-		}
-		rs := rbacv1.Subject{
-			Kind:     gvk.Group.Kind,
-			APIGroup: gvk.Group.Group,
-			Name:     e,
-		}
-
-		groupsToBind = append(groupsToBind, rs)
-	}
-
+func bindClusterRole(ctx context.Context, rr *odhtypes.ReconciliationRequest, groups []string, users []string, roleBindingName string, roleName string) error {
 	crb := &rbacv1.ClusterRoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: roleBindingName,
 		},
-		Subjects: groupsToBind,
+		Subjects: roleSubjects(ctx, groups, users, roleName == "admingroupcluster-role"),
 		RoleRef: rbacv1.RoleRef{
 			Kind:     gvk.ClusterRole.Kind,
 			APIGroup: gvk.ClusterRole.Group,
@@ -128,30 +98,143 @@ func bindClusterRole(ctx context.Context, rr *odhtypes.ReconciliationRequest, gr
 	return nil
 }
 
+// roleSubjects returns the Group and User subjects of a binding, skipping the invalid ones.
+func roleSubjects(ctx context.Context, groups []string, users []string, admin bool) []rbacv1.Subject {
+	log := logf.FromContext(ctx)
+
+	subjects := []rbacv1.Subject{}
+	for _, e := range groups {
+		// we want to disallow adding system:authenticated to the adminGroups
+		if admin && e == "system:authenticated" || e == "" {
+			log.Info("skipping adding invalid group to binding")
+			continue
+		}
+		subjects = append(subjects, rbacv1.Subject{
+			Kind:     gvk.Group.Kind,
+			APIGroup: gvk.Group.Group,
+			Name:     e,
+		})
+	}
+
+	for _, e := range users {
+		// we want to disallow adding system:anonymous to the adminUsers
+		if admin && e == "system:anonymous" || e == "" {
+			log.Info("skipping adding invalid user to binding")
+			continue
+		}
+		subjects = append(subjects, rbacv1.Subject{
+			Kind:     gvk.User.Kind,
+			APIGroup: gvk.User.Group,
+			Name:     e,
+		})
+	}
+
+	return subjects
+}
+
+// prefixNames adds a prefix to the names not already starting with it, the system: names being kept as they are.
+func prefixNames(names []string, prefix string) []string {
+	if prefix == "" {
+		return names
+	}
+
+	res := make([]string, 0, len(names))
+	for _, n := range names {
+		if n != "" && !strings.HasPrefix(n, "system:") && !strings.HasPrefix(n, prefix) {
+			n = prefix + n
+		}
+		res = append(res, n)
+	}
+
+	return res
+}
+
 func managePermissions(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	ai, ok := rr.Instance.(*serviceApi.Auth)
 	if !ok {
 		return errors.New("instance is not of type *services.Auth")
 	}
 
-	err := bindRole(ctx, rr, ai.Spec.AdminGroups, "admingroup-rolebinding", "admingroup-role")
+	authn, err := detectAuthentication(ctx, rr.Client)
+	if err != nil {
+		return err
+	}
+
+	// the OIDC provider of the cluster prefixes the claims of the tokens, unless overridden in the Auth CR
+	if oidc := ai.Spec.OIDC; oidc != nil {
+		if oidc.UsernamePrefix != nil {
+			authn.UsernamePrefix = *oidc.UsernamePrefix
+		}
+		if oidc.GroupsPrefix != nil {
+			authn.GroupsPrefix = *oidc.GroupsPrefix
+		}
+	}
+
+	adminGroups := prefixNames(ai.Spec.AdminGroups, authn.GroupsPrefix)
+	adminUsers := prefixNames(ai.Spec.AdminUsers, authn.UsernamePrefix)
+	allowedGroups := prefixNames(ai.Spec.AllowedGroups, authn.GroupsPrefix)
+	allowedUsers := prefixNames(ai.Spec.AllowedUsers, authn.UsernamePrefix)
+
+	err = bindRole(ctx, rr, adminGroups, adminUsers, "admingroup-rolebinding", "admingroup-role")
 	if err != nil {
 		return err
 	}
 
-	err = bindClusterRole(ctx, rr, ai.Spec.AdminGroups, "admingroupcluster-rolebinding", "admingroupcluster-role")
+	err = bindClusterRole(ctx, rr, adminGroups, adminUsers, "admingroupcluster-rolebinding", "admingroupcluster-role")
 	if err != nil {
 		return err
 	}
 
-	err = bindRole(ctx, rr, ai.Spec.AllowedGroups, "allowedgroup-rolebinding", "allowedgroup-role")
+	err = bindRole(ctx, rr, allowedGroups, allowedUsers, "allowedgroup-rolebinding", "allowedgroup-role")
 	if err != nil {
 		return err
 	}
 
+	capabilities, err := componentCapabilities()
+	if err != nil {
+		return err
+	}
+
+	err = bindAuthRoles(ctx, rr, ai.Spec.Roles, capabilities, authn)
+	if err != nil {
+		return err
+	}
+
+	ai.Status.AuthenticationMode = authn.Mode
+	ai.Status.Bindings = authBindings(rr)
+
 	return nil
 }
 
+// authBindings returns the RoleBindings and ClusterRoleBindings of the rendered resources.
+func authBindings(rr *odhtypes.ReconciliationRequest) []serviceApi.AuthBinding {
+	var res []serviceApi.AuthBinding
+
+	for _, r := range rr.Resources {
+		if r.GroupVersionKind() != gvk.RoleBinding && r.GroupVersionKind() != gvk.ClusterRoleBinding {
+			continue
+		}
+
+		binding := serviceApi.AuthBinding{
+			Kind:      r.GetKind(),
+			Name:      r.GetName(),
+			Namespace: r.GetNamespace(),
+		}
+
+		subjects, _, _ := unstructured.NestedSlice(r.Object, "subjects")
+		for _, s := range subjects {
+			subject := rbacv1.Subject{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(s.(map[string]any), &subject); err == nil {
+				binding.Subjects = append(binding.Subjects, subject)
+			}
+		}
+
+		res = append(res, binding)
+	}
+
+	return res
+}
+
 func addUserGroup(ctx context.Context, rr *odhtypes.ReconciliationRequest, userGroupName string) error {
 	namespace, err := actions.ApplicationNamespace(ctx, rr)
 	if err != nil {
@@ -179,6 +262,11 @@ func addUserGroup(ctx context.Context, rr *odhtypes.ReconciliationRequest, userG
 }
 
 func createDefaultGroup(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	// user groups are an OpenShift API
+	if rr.Release.Name == cluster.Kubernetes {
+		return nil
+	}
+
 	ok, err := IsDefaultAuthMethod(ctx, rr.Client)
 	if err != nil {
 		return err
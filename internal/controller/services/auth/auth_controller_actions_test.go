@@ -6,15 +6,22 @@ import (
 
 	configv1 "github.com/openshift/api/config/v1"
 	userv1 "github.com/openshift/api/user/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
 	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	cr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/registry"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 
 	. "github.com/onsi/gomega"
@@ -107,7 +114,7 @@ func TestBindRoleValidation(t *testing.T) {
 				Resources: []unstructured.Unstructured{},
 			}
 
-			err := bindRole(ctx, rr, tt.groups, "test-binding", tt.roleName)
+			err := bindRole(ctx, rr, tt.groups, nil, "test-binding", tt.roleName)
 			g.Expect(err).ToNot(HaveOccurred(), tt.description)
 
 			// Verify a resource was added
@@ -159,8 +166,9 @@ func TestManagePermissionsBasic(t *testing.T) {
 
 	// Create reconciliation request
 	rr := &odhtypes.ReconciliationRequest{
-		Client:   fakeClient,
-		Instance: auth,
+		Client:     fakeClient,
+		Instance:   auth,
+		Conditions: conditions.NewManager(auth, status.ConditionTypeReady, status.ConditionRolesBound),
 		DSCI: &dsciv1.DSCInitialization{
 			Spec: dsciv1.DSCInitializationSpec{
 				ApplicationsNamespace: "test-namespace",
@@ -273,3 +281,306 @@ func TestCreateDefaultGroupBasic(t *testing.T) {
 	err := createDefaultGroup(ctx, rr)
 	g.Expect(err).ToNot(HaveOccurred(), "Should handle group creation without error")
 }
+
+func newOIDCAuthentication(claimMappings map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"type": "OIDC",
+			"oidcProviders": []any{
+				map[string]any{
+					"name":          "keycloak",
+					"issuer":        map[string]any{"issuerURL": "https://keycloak.example.com/realms/odh"},
+					"claimMappings": claimMappings,
+				},
+			},
+		},
+	}}
+	obj.SetGroupVersionKind(gvk.OpenshiftAuthentication)
+	obj.SetName("cluster")
+
+	return obj
+}
+
+// TestDetectAuthentication validates the detection of the authentication mode of the cluster, and of the prefixes
+// the OIDC provider adds to the user and group names.
+func TestDetectAuthentication(t *testing.T) {
+	ctx := t.Context()
+
+	tests := []struct {
+		name     string
+		objects  []client.Object
+		expected authentication
+	}{
+		{
+			name:     "no Authentication config",
+			expected: authentication{},
+		},
+		{
+			name: "OIDC with prefixes",
+			objects: []client.Object{newOIDCAuthentication(map[string]any{
+				"username": map[string]any{
+					"claim":        "preferred_username",
+					"prefixPolicy": "Prefix",
+					"prefix":       map[string]any{"prefixString": "oidc:"},
+				},
+				"groups": map[string]any{"claim": "groups", "prefix": "oidc-groups:"},
+			})},
+			expected: authentication{
+				Mode:           serviceApi.AuthenticationModeOIDC,
+				UsernamePrefix: "oidc:",
+				GroupsPrefix:   "oidc-groups:",
+			},
+		},
+		{
+			name: "OIDC with the default username prefix",
+			objects: []client.Object{newOIDCAuthentication(map[string]any{
+				"username": map[string]any{"claim": "sub"},
+			})},
+			expected: authentication{
+				Mode:           serviceApi.AuthenticationModeOIDC,
+				UsernamePrefix: "https://keycloak.example.com/realms/odh#",
+			},
+		},
+		{
+			name: "OIDC with email usernames",
+			objects: []client.Object{newOIDCAuthentication(map[string]any{
+				"username": map[string]any{"claim": "email"},
+			})},
+			expected: authentication{Mode: serviceApi.AuthenticationModeOIDC},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			// the Authentication config is stored as unstructured, the OIDC fields being unknown to the scheme
+			scheme := runtime.NewScheme()
+			_ = rbacv1.AddToScheme(scheme)
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objects...).Build()
+
+			authn, err := detectAuthentication(ctx, fakeClient)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(authn).To(Equal(tt.expected))
+		})
+	}
+}
+
+// TestManagePermissionsOIDC validates that the admin and allowed users and groups are bound with the prefixes of
+// the OIDC provider, and that the detected mode and the produced bindings are reported in the status.
+func TestManagePermissionsOIDC(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	scheme := runtime.NewScheme()
+	_ = rbacv1.AddToScheme(scheme)
+	_ = serviceApi.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newOIDCAuthentication(map[string]any{
+		"username": map[string]any{"claim": "email"},
+		"groups":   map[string]any{"claim": "groups", "prefix": "oidc:"},
+	})).Build()
+
+	auth := &serviceApi.Auth{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "auth",
+		},
+		Spec: serviceApi.AuthSpec{
+			AdminGroups:   []string{"odh-admins", "oidc:platform-admins"},
+			AllowedGroups: []string{"system:authenticated"},
+			AdminUsers:    []string{"alice@example.com"},
+			AllowedUsers:  []string{"bob@example.com"},
+			OIDC:          &serviceApi.OIDCPrefixes{UsernamePrefix: ptr.To("sso:")},
+		},
+	}
+
+	rr := &odhtypes.ReconciliationRequest{
+		Client:     fakeClient,
+		Instance:   auth,
+		Conditions: conditions.NewManager(auth, status.ConditionTypeReady, status.ConditionRolesBound),
+		DSCI: &dsciv1.DSCInitialization{
+			Spec: dsciv1.DSCInitializationSpec{
+				ApplicationsNamespace: "test-namespace",
+			},
+		},
+		Resources: []unstructured.Unstructured{},
+	}
+
+	err := managePermissions(ctx, rr)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(auth.Status.AuthenticationMode).To(Equal(serviceApi.AuthenticationModeOIDC))
+	g.Expect(auth.Status.Bindings).To(HaveLen(3))
+
+	admin := auth.Status.Bindings[0]
+	g.Expect(admin.Kind).To(Equal("RoleBinding"))
+	g.Expect(admin.Name).To(Equal("admingroup-rolebinding"))
+	g.Expect(admin.Namespace).To(Equal("test-namespace"))
+	g.Expect(admin.Subjects).To(Equal([]rbacv1.Subject{
+		{Kind: "Group", APIGroup: rbacv1.GroupName, Name: "oidc:odh-admins"},
+		{Kind: "Group", APIGroup: rbacv1.GroupName, Name: "oidc:platform-admins"},
+		{Kind: "User", APIGroup: rbacv1.GroupName, Name: "sso:alice@example.com"},
+	}))
+
+	g.Expect(auth.Status.Bindings[1].Kind).To(Equal("ClusterRoleBinding"))
+	g.Expect(auth.Status.Bindings[1].Subjects).To(Equal(admin.Subjects))
+
+	// system groups are not prefixed
+	g.Expect(auth.Status.Bindings[2].Subjects).To(Equal([]rbacv1.Subject{
+		{Kind: "Group", APIGroup: rbacv1.GroupName, Name: "system:authenticated"},
+		{Kind: "User", APIGroup: rbacv1.GroupName, Name: "sso:bob@example.com"},
+	}))
+}
+
+func TestBindAuthRoles(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	scheme := runtime.NewScheme()
+	_ = rbacv1.AddToScheme(scheme)
+	_ = serviceApi.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "project-a"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "project-b"}},
+	).Build()
+
+	capabilities := map[string]cr.Capability{
+		"kserve/deploy": {
+			Name: "deploy",
+			Rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{"serving.kserve.io"},
+				Resources: []string{"inferenceservices"},
+				Verbs:     []string{"*"},
+			}},
+		},
+		"modelregistry/view": {
+			Name: "view",
+			Rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{"modelregistry.opendatahub.io"},
+				Resources: []string{"modelregistries"},
+				Verbs:     []string{"get", "list", "watch"},
+			}},
+		},
+	}
+
+	roles := []serviceApi.AuthRole{
+		{
+			Name:         "model-deployer",
+			Groups:       []string{"deployers", "system:authenticated"},
+			Capabilities: []string{"kserve/deploy", "modelregistry/view"},
+		},
+		{
+			Name:         "registry-viewer",
+			Users:        []string{"alice@example.com"},
+			Capabilities: []string{"modelregistry/view"},
+			Namespaces:   []string{"project-b", "project-a", "project-b"},
+		},
+	}
+
+	rr := newRolesRequest(fakeClient)
+
+	err := bindAuthRoles(ctx, rr, roles, capabilities, authentication{GroupsPrefix: "oidc:", UsernamePrefix: "sso:"})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	names := make([]string, 0, len(rr.Resources))
+	for _, r := range rr.Resources {
+		names = append(names, r.GetKind()+"/"+r.GetNamespace()+"/"+r.GetName())
+	}
+
+	// the fragment of a capability is generated once, even when referenced by several roles
+	g.Expect(names).To(Equal([]string{
+		"ClusterRole//data-science-capability-kserve-deploy",
+		"ClusterRole//data-science-capability-modelregistry-view",
+		"ClusterRole//data-science-role-model-deployer",
+		"ClusterRoleBinding//data-science-role-model-deployer",
+		"ClusterRole//data-science-role-registry-viewer",
+		"RoleBinding/project-a/data-science-role-registry-viewer",
+		"RoleBinding/project-b/data-science-role-registry-viewer",
+	}))
+
+	g.Expect(rr.Resources[0].GetLabels()).To(HaveKeyWithValue(capabilityLabel, "kserve.deploy"))
+
+	selectors, _, err := unstructured.NestedSlice(rr.Resources[2].Object, "aggregationRule", "clusterRoleSelectors")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(selectors).To(Equal([]any{
+		map[string]any{"matchLabels": map[string]any{capabilityLabel: "kserve.deploy"}},
+		map[string]any{"matchLabels": map[string]any{capabilityLabel: "modelregistry.view"}},
+	}))
+
+	bindings := authBindings(rr)
+	g.Expect(bindings).To(HaveLen(3))
+	g.Expect(bindings[0].Subjects).To(Equal([]rbacv1.Subject{
+		{Kind: "Group", APIGroup: rbacv1.GroupName, Name: "oidc:deployers"},
+		{Kind: "Group", APIGroup: rbacv1.GroupName, Name: "system:authenticated"},
+	}))
+	g.Expect(bindings[1].Subjects).To(Equal([]rbacv1.Subject{
+		{Kind: "User", APIGroup: rbacv1.GroupName, Name: "sso:alice@example.com"},
+	}))
+
+	roleRef, _, err := unstructured.NestedString(rr.Resources[5].Object, "roleRef", "kind")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(roleRef).To(Equal("ClusterRole"))
+
+	g.Expect(rr.Conditions.GetCondition(status.ConditionRolesBound)).To(HaveField("Status", metav1.ConditionTrue))
+}
+
+func newRolesRequest(cli client.Client) *odhtypes.ReconciliationRequest {
+	auth := &serviceApi.Auth{ObjectMeta: metav1.ObjectMeta{Name: "auth"}}
+
+	return &odhtypes.ReconciliationRequest{
+		Client:     cli,
+		Instance:   auth,
+		Conditions: conditions.NewManager(auth, status.ConditionTypeReady, status.ConditionRolesBound),
+		Resources:  []unstructured.Unstructured{},
+	}
+}
+
+func TestBindAuthRolesSkipped(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	scheme := runtime.NewScheme()
+	_ = rbacv1.AddToScheme(scheme)
+	_ = serviceApi.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "project-a"}},
+	).Build()
+
+	rr := newRolesRequest(fakeClient)
+
+	err := bindAuthRoles(ctx, rr, []serviceApi.AuthRole{
+		{
+			Name:         "pipeline-author",
+			Groups:       []string{"authors"},
+			Capabilities: []string{"datasciencepipelines/author"},
+		},
+		{
+			Name:         "model-deployer",
+			Groups:       []string{"deployers"},
+			Capabilities: []string{"kserve/deploy"},
+			Namespaces:   []string{"project-a", "project-c"},
+		},
+	}, map[string]cr.Capability{"kserve/deploy": {Name: "deploy"}}, authentication{})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// the unknown capabilities and the missing namespaces are skipped, the other bindings still apply
+	names := make([]string, 0, len(rr.Resources))
+	for _, r := range rr.Resources {
+		names = append(names, r.GetKind()+"/"+r.GetNamespace()+"/"+r.GetName())
+	}
+	g.Expect(names).To(Equal([]string{
+		"ClusterRole//data-science-capability-kserve-deploy",
+		"ClusterRole//data-science-role-model-deployer",
+		"RoleBinding/project-a/data-science-role-model-deployer",
+	}))
+
+	g.Expect(rr.Conditions.GetCondition(status.ConditionRolesBound)).To(And(
+		HaveField("Status", metav1.ConditionFalse),
+		HaveField("Reason", status.RolesPartiallyBoundReason),
+		HaveField("Message", And(
+			ContainSubstring("unknown capability datasciencepipelines/author of role pipeline-author"),
+			ContainSubstring("namespace project-c of role model-deployer not found"),
+		)),
+	))
+}
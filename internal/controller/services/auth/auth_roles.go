@@ -0,0 +1,210 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	cr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/registry"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+const (
+	capabilityRolePrefix = "data-science-capability-"
+	authRolePrefix       = "data-science-role-"
+
+	// capabilityLabel selects the ClusterRole fragment of a capability in the aggregation rules of the roles.
+	capabilityLabel = labels.ODHPlatformPrefix + "/capability"
+)
+
+// componentCapabilities returns the capabilities contributed by the components, keyed by <component>/<name>.
+func componentCapabilities() (map[string]cr.Capability, error) {
+	res := map[string]cr.Capability{}
+
+	err := cr.ForEach(func(ch cr.ComponentHandler) error {
+		provider, ok := ch.(cr.CapabilitiesProvider)
+		if !ok {
+			return nil
+		}
+
+		for _, c := range provider.Capabilities() {
+			res[ch.GetName()+"/"+c.Name] = c
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate components: %w", err)
+	}
+
+	return res, nil
+}
+
+// bindAuthRoles adds the ClusterRoles of the roles of the AuthSpec, aggregating the ClusterRole fragments of their
+// capabilities, and binds them cluster-wide or in the namespaces of the roles. The unknown capabilities and the
+// missing namespaces are skipped, so that the other bindings still apply, and reported in the RolesBound condition.
+func bindAuthRoles(
+	ctx context.Context,
+	rr *odhtypes.ReconciliationRequest,
+	roles []serviceApi.AuthRole,
+	capabilities map[string]cr.Capability,
+	authn authentication,
+) error {
+	fragments := map[string]struct{}{}
+	var skipped []string
+
+	for _, role := range roles {
+		selectors := make([]metav1.LabelSelector, 0, len(role.Capabilities))
+		for _, name := range role.Capabilities {
+			capability, ok := capabilities[name]
+			if !ok {
+				skipped = append(skipped, fmt.Sprintf("unknown capability %s of role %s", name, role.Name))
+				continue
+			}
+
+			if _, ok := fragments[name]; !ok {
+				fragments[name] = struct{}{}
+				if err := rr.AddResources(capabilityClusterRole(name, capability)); err != nil {
+					return fmt.Errorf("error creating ClusterRole for capability %s: %w", name, err)
+				}
+			}
+
+			selectors = append(selectors, metav1.LabelSelector{
+				MatchLabels: map[string]string{capabilityLabel: capabilityLabelValue(name)},
+			})
+		}
+
+		if len(selectors) == 0 {
+			continue
+		}
+
+		roleName := authRolePrefix + role.Name
+		err := rr.AddResources(&rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: roleName,
+			},
+			AggregationRule: &rbacv1.AggregationRule{
+				ClusterRoleSelectors: selectors,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error creating ClusterRole for role %s: %w", role.Name, err)
+		}
+
+		subjects := roleSubjects(ctx,
+			prefixNames(role.Groups, authn.GroupsPrefix),
+			prefixNames(role.Users, authn.UsernamePrefix),
+			false,
+		)
+		roleRef := rbacv1.RoleRef{
+			APIGroup: gvk.ClusterRole.Group,
+			Kind:     gvk.ClusterRole.Kind,
+			Name:     roleName,
+		}
+
+		if len(role.Namespaces) == 0 {
+			err := rr.AddResources(&rbacv1.ClusterRoleBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: roleName,
+				},
+				Subjects: subjects,
+				RoleRef:  roleRef,
+			})
+			if err != nil {
+				return fmt.Errorf("error creating ClusterRoleBinding for role %s: %w", role.Name, err)
+			}
+
+			continue
+		}
+
+		for _, ns := range slices.Compact(slices.Sorted(slices.Values(role.Namespaces))) {
+			err := rr.Client.Get(ctx, client.ObjectKey{Name: ns}, &corev1.Namespace{})
+			if k8serr.IsNotFound(err) {
+				skipped = append(skipped, fmt.Sprintf("namespace %s of role %s not found", ns, role.Name))
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("failed to get namespace %s of role %s: %w", ns, role.Name, err)
+			}
+
+			err = rr.AddResources(&rbacv1.RoleBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      roleName,
+					Namespace: ns,
+				},
+				Subjects: subjects,
+				RoleRef:  roleRef,
+			})
+			if err != nil {
+				return fmt.Errorf("error creating RoleBinding for role %s in namespace %s: %w", role.Name, ns, err)
+			}
+		}
+	}
+
+	if len(skipped) > 0 {
+		rr.Conditions.MarkFalse(
+			status.ConditionRolesBound,
+			conditions.WithReason(status.RolesPartiallyBoundReason),
+			conditions.WithMessage("%s", strings.Join(skipped, ", ")),
+		)
+
+		return nil
+	}
+
+	rr.Conditions.MarkTrue(status.ConditionRolesBound)
+
+	return nil
+}
+
+// ValidateRoleCapabilities checks that the capabilities of the roles are contributed by the components.
+func ValidateRoleCapabilities(roles []serviceApi.AuthRole) error {
+	capabilities, err := componentCapabilities()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, role := range roles {
+		for _, name := range role.Capabilities {
+			if _, ok := capabilities[name]; !ok {
+				errs = append(errs, fmt.Errorf("unknown capability %s of role %s, must be one of %s",
+					name, role.Name, strings.Join(slices.Sorted(maps.Keys(capabilities)), ", ")))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// capabilityClusterRole returns the ClusterRole fragment granting the rules of a capability.
+func capabilityClusterRole(name string, capability cr.Capability) *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: capabilityRolePrefix + strings.ReplaceAll(name, "/", "-"),
+			Labels: map[string]string{
+				capabilityLabel: capabilityLabelValue(name),
+			},
+		},
+		Rules: capability.Rules,
+	}
+}
+
+// capabilityLabelValue returns the value of the capability label of a capability, e.g. kserve.deploy for
+// kserve/deploy, the slash not being allowed in label values.
+func capabilityLabelValue(name string) string {
+	return strings.ReplaceAll(name, "/", ".")
+}
@@ -0,0 +1,327 @@
+package certconfigmapgenerator
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	annotation "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+const (
+	// CAExpiryWarningThreshold is the time before their expiry from which the CA certificates are reported as expiring.
+	CAExpiryWarningThreshold = 30 * 24 * time.Hour
+
+	// CustomCABundleSource and ProxyCABundleSource identify the inline custom CA bundle of the DSCInitialization and
+	// the trustedCA ConfigMap of the cluster proxy as sources of CA certificates.
+	CustomCABundleSource = "customCABundle"
+	ProxyCABundleSource  = "proxy"
+
+	ConfigMapSourceKind = "configmap"
+	SecretSourceKind    = "secret"
+
+	defaultConfigMapKey   = "ca-bundle.crt"
+	defaultSecretKey      = "ca.crt"
+	proxyConfigNamespace  = "openshift-config"
+	clusterProxyName      = "cluster"
+	certificatePEMBlockID = "CERTIFICATE"
+)
+
+// CACertificate is a CA certificate of a trusted CA bundle, along with the source it was read from.
+type CACertificate struct {
+	Certificate *x509.Certificate
+	Source      string
+}
+
+// CABundle is a set of distinct CA certificates, composed from several sources.
+type CABundle struct {
+	Certificates []CACertificate
+	// Errors lists the sources which could not be read and the invalid content of the sources, which are left out
+	// of the bundle.
+	Errors []string
+
+	fingerprints map[[sha256.Size]byte]struct{}
+}
+
+func NewCABundle() *CABundle {
+	return &CABundle{
+		fingerprints: map[[sha256.Size]byte]struct{}{},
+	}
+}
+
+// Clone returns a copy of the bundle, sharing its parsed certificates.
+func (b *CABundle) Clone() *CABundle {
+	return &CABundle{
+		Certificates: slices.Clone(b.Certificates),
+		Errors:       slices.Clone(b.Errors),
+		fingerprints: maps.Clone(b.fingerprints),
+	}
+}
+
+// Add parses the PEM encoded certificates of data and adds the ones not yet in the bundle.
+func (b *CABundle) Add(source string, data []byte) {
+	rest := data
+
+	for {
+		var block *pem.Block
+
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if block.Type != certificatePEMBlockID {
+			b.Errors = append(b.Errors, fmt.Sprintf("%s: unexpected PEM block of type %s", source, block.Type))
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			b.Errors = append(b.Errors, fmt.Sprintf("%s: invalid certificate: %v", source, err))
+			continue
+		}
+
+		fingerprint := sha256.Sum256(cert.Raw)
+		if _, found := b.fingerprints[fingerprint]; found {
+			continue
+		}
+
+		b.fingerprints[fingerprint] = struct{}{}
+		b.Certificates = append(b.Certificates, CACertificate{Certificate: cert, Source: source})
+	}
+
+	if len(bytes.TrimSpace(rest)) != 0 {
+		b.Errors = append(b.Errors, source+": content is not PEM encoded")
+	}
+}
+
+// PEM returns the PEM encoded certificates of the bundle.
+func (b *CABundle) PEM() string {
+	var sb strings.Builder
+
+	for _, c := range b.Certificates {
+		sb.Write(pem.EncodeToMemory(&pem.Block{Type: certificatePEMBlockID, Bytes: c.Certificate.Raw}))
+	}
+
+	return sb.String()
+}
+
+// Expiring returns the certificates of the bundle which expired, or expire within the threshold, sorted by expiry
+// time.
+func (b *CABundle) Expiring(now time.Time, threshold time.Duration) []dsciv1.TrustedCACertificate {
+	expiring := make([]dsciv1.TrustedCACertificate, 0)
+
+	for _, c := range b.Certificates {
+		if c.Certificate.NotAfter.After(now.Add(threshold)) {
+			continue
+		}
+
+		expiring = append(expiring, dsciv1.TrustedCACertificate{
+			Subject:  c.Certificate.Subject.String(),
+			Source:   c.Source,
+			NotAfter: metav1.NewTime(c.Certificate.NotAfter),
+			Expired:  !c.Certificate.NotAfter.After(now),
+		})
+	}
+
+	slices.SortStableFunc(expiring, func(a, b dsciv1.TrustedCACertificate) int {
+		return a.NotAfter.Compare(b.NotAfter.Time)
+	})
+
+	return expiring
+}
+
+// NextTransition returns the earliest time after now at which a certificate of the bundle starts expiring, or
+// expires, or the zero time if there is none.
+func (b *CABundle) NextTransition(now time.Time, threshold time.Duration) time.Time {
+	next := time.Time{}
+
+	for _, c := range b.Certificates {
+		for _, t := range []time.Time{c.Certificate.NotAfter.Add(-threshold), c.Certificate.NotAfter} {
+			if t.After(now) && (next.IsZero() || t.Before(next)) {
+				next = t
+			}
+		}
+	}
+
+	return next
+}
+
+// NewTrustedCABundle composes the custom CA bundle configured in the DSCInitialization from the inline PEM
+// certificates, the ConfigMaps and Secrets of the operator namespace, and the trustedCA ConfigMap of the cluster
+// proxy. Sources which cannot be found are reported in the Errors of the bundle, other API errors are returned.
+func NewTrustedCABundle(ctx context.Context, cli client.Client, operatorNamespace string, spec *dsciv1.TrustedCABundleSpec) (*CABundle, error) {
+	b := NewCABundle()
+	if spec == nil {
+		return b, nil
+	}
+
+	b.Add(CustomCABundleSource, []byte(spec.CustomCABundle))
+
+	for _, s := range spec.Sources {
+		var err error
+
+		switch {
+		case s.ConfigMap != nil:
+			err = b.addSource(ctx, cli, ConfigMapSourceKind, operatorNamespace, s.ConfigMap.Name, s.ConfigMap.Key)
+		case s.Secret != nil:
+			err = b.addSource(ctx, cli, SecretSourceKind, operatorNamespace, s.Secret.Name, s.Secret.Key)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if spec.IncludeProxyTrustedCA {
+		if err := b.addProxyTrustedCA(ctx, cli); err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
+
+// AddNamespaceSource adds the CA certificates of the ConfigMap or Secret referenced by the TrustedCABundleSource
+// annotation of the namespace, if any.
+func (b *CABundle) AddNamespaceSource(ctx context.Context, cli client.Client, ns *corev1.Namespace) error {
+	value, found := ns.GetAnnotations()[annotation.TrustedCABundleSource]
+	if !found {
+		return nil
+	}
+
+	kind, name, key, err := ParseCABundleSource(value)
+	if err != nil {
+		b.Errors = append(b.Errors, fmt.Sprintf("%s annotation: %v", annotation.TrustedCABundleSource, err))
+		return nil
+	}
+
+	return b.addSource(ctx, cli, kind, ns.Name, name, key)
+}
+
+// ParseCABundleSource parses a reference to a CA bundle source, formatted as <kind>/<name>[/<key>] with kind being
+// configmap or secret.
+func ParseCABundleSource(value string) (string, string, string, error) {
+	parts := strings.Split(value, "/")
+	if len(parts) < 2 || len(parts) > 3 || parts[1] == "" {
+		return "", "", "", fmt.Errorf("invalid source %q, expected <kind>/<name>[/<key>]", value)
+	}
+
+	kind := strings.ToLower(parts[0])
+	if kind != ConfigMapSourceKind && kind != SecretSourceKind {
+		return "", "", "", fmt.Errorf("invalid source kind %q, expected %s or %s", parts[0], ConfigMapSourceKind, SecretSourceKind)
+	}
+
+	key := ""
+	if len(parts) == 3 {
+		key = parts[2]
+	}
+
+	return kind, parts[1], key, nil
+}
+
+// addSource adds the CA certificates held by the key of a ConfigMap or Secret, which defaults to ca-bundle.crt for
+// a ConfigMap and to ca.crt for a Secret.
+func (b *CABundle) addSource(ctx context.Context, cli client.Client, kind string, namespace string, name string, key string) error {
+	source := kind + "/" + name
+
+	var data []byte
+	var found bool
+
+	switch kind {
+	case ConfigMapSourceKind:
+		if key == "" {
+			key = defaultConfigMapKey
+		}
+
+		cm := corev1.ConfigMap{}
+		if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &cm); err != nil {
+			return b.sourceError(source, namespace, err)
+		}
+
+		var value string
+		value, found = cm.Data[key]
+		data = []byte(value)
+	case SecretSourceKind:
+		if key == "" {
+			key = defaultSecretKey
+		}
+
+		secret := corev1.Secret{}
+		if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &secret); err != nil {
+			return b.sourceError(source, namespace, err)
+		}
+
+		data, found = secret.Data[key]
+	}
+
+	if !found {
+		b.Errors = append(b.Errors, fmt.Sprintf("%s: key %s not found", source, key))
+		return nil
+	}
+
+	b.Add(source, data)
+
+	return nil
+}
+
+// addProxyTrustedCA adds the CA certificates of the trustedCA ConfigMap of the cluster proxy, when configured.
+func (b *CABundle) addProxyTrustedCA(ctx context.Context, cli client.Client) error {
+	proxy := configv1.Proxy{}
+
+	err := cli.Get(ctx, client.ObjectKey{Name: clusterProxyName}, &proxy)
+	switch {
+	case k8serr.IsNotFound(err) || meta.IsNoMatchError(err):
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to get cluster proxy: %w", err)
+	}
+
+	if proxy.Spec.TrustedCA.Name == "" {
+		return nil
+	}
+
+	cm := corev1.ConfigMap{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: proxyConfigNamespace, Name: proxy.Spec.TrustedCA.Name}, &cm); err != nil {
+		return b.sourceError(ProxyCABundleSource, proxyConfigNamespace, err)
+	}
+
+	b.Add(ProxyCABundleSource, []byte(cm.Data[defaultConfigMapKey]))
+
+	return nil
+}
+
+func (b *CABundle) sourceError(source string, namespace string, err error) error {
+	if !k8serr.IsNotFound(err) {
+		return fmt.Errorf("failed to read CA bundle source %s in namespace %s: %w", source, namespace, err)
+	}
+
+	b.Errors = append(b.Errors, fmt.Sprintf("%s: not found in namespace %s", source, namespace))
+
+	return nil
+}
+
+// Err returns the errors of the bundle joined in a single error, or nil.
+func (b *CABundle) Err() error {
+	if len(b.Errors) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(b.Errors, "; "))
+}
@@ -0,0 +1,220 @@
+package certconfigmapgenerator_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/certconfigmapgenerator"
+	annotation "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+// newCACertificate returns a PEM encoded self-signed CA certificate expiring at notAfter.
+func newCACertificate(g *WithT, cn string, notAfter time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestNewTrustedCABundle(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	inline := newCACertificate(g, "inline-ca", time.Now().Add(365*24*time.Hour))
+	fromConfigMap := newCACertificate(g, "configmap-ca", time.Now().Add(365*24*time.Hour))
+	fromSecret := newCACertificate(g, "secret-ca", time.Now().Add(365*24*time.Hour))
+	fromProxy := newCACertificate(g, "proxy-ca", time.Now().Add(365*24*time.Hour))
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "corporate-ca", Namespace: "operator-ns"},
+			// the inline CA is de-duplicated
+			Data: map[string]string{"ca-bundle.crt": fromConfigMap + inline},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "partner-ca", Namespace: "operator-ns"},
+			Data:       map[string][]byte{"partner.crt": []byte(fromSecret)},
+		},
+		&configv1.Proxy{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			Spec:       configv1.ProxySpec{TrustedCA: configv1.ConfigMapNameReference{Name: "user-ca-bundle"}},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "user-ca-bundle", Namespace: "openshift-config"},
+			Data:       map[string]string{"ca-bundle.crt": fromProxy},
+		},
+	))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	bundle, err := certconfigmapgenerator.NewTrustedCABundle(ctx, cli, "operator-ns", &dsciv1.TrustedCABundleSpec{
+		ManagementState: operatorv1.Managed,
+		CustomCABundle:  inline + "not a certificate",
+		Sources: []dsciv1.TrustedCABundleSource{
+			{ConfigMap: &dsciv1.CABundleKeySelector{Name: "corporate-ca"}},
+			{Secret: &dsciv1.CABundleKeySelector{Name: "partner-ca", Key: "partner.crt"}},
+			{Secret: &dsciv1.CABundleKeySelector{Name: "missing-ca"}},
+		},
+		IncludeProxyTrustedCA: true,
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(bundle.Certificates).To(HaveLen(4))
+	g.Expect(bundle.Certificates[0].Source).To(Equal(certconfigmapgenerator.CustomCABundleSource))
+	g.Expect(bundle.Certificates[1].Source).To(Equal("configmap/corporate-ca"))
+	g.Expect(bundle.Certificates[2].Source).To(Equal("secret/partner-ca"))
+	g.Expect(bundle.Certificates[3].Source).To(Equal(certconfigmapgenerator.ProxyCABundleSource))
+	g.Expect(bundle.PEM()).To(Equal(inline + fromConfigMap + fromSecret + fromProxy))
+
+	g.Expect(bundle.Errors).To(ConsistOf(
+		"customCABundle: content is not PEM encoded",
+		"secret/missing-ca: not found in namespace operator-ns",
+	))
+}
+
+func TestCABundleExpiring(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Now()
+
+	bundle := certconfigmapgenerator.NewCABundle()
+	bundle.Add("customCABundle", []byte(
+		newCACertificate(g, "valid-ca", now.Add(365*24*time.Hour))+
+			newCACertificate(g, "expiring-ca", now.Add(10*24*time.Hour))+
+			newCACertificate(g, "expired-ca", now.Add(-time.Hour)),
+	))
+	g.Expect(bundle.Errors).To(BeEmpty())
+
+	expiring := bundle.Expiring(now, certconfigmapgenerator.CAExpiryWarningThreshold)
+	g.Expect(expiring).To(HaveLen(2))
+	g.Expect(expiring[0].Subject).To(Equal("CN=expired-ca"))
+	g.Expect(expiring[0].Expired).To(BeTrue())
+	g.Expect(expiring[1].Subject).To(Equal("CN=expiring-ca"))
+	g.Expect(expiring[1].Expired).To(BeFalse())
+
+	// the expiring CA expires before the valid one enters its warning window
+	next := bundle.NextTransition(now, certconfigmapgenerator.CAExpiryWarningThreshold)
+	g.Expect(next).To(BeTemporally("~", now.Add(10*24*time.Hour), time.Second))
+}
+
+func TestAddNamespaceSource(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	ca := newCACertificate(g, "team-ca", time.Now().Add(365*24*time.Hour))
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-ca", Namespace: "team-a"},
+			Data:       map[string]string{"ca-bundle.crt": ca},
+		},
+	))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "team-a",
+			Annotations: map[string]string{annotation.TrustedCABundleSource: "configmap/team-ca"},
+		},
+	}
+
+	bundle := certconfigmapgenerator.NewCABundle()
+	g.Expect(bundle.AddNamespaceSource(ctx, cli, ns)).To(Succeed())
+	g.Expect(bundle.Errors).To(BeEmpty())
+	g.Expect(bundle.PEM()).To(Equal(ca))
+
+	// an invalid reference is reported, and left out of the bundle
+	ns.Annotations[annotation.TrustedCABundleSource] = "deployment/team-ca"
+
+	bundle = certconfigmapgenerator.NewCABundle()
+	g.Expect(bundle.AddNamespaceSource(ctx, cli, ns)).To(Succeed())
+	g.Expect(bundle.Certificates).To(BeEmpty())
+	g.Expect(bundle.Errors).To(HaveLen(1))
+}
+
+func TestParseCABundleSource(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		kind    string
+		key     string
+		wantErr bool
+	}{
+		{name: "configmap", value: "configmap/team-ca", kind: "configmap"},
+		{name: "secret with key", value: "Secret/team-ca/tls.crt", kind: "secret", key: "tls.crt"},
+		{name: "unknown kind", value: "deployment/team-ca", wantErr: true},
+		{name: "missing name", value: "configmap/", wantErr: true},
+		{name: "too many parts", value: "configmap/team-ca/ca.crt/extra", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			kind, name, key, err := certconfigmapgenerator.ParseCABundleSource(tt.value)
+			if tt.wantErr {
+				g.Expect(err).Should(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(kind).To(Equal(tt.kind))
+			g.Expect(name).To(Equal("team-ca"))
+			g.Expect(key).To(Equal(tt.key))
+		})
+	}
+}
+
+func TestCABundleClone(t *testing.T) {
+	g := NewWithT(t)
+
+	ca := newCACertificate(g, "shared-ca", time.Now().Add(365*24*time.Hour))
+
+	bundle := certconfigmapgenerator.NewCABundle()
+	bundle.Add(certconfigmapgenerator.CustomCABundleSource, []byte(ca))
+
+	// the namespace certificates added to a clone are not added to the shared bundle
+	clone := bundle.Clone()
+	clone.Add("configmap/team-ca", []byte(newCACertificate(g, "team-ca", time.Now().Add(365*24*time.Hour))))
+	clone.Add("configmap/team-ca", []byte(ca))
+
+	g.Expect(clone.Certificates).To(HaveLen(2))
+	g.Expect(bundle.Certificates).To(HaveLen(1))
+	g.Expect(bundle.PEM()).To(Equal(ca))
+}
+
+func TestCABundleHash(t *testing.T) {
+	g := NewWithT(t)
+
+	ca := newCACertificate(g, "hashed-ca", time.Now().Add(365*24*time.Hour))
+
+	g.Expect(certconfigmapgenerator.CABundleHash(ca)).To(Equal(certconfigmapgenerator.CABundleHash("\n" + ca + "\n\n")))
+	g.Expect(certconfigmapgenerator.CABundleHash(ca)).ToNot(Equal(certconfigmapgenerator.CABundleHash("")))
+}
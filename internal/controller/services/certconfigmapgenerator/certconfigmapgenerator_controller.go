@@ -4,7 +4,9 @@ package certconfigmapgenerator
 import (
 	"context"
 	"fmt"
+	"reflect"
 
+	configv1 "github.com/openshift/api/config/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
@@ -15,7 +17,9 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
@@ -33,6 +37,10 @@ import (
 type CertConfigmapGeneratorReconciler struct {
 	sharedClient client.Client
 	certClient   client.Client
+	certReader   client.Reader
+	// skipUnchanged skips the writes of the ConfigMaps already holding the desired CA bundle, or already deleted,
+	// as known from the ConfigMaps metadata cache.
+	skipUnchanged bool
 }
 
 // NewWithManager sets up the controller with the Manager.
@@ -53,13 +61,7 @@ func NewWithManager(_ context.Context, mgr ctrl.Manager) error {
 				Field: fields.Set{"metadata.name": CAConfigMapName}.AsSelector(),
 			},
 		},
-		DefaultTransform: func(in any) (any, error) {
-			if obj, err := meta.Accessor(in); err == nil && obj.GetManagedFields() != nil {
-				obj.SetManagedFields(nil)
-			}
-
-			return in, nil
-		},
+		DefaultTransform: stripManagedFields,
 	})
 
 	if err != nil {
@@ -71,6 +73,24 @@ func NewWithManager(_ context.Context, mgr ctrl.Manager) error {
 		return fmt.Errorf("unable to register target cache to manager: %w", err)
 	}
 
+	// The ConfigMaps and Secrets referenced by the TrustedCABundleSource annotation of the namespaces can't be
+	// discriminated by a selector, so only their metadata is cached, to reconcile the namespaces when they change.
+	sourceCache, err := cache.New(mgr.GetConfig(), cache.Options{
+		HTTPClient:       mgr.GetHTTPClient(),
+		Scheme:           mgr.GetScheme(),
+		Mapper:           mgr.GetRESTMapper(),
+		DefaultTransform: stripManagedFields,
+	})
+
+	if err != nil {
+		return fmt.Errorf("unable to create source cache: %w", err)
+	}
+
+	err = mgr.Add(sourceCache)
+	if err != nil {
+		return fmt.Errorf("unable to register source cache to manager: %w", err)
+	}
+
 	// create a new client that uses the custom cache
 	targetClient, err := client.New(mgr.GetConfig(), client.Options{
 		HTTPClient: mgr.GetHTTPClient(),
@@ -84,6 +104,10 @@ func NewWithManager(_ context.Context, mgr ctrl.Manager) error {
 				// don’t need to access any of its fields. We only watch it to detect
 				// and revert any external modifications.
 				&corev1.ConfigMap{},
+				// The sources of the CA bundles are read from any namespace, so they
+				// are read directly from the API server.
+				&corev1.Secret{},
+				&configv1.Proxy{},
 			},
 		},
 	})
@@ -94,6 +118,7 @@ func NewWithManager(_ context.Context, mgr ctrl.Manager) error {
 
 	r.sharedClient = mgr.GetClient()
 	r.certClient = targetClient
+	r.certReader = targetCache
 
 	b := ctrl.NewControllerManagedBy(mgr).
 		Named("cert-configmap-generator-controller")
@@ -115,7 +140,16 @@ func NewWithManager(_ context.Context, mgr ctrl.Manager) error {
 			mgr.GetCache(),
 			&corev1.Namespace{},
 			handlers.RequestFromObject(),
-			respredicates.AnnotationChanged(annotation.InjectionOfCABundleAnnotatoion),
+			predicate.Or(
+				respredicates.AnnotationChanged(annotation.InjectionOfCABundleAnnotatoion),
+				respredicates.AnnotationChanged(annotation.TrustedCABundleSource),
+				// the labels select the namespaces the CA bundle is injected in
+				predicate.Funcs{
+					UpdateFunc: func(e event.UpdateEvent) bool {
+						return !reflect.DeepEqual(e.ObjectNew.GetLabels(), e.ObjectOld.GetLabels())
+					},
+				},
+			),
 		),
 	)
 
@@ -144,22 +178,40 @@ func NewWithManager(_ context.Context, mgr ctrl.Manager) error {
 	)
 
 	//
-	// DSCInitialization
+	// Namespace CA bundle sources
 	//
 	b = b.WatchesRawSource(
-		// The DSCInitialization singleton is shared across nearly all controllers.
-		// It uses the manager's shared cache to prevent the creation of redundant informers.
 		source.TypedKind[client.Object, ctrl.Request](
-			mgr.GetCache(),
-			&dsciv1.DSCInitialization{},
-			dsciEventHandler(r.sharedClient),
-			dsciPredicates(r.sharedClient),
+			sourceCache,
+			resources.GvkToPartial(gvk.ConfigMap),
+			handlers.Fn(namespaceSourceRequests(r.sharedClient, ConfigMapSourceKind)),
+			predicate.ResourceVersionChangedPredicate{},
+		),
+	)
+
+	b = b.WatchesRawSource(
+		source.TypedKind[client.Object, ctrl.Request](
+			sourceCache,
+			resources.GvkToPartial(gvk.Secret),
+			handlers.Fn(namespaceSourceRequests(r.sharedClient, SecretSourceKind)),
+			predicate.ResourceVersionChangedPredicate{},
 		),
 	)
 
-	return b.Complete(
+	err = b.Complete(
 		reconcile.AsReconciler[*corev1.Namespace](r.sharedClient, &r),
 	)
+	if err != nil {
+		return err
+	}
+
+	// The changes of the DSCInitialization and of the CA bundle sources, which affect all the namespaces,
+	// are distributed by a dedicated controller.
+	if err := newDistributionController(mgr, r); err != nil {
+		return err
+	}
+
+	return newStatusController(mgr, r.sharedClient, r.certClient)
 }
 
 // Reconcile will generate new configmap, odh-trusted-ca-bundle, that includes cluster-wide
@@ -185,25 +237,37 @@ func (r *CertConfigmapGeneratorReconciler) Reconcile(ctx context.Context, ns *co
 		return ctrl.Result{}, fmt.Errorf("failed to retrieve DSCInitialization: %w", err)
 	}
 
+	selector, err := namespaceSelector(dsci.Spec.TrustedCABundle)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	switch {
 	case dsci.Spec.TrustedCABundle == nil:
 		l.Info("Trusted CA Bundle is not configured in DSCI, skip CA bundle injection and delete existing configmap")
 
-		if err := DeleteOdhTrustedCABundleConfigMap(ctx, r.certClient, ns.Name); err != nil {
+		if err := r.deleteConfigMap(ctx, ns.Name); err != nil {
 			return reconcile.Result{}, fmt.Errorf("error deleting existing configmap: %w", err)
 		}
 
 	case !ShouldInjectTrustedCABundle(ns):
 		l.Info("Namespace has opted-out of CA bundle injection, deleting it")
 
-		if err := DeleteOdhTrustedCABundleConfigMap(ctx, r.certClient, ns.Name); err != nil {
+		if err := r.deleteConfigMap(ctx, ns.Name); err != nil {
+			return reconcile.Result{}, fmt.Errorf("error deleting existing configmap: %w", err)
+		}
+
+	case !selector.Matches(labels.Set(ns.Labels)):
+		l.V(3).Info("Namespace is not selected for CA bundle injection, deleting it")
+
+		if err := r.deleteConfigMap(ctx, ns.Name); err != nil {
 			return reconcile.Result{}, fmt.Errorf("error deleting existing configmap: %w", err)
 		}
 
 	case dsci.Spec.TrustedCABundle.ManagementState == operatorv1.Removed:
 		l.Info("Trusted CA Bundle injection is set to `Removed` state, skip CA bundle injection and delete existing configmap")
 
-		if err := DeleteOdhTrustedCABundleConfigMap(ctx, r.certClient, ns.Name); err != nil {
+		if err := r.deleteConfigMap(ctx, ns.Name); err != nil {
 			return reconcile.Result{}, fmt.Errorf("error deleting existing configmap: %w", err)
 		}
 
@@ -211,12 +275,88 @@ func (r *CertConfigmapGeneratorReconciler) Reconcile(ctx context.Context, ns *co
 		l.Info("Trusted CA Bundle injection is set to `Unmanaged` state, configmap is no longer managed by operator")
 
 	default:
+		bundle, err := r.trustedCABundle(ctx, dsci)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("error composing CA bundle: %w", err)
+		}
+
+		if err := bundle.AddNamespaceSource(ctx, r.certClient, ns); err != nil {
+			return reconcile.Result{}, fmt.Errorf("error composing CA bundle: %w", err)
+		}
+
+		// the invalid sources are left out of the bundle, the ones configured in the DSCInitialization
+		// are also reported in its status
+		if err := bundle.Err(); err != nil {
+			l.Info("Invalid CA bundle sources", "errors", err.Error())
+		}
+
+		data := bundle.PEM()
+		if r.skipUnchanged && r.hasConfigMap(ctx, ns.Name, CABundleHash(data)) {
+			TrustedCABundleConfigMapReconcilesTotal.WithLabelValues(unchangedResult).Inc()
+			return ctrl.Result{}, nil
+		}
+
 		l.Info("Adding CA bundle configmap")
 
-		if err := CreateOdhTrustedCABundleConfigMap(ctx, r.certClient, ns.Name, dsci.Spec.TrustedCABundle.CustomCABundle); err != nil {
+		if err := CreateOdhTrustedCABundleConfigMap(ctx, r.certClient, ns.Name, data); err != nil {
 			return reconcile.Result{}, fmt.Errorf("error adding configmap to namespace: %w", err)
 		}
+
+		TrustedCABundleConfigMapReconcilesTotal.WithLabelValues(appliedResult).Inc()
 	}
 
 	return ctrl.Result{}, nil
 }
+
+// trustedCABundle returns the custom CA bundle configured in the DSCInitialization. The distribution controller
+// reuses the bundle composed when the distribution started, instead of reading its sources for each namespace.
+func (r *CertConfigmapGeneratorReconciler) trustedCABundle(ctx context.Context, dsci *dsciv1.DSCInitialization) (*CABundle, error) {
+	if r.skipUnchanged {
+		if bundle := distribution.bundle(); bundle != nil {
+			return bundle, nil
+		}
+	}
+
+	operatorNs, err := cluster.GetOperatorNamespace()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTrustedCABundle(ctx, r.certClient, operatorNs, dsci.Spec.TrustedCABundle)
+}
+
+// hasConfigMap returns whether the CA bundle ConfigMap of the namespace exists in the ConfigMaps metadata cache,
+// holding the CA bundle of the given hash when set.
+func (r *CertConfigmapGeneratorReconciler) hasConfigMap(ctx context.Context, namespace string, hash string) bool {
+	cm := resources.GvkToPartial(gvk.ConfigMap)
+
+	err := r.certReader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: CAConfigMapName}, cm)
+	if err != nil {
+		return false
+	}
+
+	return hash == "" || cm.GetAnnotations()[annotation.TrustedCABundleHash] == hash
+}
+
+// stripManagedFields removes the managed fields of the cached objects, which are never read.
+func stripManagedFields(in any) (any, error) {
+	if obj, err := meta.Accessor(in); err == nil && obj.GetManagedFields() != nil {
+		obj.SetManagedFields(nil)
+	}
+
+	return in, nil
+}
+
+func (r *CertConfigmapGeneratorReconciler) deleteConfigMap(ctx context.Context, namespace string) error {
+	if r.skipUnchanged && !r.hasConfigMap(ctx, namespace, "") {
+		return nil
+	}
+
+	if err := DeleteOdhTrustedCABundleConfigMap(ctx, r.certClient, namespace); err != nil {
+		return err
+	}
+
+	TrustedCABundleConfigMapReconcilesTotal.WithLabelValues(deletedResult).Inc()
+
+	return nil
+}
@@ -0,0 +1,275 @@
+package certconfigmapgenerator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+const (
+	// distributionQPS and distributionBurst bound the rate at which the namespaces are reconciled when the CA
+	// bundle is distributed to all of them.
+	distributionQPS   = 20
+	distributionBurst = 100
+)
+
+// distribution tracks the distribution of the CA bundle to the namespaces.
+var distribution = newDistributionTracker()
+
+// distributionTracker tracks the progress of the distribution of the CA bundle to the namespaces, started at each
+// change of the DSCInitialization or of the CA bundle sources.
+type distributionTracker struct {
+	mu      sync.Mutex
+	since   time.Time
+	targets int
+	pending sets.Set[string]
+	// failed holds the namespaces the CA bundle could not be distributed to, retried by the work queue.
+	failed   sets.Set[string]
+	caBundle *CABundle
+	// changes receives an event when a distribution starts or completes, or the set of failed namespaces changes.
+	changes chan event.GenericEvent
+}
+
+// distributionProgress is the progress of the distribution of the CA bundle to the namespaces.
+type distributionProgress struct {
+	// distributed is the number of namespaces the CA bundle was distributed to.
+	distributed int
+	// failed lists the namespaces the CA bundle could not be distributed to.
+	failed []string
+	// targets is the number of namespaces targeted by the distribution.
+	targets int
+	// lag is the time since the distribution in progress started, zero when complete.
+	lag time.Duration
+}
+
+func newDistributionTracker() *distributionTracker {
+	return &distributionTracker{
+		pending: sets.New[string](),
+		failed:  sets.New[string](),
+		changes: make(chan event.GenericEvent, 1),
+	}
+}
+
+// start starts a distribution to the namespaces of the requests, of the CA bundle composed for the DSCInitialization
+// if any. A distribution started while another one is in progress lags since the latter started.
+func (t *distributionTracker) start(requests []reconcile.Request, bundle *CABundle) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.pending.Len() == 0 {
+		t.since = time.Now()
+	}
+
+	t.pending = sets.New[string]()
+	t.failed = sets.New[string]()
+	for _, r := range requests {
+		t.pending.Insert(r.Name)
+	}
+
+	t.targets = len(requests)
+	t.caBundle = bundle
+
+	t.notify()
+}
+
+// done records the distribution of the CA bundle to the namespace, after a failure as well.
+func (t *distributionTracker) done(namespace string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch {
+	case t.pending.Has(namespace):
+		t.pending.Delete(namespace)
+
+		if t.pending.Len() == 0 {
+			t.notify()
+		}
+	case t.failed.Has(namespace):
+		t.failed.Delete(namespace)
+		t.notify()
+	}
+}
+
+// fail records a failed distribution of the CA bundle to the namespace, so that the distribution completes
+// while the namespace is retried.
+func (t *distributionTracker) fail(namespace string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.pending.Has(namespace) {
+		t.pending.Delete(namespace)
+		t.failed.Insert(namespace)
+
+		if t.pending.Len() == 0 {
+			t.notify()
+		}
+	}
+}
+
+// notify sends a change event, unless one is already waiting to be handled.
+func (t *distributionTracker) notify() {
+	select {
+	case t.changes <- event.GenericEvent{Object: &dsciv1.DSCInitialization{}}:
+	default:
+	}
+}
+
+// bundle returns a copy of the CA bundle being distributed, nil when unknown.
+func (t *distributionTracker) bundle() *CABundle {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.caBundle == nil {
+		return nil
+	}
+
+	return t.caBundle.Clone()
+}
+
+// progress returns the progress of the last distribution of the CA bundle.
+func (t *distributionTracker) progress() distributionProgress {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p := distributionProgress{
+		distributed: t.targets - t.pending.Len() - t.failed.Len(),
+		failed:      sets.List(t.failed),
+		targets:     t.targets,
+	}
+	if t.pending.Len() != 0 {
+		p.lag = time.Since(t.since)
+	}
+
+	return p
+}
+
+// newDistributionController sets up the controller distributing the CA bundle to all the namespaces when the
+// DSCInitialization, or a CA bundle source, changes. Its work queue is rate limited, and it skips the namespaces
+// already holding the CA bundle, so that a change does not result in a burst of writes on large clusters.
+func newDistributionController(mgr ctrl.Manager, r CertConfigmapGeneratorReconciler) error {
+	r.skipUnchanged = true
+
+	dsciFromEvent := func(_ context.Context, obj client.Object) *dsciv1.DSCInitialization {
+		dsci, _ := obj.(*dsciv1.DSCInitialization)
+		return dsci
+	}
+
+	dsciFromSource := func(ctx context.Context, obj client.Object) *dsciv1.DSCInitialization {
+		if !isTrustedCABundleSource(ctx, r.sharedClient, obj) {
+			return nil
+		}
+
+		dsci, err := cluster.GetDSCI(ctx, r.sharedClient)
+		if err != nil {
+			return nil
+		}
+
+		return dsci
+	}
+
+	namespaceReconciler := reconcile.AsReconciler[*corev1.Namespace](r.sharedClient, &r)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("cert-configmap-distribution-controller").
+		WithOptions(controller.Options{
+			RateLimiter: workqueue.NewTypedMaxOfRateLimiter(
+				workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](5*time.Millisecond, 1000*time.Second),
+				&workqueue.TypedBucketRateLimiter[reconcile.Request]{Limiter: rate.NewLimiter(rate.Limit(distributionQPS), distributionBurst)},
+			),
+		}).
+		WatchesRawSource(
+			// The DSCInitialization singleton is shared across nearly all controllers.
+			// It uses the manager's shared cache to prevent the creation of redundant informers.
+			source.TypedKind[client.Object, ctrl.Request](
+				mgr.GetCache(),
+				&dsciv1.DSCInitialization{},
+				fanOutHandler(r, dsciFromEvent),
+				dsciPredicates(r.sharedClient),
+			),
+		).
+		// The ConfigMaps and Secrets of the operator namespace are in the shared cache.
+		Watches(&corev1.ConfigMap{}, fanOutHandler(r, dsciFromSource)).
+		Watches(&corev1.Secret{}, fanOutHandler(r, dsciFromSource)).
+		Complete(reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+			res, err := namespaceReconciler.Reconcile(ctx, req)
+			if err != nil {
+				distribution.fail(req.Name)
+			} else {
+				distribution.done(req.Name)
+			}
+
+			return res, err
+		}))
+}
+
+// fanOutHandler creates an event handler starting the distribution of the CA bundle of the DSCInitialization
+// returned by dsciFn, if any. The reconciliation requests of the namespaces are added through the rate limiter of
+// the work queue.
+func fanOutHandler(r CertConfigmapGeneratorReconciler, dsciFn func(context.Context, client.Object) *dsciv1.DSCInitialization) handler.EventHandler {
+	fanOut := func(ctx context.Context, obj client.Object, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+		dsci := dsciFn(ctx, obj)
+		if dsci == nil {
+			return
+		}
+
+		requests := namespaceRequests(ctx, r.sharedClient, r.certReader, dsci)
+		distribution.start(requests, distributedBundle(ctx, r.certClient, dsci))
+
+		for _, req := range requests {
+			q.AddRateLimited(req)
+		}
+	}
+
+	return handler.Funcs{
+		CreateFunc: func(ctx context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			fanOut(ctx, e.Object, q)
+		},
+		UpdateFunc: func(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			fanOut(ctx, e.ObjectNew, q)
+		},
+		DeleteFunc: func(ctx context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			fanOut(ctx, e.Object, q)
+		},
+		GenericFunc: func(ctx context.Context, e event.GenericEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			fanOut(ctx, e.Object, q)
+		},
+	}
+}
+
+// distributedBundle composes the custom CA bundle of the DSCInitialization once for all the namespaces, nil when
+// the trusted CA bundle is not managed or its sources cannot be read.
+func distributedBundle(ctx context.Context, cli client.Client, dsci *dsciv1.DSCInitialization) *CABundle {
+	if dsci.Spec.TrustedCABundle == nil || dsci.Spec.TrustedCABundle.ManagementState != operatorv1.Managed {
+		return nil
+	}
+
+	operatorNs, err := cluster.GetOperatorNamespace()
+	if err != nil {
+		return nil
+	}
+
+	bundle, err := NewTrustedCABundle(ctx, cli, operatorNs, dsci.Spec.TrustedCABundle)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Unable to compose CA bundle, it is composed for each namespace")
+		return nil
+	}
+
+	return bundle
+}
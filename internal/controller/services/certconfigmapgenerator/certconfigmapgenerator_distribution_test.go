@@ -0,0 +1,229 @@
+//nolint:testpackage // Need to test unexported functions
+package certconfigmapgenerator
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	annotation "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func namespaceRequest(name string) reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName{Name: name}}
+}
+
+func TestDistributionTracker(t *testing.T) {
+	g := NewWithT(t)
+
+	tracker := newDistributionTracker()
+	tracker.start([]reconcile.Request{namespaceRequest("ns-a"), namespaceRequest("ns-b"), namespaceRequest("ns-c")}, nil)
+	g.Expect(tracker.changes).To(Receive())
+
+	time.Sleep(10 * time.Millisecond)
+
+	p := tracker.progress()
+	g.Expect(p.distributed).To(Equal(0))
+	g.Expect(p.targets).To(Equal(3))
+	g.Expect(p.lag).To(BeNumerically(">=", 10*time.Millisecond))
+
+	reason, message, conditionStatus := distributionCondition(p)
+	g.Expect(reason).To(Equal(status.DistributionInProgressReason))
+	g.Expect(message).To(Equal("CA bundle distributed to 0 of 3 namespaces"))
+	g.Expect(conditionStatus).To(Equal(metav1.ConditionFalse))
+
+	// a distribution started while another one is in progress lags since the latter started
+	tracker.start([]reconcile.Request{namespaceRequest("ns-a"), namespaceRequest("ns-b"), namespaceRequest("ns-c")}, nil)
+	g.Expect(tracker.progress().lag).To(BeNumerically(">=", 10*time.Millisecond))
+
+	// a failed namespace does not hold the distribution back
+	tracker.done("ns-a")
+	tracker.fail("ns-b")
+	tracker.done("ns-c")
+	g.Expect(tracker.changes).To(Receive())
+
+	p = tracker.progress()
+	g.Expect(p.distributed).To(Equal(2))
+	g.Expect(p.failed).To(Equal([]string{"ns-b"}))
+	g.Expect(p.lag).To(BeZero())
+
+	reason, message, conditionStatus = distributionCondition(p)
+	g.Expect(reason).To(Equal(status.DistributionFailedReason))
+	g.Expect(message).To(Equal("CA bundle distributed to 2 of 3 namespaces, failed for: ns-b"))
+	g.Expect(conditionStatus).To(Equal(metav1.ConditionFalse))
+
+	// the failed namespace is distributed once retried
+	tracker.done("ns-b")
+	g.Expect(tracker.changes).To(Receive())
+
+	reason, message, conditionStatus = distributionCondition(tracker.progress())
+	g.Expect(reason).To(Equal(status.ReadyReason))
+	g.Expect(message).To(Equal("CA bundle distributed to 3 namespaces"))
+	g.Expect(conditionStatus).To(Equal(metav1.ConditionTrue))
+}
+
+func TestNamespaceRequests(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-c"}},
+		// the ConfigMap of a namespace no longer selected is deleted
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: CAConfigMapName, Namespace: "team-c"}},
+	))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	dsci := &dsciv1.DSCInitialization{
+		Spec: dsciv1.DSCInitializationSpec{
+			TrustedCABundle: &dsciv1.TrustedCABundleSpec{ManagementState: operatorv1.Managed},
+		},
+	}
+
+	g.Expect(namespaceRequests(ctx, cli, cli, dsci)).To(Equal([]reconcile.Request{
+		namespaceRequest("team-a"),
+		namespaceRequest("team-b"),
+		namespaceRequest("team-c"),
+	}))
+
+	dsci.Spec.TrustedCABundle.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+
+	g.Expect(namespaceRequests(ctx, cli, cli, dsci)).To(Equal([]reconcile.Request{
+		namespaceRequest("team-a"),
+		namespaceRequest("team-c"),
+	}))
+}
+
+func TestNamespaceSourceRequests(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:        "team-a",
+			Annotations: map[string]string{annotation.TrustedCABundleSource: "secret/team-ca/ca.crt"},
+		}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}},
+	))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	secretRequests := namespaceSourceRequests(cli, SecretSourceKind)
+	configMapRequests := namespaceSourceRequests(cli, ConfigMapSourceKind)
+
+	g.Expect(secretRequests(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "team-ca", Namespace: "team-a"}})).
+		To(Equal([]reconcile.Request{namespaceRequest("team-a")}))
+
+	// the objects not referenced by the annotation of their namespace are ignored
+	g.Expect(secretRequests(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "team-a"}})).To(BeEmpty())
+	g.Expect(configMapRequests(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "team-ca", Namespace: "team-a"}})).To(BeEmpty())
+	g.Expect(secretRequests(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "team-ca", Namespace: "team-b"}})).To(BeEmpty())
+}
+
+func TestReconcileSkipsUnchangedBundle(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "inline-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(365 * 24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	spec := &dsciv1.TrustedCABundleSpec{
+		ManagementState: operatorv1.Managed,
+		CustomCABundle:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	}
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+		Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+	}
+
+	sharedClient, err := fakeclient.New(fakeclient.WithObjects(
+		&dsciv1.DSCInitialization{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+			Spec:       dsciv1.DSCInitializationSpec{TrustedCABundle: spec},
+		},
+		ns,
+	))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	bundle, err := NewTrustedCABundle(ctx, sharedClient, "operator-ns", spec)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        CAConfigMapName,
+			Namespace:   ns.Name,
+			Labels:      map[string]string{labels.K8SCommon.PartOf: PartOf},
+			Annotations: map[string]string{annotation.TrustedCABundleHash: CABundleHash(bundle.PEM())},
+		},
+	}
+
+	writes := 0
+	certClient, err := fakeclient.New(
+		fakeclient.WithObjects(cm),
+		fakeclient.WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(_ context.Context, _ client.WithWatch, _ client.Object, _ client.Patch, _ ...client.PatchOption) error {
+				writes++
+				return nil
+			},
+		}),
+	)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	// the distribution controller reuses the bundle composed when the distribution started
+	distribution.start(nil, bundle)
+	t.Cleanup(func() { distribution.start(nil, nil) })
+
+	r := CertConfigmapGeneratorReconciler{
+		sharedClient:  sharedClient,
+		certClient:    certClient,
+		certReader:    certClient,
+		skipUnchanged: true,
+	}
+
+	_, err = r.Reconcile(ctx, ns)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(writes).To(Equal(0))
+
+	// a ConfigMap holding another bundle is updated
+	cm.Annotations[annotation.TrustedCABundleHash] = "stale"
+	g.Expect(certClient.Update(ctx, cm)).To(Succeed())
+
+	_, err = r.Reconcile(ctx, ns)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(writes).To(Equal(1))
+}
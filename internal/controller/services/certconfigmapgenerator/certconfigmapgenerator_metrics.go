@@ -0,0 +1,89 @@
+package certconfigmapgenerator
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	appliedResult   = "applied"
+	deletedResult   = "deleted"
+	unchangedResult = "unchanged"
+)
+
+var (
+	// TrustedCABundleConfigMapReconcilesTotal is a prometheus counter metrics which holds the total number
+	// of reconciliations of the trusted CA bundle ConfigMaps. It has one label.
+	// result label refers to the outcome of the reconciliation (applied, deleted, unchanged).
+	TrustedCABundleConfigMapReconcilesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "trusted_ca_bundle_configmap_reconciles_total",
+			Help: "Number of reconciliations of the trusted CA bundle ConfigMaps",
+		},
+		[]string{
+			"result",
+		},
+	)
+
+	// TrustedCABundleDistributionNamespaces is a prometheus gauge metrics which holds the number of namespaces
+	// targeted by the last distribution of the trusted CA bundle.
+	TrustedCABundleDistributionNamespaces = prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "trusted_ca_bundle_distribution_namespaces",
+			Help: "Number of namespaces targeted by the last distribution of the trusted CA bundle",
+		},
+		func() float64 {
+			return float64(distribution.progress().targets)
+		},
+	)
+
+	// TrustedCABundleDistributedNamespaces is a prometheus gauge metrics which holds the number of namespaces
+	// the last distribution of the trusted CA bundle reached.
+	TrustedCABundleDistributedNamespaces = prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "trusted_ca_bundle_distributed_namespaces",
+			Help: "Number of namespaces the last distribution of the trusted CA bundle reached",
+		},
+		func() float64 {
+			return float64(distribution.progress().distributed)
+		},
+	)
+
+	// TrustedCABundleDistributionLagSeconds is a prometheus gauge metrics which holds the time since the
+	// distribution of the trusted CA bundle in progress started, zero when no distribution is in progress.
+	TrustedCABundleDistributionLagSeconds = prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "trusted_ca_bundle_distribution_lag_seconds",
+			Help: "Time since the distribution of the trusted CA bundle in progress started",
+		},
+		func() float64 {
+			return distribution.progress().lag.Seconds()
+		},
+	)
+
+	// TrustedCABundleDistributionFailedNamespaces is a prometheus gauge metrics which holds the number of
+	// namespaces the last distribution of the trusted CA bundle failed to reach, retried until they succeed.
+	TrustedCABundleDistributionFailedNamespaces = prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "trusted_ca_bundle_distribution_failed_namespaces",
+			Help: "Number of namespaces the last distribution of the trusted CA bundle failed to reach",
+		},
+		func() float64 {
+			return float64(len(distribution.progress().failed))
+		},
+	)
+)
+
+// init register metrics to the global registry from controller-runtime/pkg/metrics.
+// see https://book.kubebuilder.io/reference/metrics#publishing-additional-metrics
+//
+//nolint:gochecknoinits
+func init() {
+	metrics.Registry.MustRegister(
+		TrustedCABundleConfigMapReconcilesTotal,
+		TrustedCABundleDistributionNamespaces,
+		TrustedCABundleDistributedNamespaces,
+		TrustedCABundleDistributionLagSeconds,
+		TrustedCABundleDistributionFailedNamespaces,
+	)
+}
@@ -0,0 +1,202 @@
+package certconfigmapgenerator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+)
+
+const (
+	// maxStatusRequeueInterval bounds the time between two checks of the custom CA bundle, as the trustedCA
+	// ConfigMap of the cluster proxy is not watched.
+	maxStatusRequeueInterval = 24 * time.Hour
+	minStatusRequeueInterval = time.Minute
+	// distributionStatusInterval is the time between two updates of the status while the CA bundle is being
+	// distributed.
+	distributionStatusInterval = 15 * time.Second
+	// maxReportedNamespaces bounds the number of namespaces listed in the status when the distribution failed.
+	maxReportedNamespaces = 10
+)
+
+// TrustedCABundleStatusReconciler reports the CA certificates of the custom CA bundle, the invalid, expired or
+// expiring ones, and the progress of its distribution to the namespaces in the DSCInitialization status.
+type TrustedCABundleStatusReconciler struct {
+	// Client reads and updates the DSCInitialization.
+	Client client.Client
+	// SourceClient reads the sources of the custom CA bundle.
+	SourceClient client.Client
+}
+
+func newStatusController(mgr ctrl.Manager, sharedClient client.Client, sourceClient client.Client) error {
+	r := TrustedCABundleStatusReconciler{
+		Client:       sharedClient,
+		SourceClient: sourceClient,
+	}
+
+	dsciRequest := func(ctx context.Context) []reconcile.Request {
+		dsci, err := cluster.GetDSCI(ctx, sharedClient)
+		if err != nil {
+			return []reconcile.Request{}
+		}
+
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: dsci.Name}}}
+	}
+
+	sourceRequest := handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		if !isTrustedCABundleSource(ctx, sharedClient, obj) {
+			return []reconcile.Request{}
+		}
+
+		return dsciRequest(ctx)
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("trusted-ca-bundle-status-controller").
+		For(&dsciv1.DSCInitialization{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.ConfigMap{}, sourceRequest).
+		Watches(&corev1.Secret{}, sourceRequest).
+		// the distributions of the CA bundle to the namespaces are reported when they start and complete
+		WatchesRawSource(source.Channel(distribution.changes, handler.EnqueueRequestsFromMapFunc(
+			func(ctx context.Context, _ client.Object) []reconcile.Request {
+				return dsciRequest(ctx)
+			},
+		))).
+		Complete(reconcile.AsReconciler[*dsciv1.DSCInitialization](sharedClient, &r))
+}
+
+// Reconcile composes the custom CA bundle and reports its state in the DSCInitialization status. It reconciles
+// again when a certificate of the bundle starts expiring, or expires.
+func (r *TrustedCABundleStatusReconciler) Reconcile(ctx context.Context, dsci *dsciv1.DSCInitialization) (ctrl.Result, error) {
+	if dsci.Spec.TrustedCABundle == nil || dsci.Spec.TrustedCABundle.ManagementState != operatorv1.Managed {
+		if dsci.Status.TrustedCABundle == nil &&
+			conditions.FindStatusCondition(&dsci.Status, status.ConditionTrustedCABundleValid) == nil &&
+			conditions.FindStatusCondition(&dsci.Status, status.ConditionTrustedCABundleDistributed) == nil {
+			return ctrl.Result{}, nil
+		}
+
+		_, err := status.UpdateWithRetry(ctx, r.Client, dsci, func(saved *dsciv1.DSCInitialization) {
+			saved.Status.TrustedCABundle = nil
+			conditions.RemoveStatusCondition(&saved.Status, status.ConditionTrustedCABundleValid)
+			conditions.RemoveStatusCondition(&saved.Status, status.ConditionTrustedCABundleDistributed)
+		})
+
+		return ctrl.Result{}, err
+	}
+
+	operatorNs, err := cluster.GetOperatorNamespace()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	bundle, err := NewTrustedCABundle(ctx, r.SourceClient, operatorNs, dsci.Spec.TrustedCABundle)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	now := time.Now()
+	expiring := bundle.Expiring(now, CAExpiryWarningThreshold)
+	reason, message, conditionStatus := bundleCondition(bundle, expiring)
+	progress := distribution.progress()
+	distributionReason, distributionMessage, distributionStatus := distributionCondition(progress)
+
+	_, err = status.UpdateWithRetry(ctx, r.Client, dsci, func(saved *dsciv1.DSCInitialization) {
+		saved.Status.TrustedCABundle = &dsciv1.TrustedCABundleStatus{
+			Certificates:         len(bundle.Certificates),
+			ExpiringCertificates: expiring,
+		}
+		status.SetCondition(&saved.Status.Conditions, status.ConditionTrustedCABundleValid, reason, message, conditionStatus)
+		status.SetCondition(&saved.Status.Conditions, status.ConditionTrustedCABundleDistributed,
+			distributionReason, distributionMessage, distributionStatus)
+	})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update DSCInitialization status: %w", err)
+	}
+
+	requeueAfter := maxStatusRequeueInterval
+	if next := bundle.NextTransition(now, CAExpiryWarningThreshold); !next.IsZero() {
+		requeueAfter = min(max(next.Sub(now), minStatusRequeueInterval), maxStatusRequeueInterval)
+	}
+
+	if progress.lag != 0 {
+		requeueAfter = distributionStatusInterval
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// distributionCondition returns the reason, message and status of the TrustedCABundleDistributed condition: it is
+// false while the distribution is in progress or when it failed for some namespaces, and true otherwise.
+func distributionCondition(p distributionProgress) (string, string, metav1.ConditionStatus) {
+	switch {
+	case p.lag != 0:
+		return status.DistributionInProgressReason,
+			fmt.Sprintf("CA bundle distributed to %d of %d namespaces", p.distributed, p.targets),
+			metav1.ConditionFalse
+	case len(p.failed) != 0:
+		failed := p.failed
+		if len(failed) > maxReportedNamespaces {
+			failed = append(failed[:maxReportedNamespaces:maxReportedNamespaces], fmt.Sprintf("%d more", len(p.failed)-maxReportedNamespaces))
+		}
+
+		return status.DistributionFailedReason,
+			fmt.Sprintf("CA bundle distributed to %d of %d namespaces, failed for: %s", p.distributed, p.targets, strings.Join(failed, ", ")),
+			metav1.ConditionFalse
+	default:
+		return status.ReadyReason,
+			fmt.Sprintf("CA bundle distributed to %d namespaces", p.targets),
+			metav1.ConditionTrue
+	}
+}
+
+// bundleCondition returns the reason, message and status of the TrustedCABundleValid condition: it is false when
+// a source is invalid or a certificate expired, and true otherwise, with the expiring certificates in its message.
+func bundleCondition(bundle *CABundle, expiring []dsciv1.TrustedCACertificate) (string, string, metav1.ConditionStatus) {
+	if err := bundle.Err(); err != nil {
+		return status.InvalidCABundleReason, err.Error(), metav1.ConditionFalse
+	}
+
+	expired := make([]string, 0)
+	soon := make([]string, 0)
+
+	for _, c := range expiring {
+		entry := fmt.Sprintf("%s (%s, %s)", c.Subject, c.Source, c.NotAfter.UTC().Format(time.RFC3339))
+		if c.Expired {
+			expired = append(expired, entry)
+		} else {
+			soon = append(soon, entry)
+		}
+	}
+
+	switch {
+	case len(expired) != 0:
+		return status.CACertificatesExpiredReason,
+			"Expired CA certificates: " + strings.Join(expired, ", "),
+			metav1.ConditionFalse
+	case len(soon) != 0:
+		return status.CACertificatesExpiringReason,
+			"CA certificates expiring soon: " + strings.Join(soon, ", "),
+			metav1.ConditionTrue
+	default:
+		return status.ReadyReason,
+			fmt.Sprintf("%d CA certificates in the custom CA bundle", len(bundle.Certificates)),
+			metav1.ConditionTrue
+	}
+}
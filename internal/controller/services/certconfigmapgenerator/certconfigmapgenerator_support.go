@@ -3,6 +3,9 @@ package certconfigmapgenerator
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"reflect"
 	"strconv"
 	"strings"
@@ -10,13 +13,17 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
-	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	annotation "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
@@ -44,9 +51,9 @@ func CreateOdhTrustedCABundleConfigMap(ctx context.Context, cli client.Client, n
 			Namespace: namespace,
 			Labels: map[string]string{
 				labels.K8SCommon.PartOf: PartOf,
-				// Label 'config.openshift.io/inject-trusted-cabundle' required for the Cluster Network Operator(CNO)
-				// to inject the cluster trusted CA bundle into .data["ca-bundle.crt"]
-				labels.InjectTrustCA: labels.True,
+			},
+			Annotations: map[string]string{
+				annotation.TrustedCABundleHash: CABundleHash(customCAData),
 			},
 		},
 		// Add the DSCInitialzation specified TrustedCABundle.CustomCABundle to CM's data.odh-ca-bundle.crt field
@@ -58,6 +65,12 @@ func CreateOdhTrustedCABundleConfigMap(ctx context.Context, cli client.Client, n
 		},
 	}
 
+	// Label 'config.openshift.io/inject-trusted-cabundle' required for the Cluster Network Operator(CNO)
+	// to inject the cluster trusted CA bundle into .data["ca-bundle.crt"], there is no CNO on Kubernetes
+	if !cluster.IsKubernetes() {
+		desiredConfigMap.Labels[labels.InjectTrustCA] = labels.True
+	}
+
 	err := resources.Apply(
 		ctx,
 		cli,
@@ -73,6 +86,13 @@ func CreateOdhTrustedCABundleConfigMap(ctx context.Context, cli client.Client, n
 	return nil
 }
 
+// CABundleHash returns the hash of the custom CA bundle of a ConfigMap, set in its TrustedCABundleHash annotation
+// to skip the writes of unchanged bundles.
+func CABundleHash(customCAData string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(customCAData) + "\n"))
+	return hex.EncodeToString(sum[:])
+}
+
 func DeleteOdhTrustedCABundleConfigMap(ctx context.Context, cli client.Client, namespace string) error {
 	cm := corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
@@ -102,45 +122,124 @@ func ShouldInjectTrustedCABundle(obj client.Object) bool {
 	return shouldInject
 }
 
-// dsciEventHandler creates an event handler for DSCInitialization events. When a DSCInitialization
-// resource changes, this handler enqueues reconciliation requests for all namespaces in the cluster,
-// allowing the controller to update CA Bundle configuration across all namespaces.
-//
-// Parameters:
-//   - cli: Kubernetes client used to list namespaces
-//
-// Returns:
-//   - handler.EventHandler: Event handler that maps DSCInitialization events to namespace reconcile requests
-func dsciEventHandler(cli client.Client) handler.EventHandler {
-	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
-		requests := make([]reconcile.Request, 0)
+// namespaceRequests returns reconciliation requests for the namespaces selected by the namespace selector of the
+// trusted CA bundle, and for the namespaces holding a CA bundle ConfigMap, to be deleted when no longer selected.
+func namespaceRequests(ctx context.Context, cli client.Client, reader client.Reader, dsci *dsciv1.DSCInitialization) []reconcile.Request {
+	selector, err := namespaceSelector(dsci.Spec.TrustedCABundle)
+	if err != nil {
+		return []reconcile.Request{}
+	}
+
+	names := sets.New[string]()
 
-		lo := client.ListOptions{
-			Limit: NSListLimit,
+	lo := client.ListOptions{
+		Limit:         NSListLimit,
+		LabelSelector: selector,
+	}
+
+	for {
+		namespaces := corev1.NamespaceList{}
+
+		if err := cli.List(ctx, &namespaces, &lo); err != nil {
+			return []reconcile.Request{}
 		}
 
-		for {
-			namespaces := corev1.NamespaceList{}
+		for _, ns := range namespaces.Items {
+			names.Insert(ns.Name)
+		}
 
-			if err := cli.List(ctx, &namespaces, &lo); err != nil {
-				return []reconcile.Request{}
-			}
+		if namespaces.Continue == "" {
+			break
+		}
 
-			for _, ns := range namespaces.Items {
-				requests = append(requests, reconcile.Request{
-					NamespacedName: resources.NamespacedNameFromObject(&ns),
-				})
-			}
+		lo.Continue = namespaces.Continue
+	}
+
+	configMaps := metav1.PartialObjectMetadataList{}
+	configMaps.SetGroupVersionKind(gvk.ConfigMap.GroupVersion().WithKind(gvk.ConfigMap.Kind + "List"))
+
+	if err := reader.List(ctx, &configMaps); err != nil {
+		return []reconcile.Request{}
+	}
+
+	for _, cm := range configMaps.Items {
+		names.Insert(cm.Namespace)
+	}
 
-			if namespaces.Continue == "" {
-				break
+	requests := make([]reconcile.Request, 0, names.Len())
+	for _, name := range sets.List(names) {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: name},
+		})
+	}
+
+	return requests
+}
+
+// namespaceSelector returns the selector of the namespaces the trusted CA bundle is injected in.
+func namespaceSelector(spec *dsciv1.TrustedCABundleSpec) (k8slabels.Selector, error) {
+	if spec == nil || spec.NamespaceSelector == nil {
+		return k8slabels.Everything(), nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(spec.NamespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trusted CA bundle namespace selector: %w", err)
+	}
+
+	return selector, nil
+}
+
+// isTrustedCABundleSource returns whether the ConfigMap or Secret is one of the sources of the custom CA bundle
+// configured in the DSCInitialization.
+func isTrustedCABundleSource(ctx context.Context, cli client.Client, obj client.Object) bool {
+	operatorNs, err := cluster.GetOperatorNamespace()
+	if err != nil || obj.GetNamespace() != operatorNs {
+		return false
+	}
+
+	dsci, err := cluster.GetDSCI(ctx, cli)
+	if err != nil || dsci.Spec.TrustedCABundle == nil {
+		return false
+	}
+
+	for _, s := range dsci.Spec.TrustedCABundle.Sources {
+		switch obj.(type) {
+		case *corev1.ConfigMap:
+			if s.ConfigMap != nil && s.ConfigMap.Name == obj.GetName() {
+				return true
+			}
+		case *corev1.Secret:
+			if s.Secret != nil && s.Secret.Name == obj.GetName() {
+				return true
 			}
+		}
+	}
+
+	return false
+}
+
+// namespaceSourceRequests returns a function mapping a ConfigMap or Secret, of the given source kind, to the
+// reconciliation request of its namespace when the TrustedCABundleSource annotation of the namespace references it.
+func namespaceSourceRequests(cli client.Client, kind string) func(context.Context, client.Object) []reconcile.Request {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		ns := corev1.Namespace{}
+		if err := cli.Get(ctx, client.ObjectKey{Name: obj.GetNamespace()}, &ns); err != nil {
+			return nil
+		}
 
-			lo.Continue = namespaces.Continue
+		value, found := ns.GetAnnotations()[annotation.TrustedCABundleSource]
+		if !found {
+			return nil
 		}
 
-		return requests
-	})
+		sourceKind, name, _, err := ParseCABundleSource(value)
+		if err != nil || sourceKind != kind || name != obj.GetName() {
+			return nil
+		}
+
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: ns.Name}}}
+	}
 }
 
 // dsciPredicates creates predicates for filtering DSCInitialization events. It determines when
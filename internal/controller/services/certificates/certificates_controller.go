@@ -0,0 +1,203 @@
+// Package certificates contains the renewal logic of the TLS certificates managed by the Open Data Hub operator
+package certificates
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+const (
+	// certManagerCertificateAnnotation is set by cert-manager on the Secrets of the certificates it issues and renews.
+	certManagerCertificateAnnotation = "cert-manager.io/certificate-name"
+
+	minRequeueInterval = time.Minute
+)
+
+// CertificateReconciler tracks the certificates managed by the operator, labeled with their kind. It renews the
+// internal CA and the certificates it issued before they expire, and keeps the copies of the default ingress
+// certificate in sync with it.
+type CertificateReconciler struct {
+	Client client.Client
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CertificateReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	logf.FromContext(ctx).Info("Adding controller for Certificate management.")
+
+	certificates := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		_, found := obj.GetLabels()[labels.PlatformCertificate]
+		return found
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("certificate-controller").
+		For(&corev1.Secret{}, builder.WithPredicates(certificates)).
+		// a renewed CA, or a rotated ingress certificate, triggers the reconciliation of the certificates depending on it
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.dependentCertificates)).
+		// the certificates options are configured in the DSCInitialization
+		Watches(&dsciv1.DSCInitialization{}, handler.EnqueueRequestsFromMapFunc(r.managedCertificates)).
+		Complete(r)
+}
+
+// Reconcile renews the certificate of the Secret when needed, and exposes its expiry time.
+func (r *CertificateReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx).WithName("Certificates")
+
+	secret := &corev1.Secret{}
+	err := r.Client.Get(ctx, request.NamespacedName, secret)
+	if err != nil {
+		if !k8serr.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+
+		CertificateExpiryTimestampSeconds.DeletePartialMatch(prometheus.Labels{
+			"namespace": request.Namespace,
+			"name":      request.Name,
+		})
+
+		return ctrl.Result{}, nil
+	}
+
+	opts, err := r.certificateOptions(ctx)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	kind := secret.Labels[labels.PlatformCertificate]
+	renewed := false
+
+	// the time the certificate is renewed at, the next step of the rotation for the CA
+	var renewAt time.Time
+
+	switch kind {
+	case cluster.CACertificate:
+		if secret.Name != cluster.InternalCASecretName {
+			return ctrl.Result{}, nil
+		}
+
+		notAfter, _ := cluster.CertificateNotAfter(secret)
+
+		opts.CANamespace = secret.Namespace
+		ca, err := cluster.GetOrCreateCA(ctx, r.Client, opts)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		renewAt = ca.RotateAt
+		renewed = !ca.Certificate.NotAfter.Equal(notAfter)
+	case cluster.IssuedCertificate:
+		// cert-manager renews the certificates it issued
+		if _, found := secret.Annotations[certManagerCertificateAnnotation]; !found {
+			renewed, err = cluster.RenewCertificate(ctx, r.Client, secret, opts)
+		}
+	case cluster.CopiedCertificate:
+		renewed, err = cluster.SyncCopiedCertificate(ctx, r.Client, secret)
+	default:
+		return ctrl.Result{}, nil
+	}
+
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if renewed {
+		log.Info("Renewed certificate", "secret", secret.Name, "namespace", secret.Namespace, "kind", kind)
+		CertificateRenewalsTotal.WithLabelValues(secret.Namespace, secret.Name, kind).Inc()
+
+		// the update of the Secret triggers a new reconciliation, exposing the expiry of the renewed certificate
+		return ctrl.Result{}, nil
+	}
+
+	notAfter, err := cluster.CertificateNotAfter(secret)
+	if err != nil {
+		log.Error(err, "Unable to parse certificate", "secret", secret.Name, "namespace", secret.Namespace)
+		return ctrl.Result{}, nil
+	}
+
+	CertificateExpiryTimestampSeconds.WithLabelValues(secret.Namespace, secret.Name, kind).Set(float64(notAfter.Unix()))
+
+	// the copies are renewed along with the copied certificate
+	if kind == cluster.CopiedCertificate {
+		return ctrl.Result{}, nil
+	}
+
+	if renewAt.IsZero() {
+		renewAt = notAfter.Add(-opts.RenewBefore)
+	}
+
+	// reconcile again once the certificate enters its renewal window
+	return ctrl.Result{RequeueAfter: max(time.Until(renewAt), minRequeueInterval)}, nil
+}
+
+// certificateOptions returns the options of the certificates configured in the DSCInitialization, or the default
+// ones when there is no DSCInitialization.
+func (r *CertificateReconciler) certificateOptions(ctx context.Context) (cluster.CertificateOptions, error) {
+	dsci, err := cluster.GetDSCI(ctx, r.Client)
+	switch {
+	case k8serr.IsNotFound(err):
+		return cluster.NewCertificateOptions(nil), nil
+	case err != nil:
+		return cluster.CertificateOptions{}, err
+	default:
+		return cluster.NewCertificateOptions(dsci.Spec.Certificates), nil
+	}
+}
+
+// dependentCertificates returns the certificates issued by the internal CA when it is renewed, or the copies of
+// a certificate of the ingress.
+func (r *CertificateReconciler) dependentCertificates(ctx context.Context, obj client.Object) []reconcile.Request {
+	switch {
+	case obj.GetLabels()[labels.PlatformCertificate] == cluster.CACertificate:
+		return r.listCertificates(ctx, cluster.IssuedCertificate, "")
+	case obj.GetNamespace() == cluster.IngressNamespace:
+		return r.listCertificates(ctx, cluster.CopiedCertificate, obj.GetNamespace()+"/"+obj.GetName())
+	default:
+		return nil
+	}
+}
+
+func (r *CertificateReconciler) managedCertificates(ctx context.Context, _ client.Object) []reconcile.Request {
+	return append(
+		r.listCertificates(ctx, cluster.CACertificate, ""),
+		r.listCertificates(ctx, cluster.IssuedCertificate, "")...,
+	)
+}
+
+// listCertificates returns the Secrets of the certificates of a kind, copied from the source Secret when set.
+func (r *CertificateReconciler) listCertificates(ctx context.Context, kind string, source string) []reconcile.Request {
+	secrets := &corev1.SecretList{}
+	if err := r.Client.List(ctx, secrets, client.MatchingLabels{labels.PlatformCertificate: kind}); err != nil {
+		logf.FromContext(ctx).Error(err, "Unable to list certificates", "kind", kind)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(secrets.Items))
+	for _, s := range secrets.Items {
+		if source != "" && s.Annotations[annotations.CertificateSource] != source {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: s.Namespace, Name: s.Name},
+		})
+	}
+
+	return requests
+}
@@ -0,0 +1,121 @@
+package certificates_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/certificates"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+)
+
+// newApplyClient returns a fake client emulating the server-side apply, not supported by the fake client, with a
+// create or an update.
+func newApplyClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+
+	cli, err := fakeclient.New(
+		fakeclient.WithObjects(objects...),
+		fakeclient.WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				u, ok := obj.(*unstructured.Unstructured)
+				if !ok || patch.Type() != types.ApplyPatchType {
+					return c.Patch(ctx, obj, patch, opts...)
+				}
+
+				current := &unstructured.Unstructured{}
+				current.SetGroupVersionKind(u.GroupVersionKind())
+				if err := c.Get(ctx, client.ObjectKeyFromObject(u), current); err != nil {
+					return c.Create(ctx, u)
+				}
+
+				u.SetResourceVersion(current.GetResourceVersion())
+
+				return c.Update(ctx, u)
+			},
+		}),
+	)
+	require.NoError(t, err)
+
+	return cli
+}
+
+func TestReconcileCA(t *testing.T) {
+	ctx := t.Context()
+	cli := newApplyClient(t)
+
+	// generate the internal CA
+	_, err := cluster.GetOrCreateCA(ctx, cli, cluster.CertificateOptions{CANamespace: "operator-ns"})
+	require.NoError(t, err)
+
+	r := &certificates.CertificateReconciler{Client: cli}
+	key := types.NamespacedName{Namespace: "operator-ns", Name: cluster.InternalCASecretName}
+
+	res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	// a valid CA is reconciled again once it enters its renewal window
+	secret, err := cluster.GetSecret(ctx, cli, key.Namespace, key.Name)
+	require.NoError(t, err)
+	notAfter, err := cluster.CertificateNotAfter(secret)
+	require.NoError(t, err)
+
+	assert.InDelta(t, time.Until(notAfter.Add(-cluster.CARenewBefore)).Seconds(), res.RequeueAfter.Seconds(), 60)
+	assert.InDelta(t, float64(notAfter.Unix()),
+		testutil.ToFloat64(certificates.CertificateExpiryTimestampSeconds.WithLabelValues(key.Namespace, key.Name, cluster.CACertificate)), 0)
+
+	// the series of a deleted certificate is removed
+	require.NoError(t, cli.Delete(ctx, secret))
+
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	assert.Equal(t, 0, testutil.CollectAndCount(certificates.CertificateExpiryTimestampSeconds))
+}
+
+func TestReconcileCopiedCertificate(t *testing.T) {
+	ctx := t.Context()
+
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "router-certs-default", Namespace: cluster.IngressNamespace},
+		Data:       map[string][]byte{corev1.TLSCertKey: []byte("rotated")},
+		Type:       corev1.SecretTypeTLS,
+	}
+	copied := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "knative-serving-cert",
+			Namespace:   "istio-system",
+			Labels:      map[string]string{labels.PlatformCertificate: cluster.CopiedCertificate},
+			Annotations: map[string]string{annotations.CertificateSource: cluster.IngressNamespace + "/router-certs-default"},
+		},
+		Data: map[string][]byte{corev1.TLSCertKey: []byte("initial")},
+		Type: corev1.SecretTypeTLS,
+	}
+	cli := newApplyClient(t, source, copied)
+
+	r := &certificates.CertificateReconciler{Client: cli}
+	key := client.ObjectKeyFromObject(copied)
+
+	res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, res)
+
+	current, err := cluster.GetSecret(ctx, cli, key.Namespace, key.Name)
+	require.NoError(t, err)
+	assert.Equal(t, source.Data, current.Data)
+
+	assert.InDelta(t, 1, testutil.ToFloat64(certificates.CertificateRenewalsTotal.WithLabelValues(key.Namespace, key.Name, cluster.CopiedCertificate)), 0)
+}
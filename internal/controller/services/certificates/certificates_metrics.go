@@ -0,0 +1,48 @@
+package certificates
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// CertificateExpiryTimestampSeconds is a prometheus gauge metrics which holds the expiry time,
+	// as a unix timestamp, of the certificates managed by the operator. It has three labels.
+	// namespace and name labels refer to the Secret of the certificate.
+	// kind label refers to the kind of certificate (ca, issued, copied).
+	CertificateExpiryTimestampSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "certificate_expiry_timestamp_seconds",
+			Help: "Expiry time of the certificates managed by the operator",
+		},
+		[]string{
+			"namespace",
+			"name",
+			"kind",
+		},
+	)
+
+	// CertificateRenewalsTotal is a prometheus counter metrics which holds the total number
+	// of renewals of the certificates managed by the operator. It has three labels.
+	// namespace and name labels refer to the Secret of the certificate.
+	// kind label refers to the kind of certificate (ca, issued, copied).
+	CertificateRenewalsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "certificate_renewals_total",
+			Help: "Number of renewals of the certificates managed by the operator",
+		},
+		[]string{
+			"namespace",
+			"name",
+			"kind",
+		},
+	)
+)
+
+// init register metrics to the global registry from controller-runtime/pkg/metrics.
+// see https://book.kubebuilder.io/reference/metrics#publishing-additional-metrics
+//
+//nolint:gochecknoinits
+func init() {
+	metrics.Registry.MustRegister(CertificateExpiryTimestampSeconds, CertificateRenewalsTotal)
+}
@@ -0,0 +1,157 @@
+// Package connectionrollout contains the logic to roll out workloads consuming a connection secret when the secret changes
+package connectionrollout
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	webhookutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/webhook"
+)
+
+// ConnectionRolloutReconciler holds the controller configuration.
+type ConnectionRolloutReconciler struct {
+	// Client is used to patch the consumers of a connection secret.
+	Client client.Client
+	// Reader is used to read secrets and consumers, which live in user namespaces and are not cached.
+	Reader client.Reader
+}
+
+// NewWithManager sets up the controller with the Manager.
+func NewWithManager(_ context.Context, mgr ctrl.Manager) error {
+	// Connection secrets live in user namespaces, which are not part of the shared Secret cache.
+	// Use a dedicated cache holding only Secret metadata, as the annotations and the resource
+	// version are all that is needed to detect a change; the data is read on reconcile.
+	targetCache, err := cache.New(mgr.GetConfig(), cache.Options{
+		HTTPClient: mgr.GetHTTPClient(),
+		Scheme:     mgr.GetScheme(),
+		Mapper:     mgr.GetRESTMapper(),
+		DefaultTransform: func(in any) (any, error) {
+			if obj, err := meta.Accessor(in); err == nil && obj.GetManagedFields() != nil {
+				obj.SetManagedFields(nil)
+			}
+
+			return in, nil
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create cache: %w", err)
+	}
+
+	if err := mgr.Add(targetCache); err != nil {
+		return fmt.Errorf("unable to register target cache to manager: %w", err)
+	}
+
+	r := ConnectionRolloutReconciler{
+		Client: mgr.GetClient(),
+		Reader: mgr.GetAPIReader(),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("connection-rollout-controller").
+		WatchesRawSource(
+			source.TypedKind[client.Object, ctrl.Request](
+				targetCache,
+				resources.GvkToPartial(gvk.Secret),
+				handlers.RequestFromObject(),
+				connectionSecretChanged(),
+			),
+		).
+		Complete(&r)
+}
+
+// Reconcile bumps the connections hash annotation on the pod template of every Notebook and
+// InferenceService in the secret namespace that references the secret in its connections
+// annotation, so that workloads pick up the rotated credentials.
+func (r *ConnectionRolloutReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	for _, c := range consumers {
+		if err := r.rollout(ctx, c, req.NamespacedName); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to roll out %s consuming secret %s: %w", c.gvk.Kind, req.NamespacedName, err)
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *ConnectionRolloutReconciler) rollout(ctx context.Context, c consumer, secret types.NamespacedName) error {
+	l := logf.FromContext(ctx)
+
+	items := unstructured.UnstructuredList{}
+	items.SetGroupVersionKind(c.gvk)
+
+	err := r.Reader.List(ctx, &items, client.InNamespace(secret.Namespace))
+	switch {
+	case meta.IsNoMatchError(err):
+		l.V(3).Info("Consumer kind not available on the cluster, skip", "kind", c.gvk.Kind)
+		return nil
+	case err != nil:
+		return err
+	}
+
+	for i := range items.Items {
+		obj := &items.Items[i]
+
+		if !obj.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+
+		refs := webhookutils.ParseConnectionReferences(resources.GetAnnotation(obj, annotations.Connection), obj.GetNamespace())
+		if !referencesSecret(refs, secret) {
+			continue
+		}
+
+		hash, err := r.connectionsHash(ctx, refs)
+		if err != nil {
+			return err
+		}
+
+		patched, err := setConnectionsHash(obj, c.annotationsPath, hash)
+		if err != nil {
+			return err
+		}
+		if patched == nil {
+			continue
+		}
+
+		l.Info("Connection secret changed, rolling out consumer", "kind", c.gvk.Kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+
+		if err := r.Client.Patch(ctx, patched, client.MergeFrom(obj)); err != nil {
+			return fmt.Errorf("failed to patch %s %s/%s: %w", c.gvk.Kind, obj.GetNamespace(), obj.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+func (r *ConnectionRolloutReconciler) connectionsHash(ctx context.Context, refs []corev1.SecretReference) (string, error) {
+	secrets := make([]*corev1.Secret, 0, len(refs))
+
+	for _, ref := range refs {
+		secret := corev1.Secret{}
+		err := r.Reader.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, &secret)
+		if client.IgnoreNotFound(err) != nil {
+			return "", fmt.Errorf("failed to get connection secret %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+
+		// a missing secret still contributes its name, so that re-creating it is detected
+		secret.Namespace = ref.Namespace
+		secret.Name = ref.Name
+
+		secrets = append(secrets, &secret)
+	}
+
+	return hashSecrets(secrets)
+}
@@ -0,0 +1,123 @@
+package connectionrollout_test
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/connectionrollout"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/envtestutil"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testNamespace = "test-ns"
+	testSecret    = "s3-connection"
+)
+
+func newSecret(value string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testSecret,
+			Namespace: testNamespace,
+			Annotations: map[string]string{
+				annotations.ConnectionTypeRef: "s3",
+			},
+		},
+		Data: map[string][]byte{
+			"AWS_SECRET_ACCESS_KEY": []byte(value),
+		},
+	}
+}
+
+func reconcile(t *testing.T, cli client.Client) {
+	t.Helper()
+
+	r := connectionrollout.ConnectionRolloutReconciler{Client: cli, Reader: cli}
+
+	_, err := r.Reconcile(t.Context(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: testSecret},
+	})
+	NewWithT(t).Expect(err).ShouldNot(HaveOccurred())
+}
+
+func getHash(t *testing.T, cli client.Client, obj client.Object, path ...string) string {
+	t.Helper()
+
+	u := unstructured.Unstructured{}
+	u.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+
+	err := cli.Get(t.Context(), client.ObjectKeyFromObject(obj), &u)
+	NewWithT(t).Expect(err).ShouldNot(HaveOccurred())
+
+	value, _, err := unstructured.NestedString(u.Object, append(path, annotations.ConnectionsHash)...)
+	NewWithT(t).Expect(err).ShouldNot(HaveOccurred())
+
+	return value
+}
+
+func TestConnectionRolloutReconciler(t *testing.T) {
+	g := NewWithT(t)
+
+	s, err := scheme.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	notebookPath := []string{"spec", "template", "metadata", "annotations"}
+	isvcPath := []string{"spec", "predictor", "annotations"}
+
+	consumingNotebook := envtestutil.NewNotebook("consumer", testNamespace,
+		envtestutil.WithAnnotation(annotations.Connection, testNamespace+"/"+testSecret))
+	otherNotebook := envtestutil.NewNotebook("other", testNamespace,
+		envtestutil.WithAnnotation(annotations.Connection, testNamespace+"/another-secret"))
+	consumingISVC := envtestutil.NewInferenceService("consumer", testNamespace,
+		envtestutil.WithAnnotation(annotations.Connection, testSecret))
+
+	secret := newSecret("v1")
+
+	cli := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(secret, consumingNotebook, otherNotebook, consumingISVC).
+		Build()
+
+	reconcile(t, cli)
+
+	nbHash := getHash(t, cli, consumingNotebook, notebookPath...)
+	g.Expect(nbHash).ShouldNot(BeEmpty())
+	g.Expect(getHash(t, cli, consumingISVC, isvcPath...)).Should(Equal(nbHash))
+	g.Expect(getHash(t, cli, otherNotebook, notebookPath...)).Should(BeEmpty())
+
+	t.Run("unchanged secret does not roll out again", func(t *testing.T) {
+		g := NewWithT(t)
+
+		reconcile(t, cli)
+
+		g.Expect(getHash(t, cli, consumingNotebook, notebookPath...)).Should(Equal(nbHash))
+	})
+
+	t.Run("rotated secret rolls out consumers", func(t *testing.T) {
+		g := NewWithT(t)
+
+		rotated := newSecret("v2")
+		err := cli.Get(t.Context(), client.ObjectKeyFromObject(rotated), secret)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		secret.Data = rotated.Data
+		g.Expect(cli.Update(t.Context(), secret)).Should(Succeed())
+
+		reconcile(t, cli)
+
+		rotatedHash := getHash(t, cli, consumingNotebook, notebookPath...)
+		g.Expect(rotatedHash).ShouldNot(BeEmpty())
+		g.Expect(rotatedHash).ShouldNot(Equal(nbHash))
+		g.Expect(getHash(t, cli, consumingISVC, isvcPath...)).Should(Equal(rotatedHash))
+	})
+}
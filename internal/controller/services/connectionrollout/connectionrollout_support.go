@@ -0,0 +1,126 @@
+package connectionrollout
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// consumer describes a kind of workload that can reference connection secrets through the
+// connections annotation, and where its pod template annotations live.
+type consumer struct {
+	gvk             schema.GroupVersionKind
+	annotationsPath []string
+}
+
+var consumers = []consumer{
+	{
+		gvk:             gvk.Notebook,
+		annotationsPath: []string{"spec", "template", "metadata", "annotations"},
+	},
+	{
+		gvk:             gvk.InferenceServices,
+		annotationsPath: []string{"spec", "predictor", "annotations"},
+	},
+}
+
+// connectionSecretChanged only lets through updates of connection secrets, as creation
+// and deletion are already handled by the admission webhooks of the consumers.
+func connectionSecretChanged() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				return false
+			}
+			if resources.GetAnnotation(e.ObjectNew, annotations.ConnectionTypeRef) == "" {
+				return false
+			}
+
+			return e.ObjectOld.GetResourceVersion() != e.ObjectNew.GetResourceVersion()
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+func referencesSecret(refs []corev1.SecretReference, secret types.NamespacedName) bool {
+	return slices.ContainsFunc(refs, func(ref corev1.SecretReference) bool {
+		return ref.Namespace == secret.Namespace && ref.Name == secret.Name
+	})
+}
+
+// hashSecrets computes a stable hash of the content of the given secrets, independent of
+// the order of the secrets and of their keys.
+func hashSecrets(secrets []*corev1.Secret) (string, error) {
+	sorted := slices.Clone(secrets)
+	slices.SortFunc(sorted, func(a, b *corev1.Secret) int {
+		return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+	})
+
+	hash := sha256.New()
+
+	for _, s := range sorted {
+		if _, err := fmt.Fprintf(hash, "%s/%s\n", s.Namespace, s.Name); err != nil {
+			return "", fmt.Errorf("failed to hash secret %s/%s: %w", s.Namespace, s.Name, err)
+		}
+
+		keys := make([]string, 0, len(s.Data))
+		for k := range s.Data {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+
+		for _, k := range keys {
+			if _, err := fmt.Fprintf(hash, "%s=%x\n", k, s.Data[k]); err != nil {
+				return "", fmt.Errorf("failed to hash secret %s/%s: %w", s.Namespace, s.Name, err)
+			}
+		}
+	}
+
+	return resources.EncodeToString(hash.Sum(nil)), nil
+}
+
+// setConnectionsHash returns a copy of obj with the connections hash annotation set at the given
+// path, or nil if the annotation already holds the given hash.
+func setConnectionsHash(obj *unstructured.Unstructured, path []string, hash string) (*unstructured.Unstructured, error) {
+	values, _, err := unstructured.NestedStringMap(obj.Object, path...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", strings.Join(path, "."), err)
+	}
+
+	if values[annotations.ConnectionsHash] == hash {
+		return nil, nil //nolint:nilnil
+	}
+
+	if values == nil {
+		values = map[string]string{}
+	}
+
+	values[annotations.ConnectionsHash] = hash
+
+	patched := obj.DeepCopy()
+	if err := unstructured.SetNestedStringMap(patched.Object, values, path...); err != nil {
+		return nil, fmt.Errorf("failed to set %s: %w", strings.Join(path, "."), err)
+	}
+
+	return patched, nil
+}
@@ -0,0 +1,41 @@
+package gateway
+
+import (
+	"embed"
+	"path"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+const (
+	ServiceName = "gateway"
+)
+
+var (
+	conditionTypes = []string{
+		status.ConditionGatewayProgrammed,
+	}
+
+	// gatewayAPIKinds are the kinds of the Gateway API the platform Gateway and the HTTPRoutes of the components
+	// depend on.
+	gatewayAPIKinds = []schema.GroupVersionKind{
+		gvk.GatewayClass,
+		gvk.KubernetesGateway,
+		gvk.HTTPRoute,
+	}
+)
+
+//go:embed resources
+var resourcesFS embed.FS
+
+const (
+	baseDir = "resources"
+)
+
+var (
+	gatewayClassTemplate = path.Join(baseDir, "gatewayclass.tmpl.yaml")
+	gatewayTemplate      = path.Join(baseDir, "gateway.tmpl.yaml")
+)
@@ -0,0 +1,108 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	sr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/registry"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/gc"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/template"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/dependent"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
+)
+
+//nolint:gochecknoinits
+func init() {
+	sr.Add(&serviceHandler{})
+}
+
+type serviceHandler struct {
+}
+
+func (h *serviceHandler) Init(_ common.Platform) error {
+	return nil
+}
+
+func (h *serviceHandler) GetName() string {
+	return ServiceName
+}
+
+func (h *serviceHandler) GetManagementState(_ common.Platform, dsci *dsciv1.DSCInitialization) operatorv1.ManagementState {
+	if dsci != nil && dsci.Spec.Gateway != nil {
+		return dsci.Spec.Gateway.ManagementState
+	}
+
+	return operatorv1.Removed
+}
+
+func (h *serviceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) error {
+	_, err := reconciler.ReconcilerFor(mgr, &serviceApi.GatewayConfig{}).
+		Owns(&corev1.Secret{}).
+		OwnsGVK(gvk.GatewayClass,
+			reconciler.Dynamic(actions.IfGVKInstalled(gvk.GatewayClass))).
+		// watch the status of the Gateway to report when it is programmed
+		OwnsGVK(gvk.KubernetesGateway,
+			reconciler.Dynamic(actions.IfGVKInstalled(gvk.KubernetesGateway)),
+			reconciler.WithPredicates(dependent.Predicate{
+				WatchDelete: true,
+				WatchUpdate: true,
+				WatchStatus: true,
+			})).
+		// the Gateway API may be installed after the operator, e.g. with OpenShift Service Mesh 3
+		Watches(
+			&extv1.CustomResourceDefinition{},
+			reconciler.WithEventHandler(
+				handlers.ToNamed(serviceApi.GatewayInstanceName)),
+			reconciler.WithPredicates(predicate.Funcs{
+				CreateFunc: func(e event.CreateEvent) bool {
+					return strings.HasSuffix(e.Object.GetName(), "."+gvk.KubernetesGateway.Group)
+				},
+				UpdateFunc: func(e event.UpdateEvent) bool {
+					return false
+				},
+				DeleteFunc: func(e event.DeleteEvent) bool {
+					return false
+				},
+				GenericFunc: func(e event.GenericEvent) bool {
+					return false
+				},
+			}),
+		).
+		WithAction(checkPreconditions).
+		WithAction(resolveDomain).
+		WithAction(createGatewayNamespace).
+		WithAction(createGatewayCertificate).
+		WithAction(initialize).
+		WithAction(template.NewAction(
+			template.WithDataFn(getTemplateData),
+		)).
+		WithAction(deploy.NewAction(
+			deploy.WithCache(),
+		)).
+		WithAction(checkGatewayReadiness).
+		// must be the final action
+		WithAction(gc.NewAction()).
+		WithConditions(conditionTypes...).
+		Build(ctx)
+
+	if err != nil {
+		return fmt.Errorf("could not create the gateway controller: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+var ErrUnsupportedCertType = errors.New("unsupported certificate type for the Gateway")
+
+func checkPreconditions(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	rr.Conditions.MarkUnknown(status.ConditionGatewayProgrammed)
+
+	for _, kind := range gatewayAPIKinds {
+		found, err := cluster.HasCRD(ctx, rr.Client, kind)
+		if err != nil {
+			return odherrors.NewStopError("failed to check %s CRD: %w", kind, err)
+		}
+
+		if !found {
+			rr.Conditions.MarkFalse(
+				status.ConditionGatewayProgrammed,
+				conditions.WithReason(status.GatewayAPIMissingCRDReason),
+				conditions.WithMessage(status.GatewayAPIMissingCRDMessage),
+				conditions.WithSeverity(common.ConditionSeverityInfo),
+			)
+
+			return odherrors.NewStopError(status.GatewayAPIMissingCRDMessage)
+		}
+	}
+
+	return nil
+}
+
+func resolveDomain(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	gc, ok := rr.Instance.(*serviceApi.GatewayConfig)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a serviceApi.GatewayConfig)", rr.Instance)
+	}
+
+	domain, err := cluster.GetGatewayDomain(ctx, rr.Client, &gc.Spec.GatewayCommonSpec)
+	if err != nil {
+		return err
+	}
+
+	gc.Status.Domain = domain
+
+	return nil
+}
+
+func createGatewayNamespace(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	gc, ok := rr.Instance.(*serviceApi.GatewayConfig)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a serviceApi.GatewayConfig)", rr.Instance)
+	}
+
+	_, namespace := cluster.GatewayName(&gc.Spec.GatewayCommonSpec)
+	if _, err := cluster.CreateNamespace(ctx, rr.Client, namespace); err != nil {
+		return fmt.Errorf("failed to create Gateway namespace %s: %w", namespace, err)
+	}
+
+	return nil
+}
+
+func createGatewayCertificate(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	gc, ok := rr.Instance.(*serviceApi.GatewayConfig)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a serviceApi.GatewayConfig)", rr.Instance)
+	}
+
+	_, namespace := cluster.GatewayName(&gc.Spec.GatewayCommonSpec)
+	secretName := cluster.GatewayCertificateSecretName(&gc.Spec.GatewayCommonSpec)
+
+	switch gc.Spec.Certificate.Type {
+	case infrav1.SelfSigned:
+		return cluster.CreateCertificate(ctx, rr.Client, secretName,
+			"*."+gc.Status.Domain, namespace,
+			cluster.NewCertificateOptions(rr.DSCI.Spec.Certificates),
+			cluster.OwnedBy(gc, rr.Client.Scheme()))
+	case infrav1.Provided:
+		return nil
+	case infrav1.OpenshiftDefaultIngress, "":
+		return cluster.PropagateDefaultIngressCertificate(ctx, rr.Client, secretName, namespace)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedCertType, gc.Spec.Certificate.Type)
+	}
+}
+
+func initialize(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
+	rr.Templates = append(rr.Templates,
+		odhtypes.TemplateInfo{
+			FS:   resourcesFS,
+			Path: gatewayClassTemplate,
+		},
+		odhtypes.TemplateInfo{
+			FS:   resourcesFS,
+			Path: gatewayTemplate,
+		},
+	)
+
+	return nil
+}
+
+func checkGatewayReadiness(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	gc, ok := rr.Instance.(*serviceApi.GatewayConfig)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a serviceApi.GatewayConfig)", rr.Instance)
+	}
+
+	name, namespace := cluster.GatewayName(&gc.Spec.GatewayCommonSpec)
+
+	gateway := &unstructured.Unstructured{}
+	gateway.SetGroupVersionKind(gvk.KubernetesGateway)
+
+	err := rr.Client.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, gateway)
+	if err != nil && !k8serr.IsNotFound(err) {
+		return fmt.Errorf("failed to get Gateway %s/%s: %w", namespace, name, err)
+	}
+
+	message := fmt.Sprintf("Gateway %s/%s not found, it may be initializing", namespace, name)
+	programmed := false
+	if err == nil {
+		programmed, message = isGatewayProgrammed(gateway)
+	}
+
+	if programmed {
+		rr.Conditions.MarkTrue(
+			status.ConditionGatewayProgrammed,
+			conditions.WithReason(status.ReadyReason),
+			conditions.WithMessage("Gateway %s/%s is programmed", namespace, name),
+		)
+	} else {
+		rr.Conditions.MarkFalse(
+			status.ConditionGatewayProgrammed,
+			conditions.WithReason(status.GatewayNotProgrammedReason),
+			conditions.WithMessage("%s", message),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+func getTemplateData(_ context.Context, rr *odhtypes.ReconciliationRequest) (map[string]any, error) {
+	gc, ok := rr.Instance.(*serviceApi.GatewayConfig)
+	if !ok {
+		return nil, fmt.Errorf("resource instance %v is not a serviceApi.GatewayConfig)", rr.Instance)
+	}
+
+	name, namespace := cluster.GatewayName(&gc.Spec.GatewayCommonSpec)
+	className, controllerName := cluster.GatewayClass(&gc.Spec.GatewayCommonSpec)
+
+	return map[string]any{
+		"GatewayName":           name,
+		"GatewayNamespace":      namespace,
+		"GatewayClassName":      className,
+		"ControllerName":        controllerName,
+		"Domain":                gc.Status.Domain,
+		"CertificateSecretName": cluster.GatewayCertificateSecretName(&gc.Spec.GatewayCommonSpec),
+	}, nil
+}
+
+// isGatewayProgrammed returns true when the Programmed condition of a Gateway is True, with a message explaining why
+// it is not.
+func isGatewayProgrammed(gateway *unstructured.Unstructured) (bool, string) {
+	conditions, found, err := unstructured.NestedSlice(gateway.Object, "status", "conditions")
+	if err != nil {
+		return false, fmt.Sprintf("error checking Gateway conditions: %v", err)
+	}
+
+	if !found {
+		return false, fmt.Sprintf("no Gateway conditions found, Gateway %s may be starting up", gateway.GetName())
+	}
+
+	for _, condition := range conditions {
+		conditionMap, ok := condition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if conditionMap["type"] != "Programmed" {
+			continue
+		}
+
+		if conditionMap["status"] == "True" {
+			return true, ""
+		}
+
+		if message, found := conditionMap["message"]; found {
+			return false, fmt.Sprintf("Gateway %s Programmed condition is false: %v", gateway.GetName(), message)
+		}
+
+		return false, fmt.Sprintf("Gateway %s Programmed condition is false", gateway.GetName())
+	}
+
+	return false, fmt.Sprintf("Gateway %s Programmed condition not found, it may be initializing", gateway.GetName())
+}
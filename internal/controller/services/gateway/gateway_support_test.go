@@ -0,0 +1,97 @@
+//nolint:testpackage
+package gateway
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGetTemplateData(t *testing.T) {
+	g := NewWithT(t)
+
+	gc := &serviceApi.GatewayConfig{}
+	gc.Status.Domain = "apps.example.com"
+
+	data, err := getTemplateData(t.Context(), &odhtypes.ReconciliationRequest{Instance: gc})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data).Should(And(
+		HaveKeyWithValue("GatewayName", cluster.DefaultGatewayName),
+		HaveKeyWithValue("GatewayNamespace", cluster.DefaultGatewayNamespace),
+		HaveKeyWithValue("GatewayClassName", cluster.DefaultGatewayClassName),
+		HaveKeyWithValue("ControllerName", cluster.DefaultGatewayControllerName),
+		HaveKeyWithValue("Domain", "apps.example.com"),
+		HaveKeyWithValue("CertificateSecretName", cluster.DefaultGatewayName+"-tls"),
+	))
+
+	gc.Spec.Name = "my-gateway"
+	gc.Spec.Certificate.SecretName = "my-cert"
+	gc.Spec.GatewayClass.ControllerName = "istio.io/gateway-controller"
+
+	data, err = getTemplateData(t.Context(), &odhtypes.ReconciliationRequest{Instance: gc})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(data).Should(And(
+		HaveKeyWithValue("GatewayName", "my-gateway"),
+		HaveKeyWithValue("ControllerName", "istio.io/gateway-controller"),
+		HaveKeyWithValue("CertificateSecretName", "my-cert"),
+	))
+}
+
+func TestIsGatewayProgrammed(t *testing.T) {
+	newGateway := func(conditions ...any) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: map[string]any{}}
+		u.SetGroupVersionKind(gvk.KubernetesGateway)
+		u.SetName("data-science-gateway")
+
+		if len(conditions) > 0 {
+			_ = unstructured.SetNestedSlice(u.Object, conditions, "status", "conditions")
+		}
+
+		return u
+	}
+
+	tests := []struct {
+		name       string
+		obj        *unstructured.Unstructured
+		programmed bool
+		message    string
+	}{
+		{
+			name:    "no conditions",
+			obj:     newGateway(),
+			message: "no Gateway conditions found",
+		},
+		{
+			name:       "programmed",
+			obj:        newGateway(map[string]any{"type": "Programmed", "status": "True"}),
+			programmed: true,
+		},
+		{
+			name:    "not programmed",
+			obj:     newGateway(map[string]any{"type": "Programmed", "status": "False", "message": "no addresses"}),
+			message: "Gateway data-science-gateway Programmed condition is false: no addresses",
+		},
+		{
+			name:    "no programmed condition",
+			obj:     newGateway(map[string]any{"type": "Accepted", "status": "True"}),
+			message: "Programmed condition not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			programmed, message := isGatewayProgrammed(tt.obj)
+			g.Expect(programmed).Should(Equal(tt.programmed))
+			g.Expect(message).Should(ContainSubstring(tt.message))
+		})
+	}
+}
@@ -0,0 +1,357 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+const (
+	AlertmanagerConfigName = "data-science-alertmanagerconfig"
+
+	// alertmanagerFieldOwner is the field manager of the matcher strategy set on the Alertmanager of the
+	// MonitoringStack, which is otherwise managed by the Observability Operator.
+	alertmanagerFieldOwner = "monitoring.opendatahub.io/alertmanager"
+
+	// nullReceiver drops the notifications of the alerts routed to it.
+	nullReceiver = "null"
+
+	// alertComponentLabel is the label holding the name of the component an alert belongs to,
+	// added to all the alerting rules deployed by the operator so alerts can be routed by component.
+	alertComponentLabel = "component"
+	alertSeverityLabel  = "severity"
+	alertNameLabel      = "alertname"
+)
+
+// prometheusRulesSuffixes are the suffixes of the names of the PrometheusRules deployed by the operator,
+// prefixed by the name of the component.
+var prometheusRulesSuffixes = []string{"-prometheusrules", "-alerts"}
+
+// hasAlertmanagerConfig reports whether the alerting configuration requires an Alertmanager configuration,
+// alerts are only evaluated otherwise.
+func hasAlertmanagerConfig(alerting *serviceApi.Alerting) bool {
+	return len(alerting.Receivers) > 0 || len(alerting.Inhibitions) > 0 || len(alerting.Silences) > 0
+}
+
+// applyAlertmanagerMatcherStrategy disables the namespace matcher the Alertmanager of the MonitoringStack adds
+// by default to the routes of the AlertmanagerConfig, which would otherwise only notify the alerts raised in the
+// monitoring namespace and drop the ones of the components, raised in the applications namespace or without
+// any namespace label. When disabled, the matcher strategy previously applied is removed.
+//
+// The MonitoringStack does not expose the matcher strategy, it is applied on the Alertmanager once created by
+// the Observability Operator. The ownership of the field is not forced, the apply fails if the Observability
+// Operator manages it.
+func applyAlertmanagerMatcherStrategy(ctx context.Context, cli client.Client, namespace string, enabled bool) error {
+	am := unstructured.Unstructured{}
+	am.SetGroupVersionKind(gvk.Alertmanager)
+
+	err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: monitoringStackName}, &am)
+	switch {
+	case k8serr.IsNotFound(err) || meta.IsNoMatchError(err):
+		// applied once the Alertmanager is created
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to get the Alertmanager %s/%s: %w", namespace, monitoringStackName, err)
+	}
+
+	obj := unstructured.Unstructured{Object: map[string]any{}}
+	obj.SetGroupVersionKind(gvk.Alertmanager)
+	obj.SetNamespace(namespace)
+	obj.SetName(monitoringStackName)
+
+	if enabled {
+		obj.Object["spec"] = map[string]any{
+			"alertmanagerConfigMatcherStrategy": map[string]any{
+				"type": "None",
+			},
+		}
+	} else if !hasFieldManager(&am, alertmanagerFieldOwner) {
+		return nil
+	}
+
+	// applying an empty configuration removes the fields previously applied by the field owner
+	err = cli.Patch(ctx, &obj, client.Apply, client.FieldOwner(alertmanagerFieldOwner))
+	if err != nil {
+		return fmt.Errorf("failed to apply the matcher strategy of the Alertmanager %s/%s: %w", namespace, monitoringStackName, err)
+	}
+
+	return nil
+}
+
+// hasFieldManager reports whether the object has fields applied by the given field manager.
+func hasFieldManager(obj client.Object, manager string) bool {
+	return slices.ContainsFunc(obj.GetManagedFields(), func(e metav1.ManagedFieldsEntry) bool {
+		return e.Manager == manager && e.Operation == metav1.ManagedFieldsOperationApply
+	})
+}
+
+// newAlertmanagerConfig renders the alerting configuration of the Monitoring CR into an AlertmanagerConfig,
+// picked up by the Alertmanager of the MonitoringStack.
+//
+// Each route is rendered with a child route per silence, muting the silenced alerts of the route during
+// the maintenance window while the others are still notified.
+func newAlertmanagerConfig(namespace string, alerting *serviceApi.Alerting) *unstructured.Unstructured {
+	receivers := []any{
+		map[string]any{"name": nullReceiver},
+	}
+	for _, r := range alerting.Receivers {
+		receivers = append(receivers, newAlertReceiver(r))
+	}
+
+	defaultReceiver := alerting.DefaultReceiver
+	if defaultReceiver == "" {
+		defaultReceiver = nullReceiver
+	}
+
+	routes := make([]any, 0, len(alerting.Routes)+len(alerting.Silences))
+	for _, r := range alerting.Routes {
+		route := map[string]any{
+			"receiver": r.Receiver,
+		}
+		if silenced := newSilenceRoutes(r.Receiver, alerting.Silences); len(silenced) > 0 {
+			route["routes"] = silenced
+		}
+
+		var matchers []any
+		if m := newAlertMatcher(alertComponentLabel, r.Components); m != nil {
+			matchers = append(matchers, m)
+		}
+		if m := newAlertMatcher(alertSeverityLabel, r.Severities); m != nil {
+			matchers = append(matchers, m)
+		}
+		if len(matchers) > 0 {
+			route["matchers"] = matchers
+		}
+
+		routes = append(routes, route)
+	}
+
+	// alerts not matched by any route go to the default receiver, silences apply to them as well
+	if defaultReceiver != nullReceiver {
+		routes = append(routes, newSilenceRoutes(defaultReceiver, alerting.Silences)...)
+	}
+
+	spec := map[string]any{
+		"route": map[string]any{
+			"receiver": defaultReceiver,
+			"groupBy":  []any{alertNameLabel, alertComponentLabel},
+			"routes":   routes,
+		},
+		"receivers": receivers,
+	}
+
+	if len(alerting.Inhibitions) > 0 {
+		rules := make([]any, 0, len(alerting.Inhibitions))
+		for _, i := range alerting.Inhibitions {
+			rule := map[string]any{
+				"sourceMatch": []any{newAlertMatcher(alertSeverityLabel, []serviceApi.AlertSeverity{i.SourceSeverity})},
+				"targetMatch": []any{newAlertMatcher(alertSeverityLabel, []serviceApi.AlertSeverity{i.TargetSeverity})},
+			}
+			if len(i.Equal) > 0 {
+				rule["equal"] = toAnySlice(i.Equal)
+			}
+			rules = append(rules, rule)
+		}
+		spec["inhibitRules"] = rules
+	}
+
+	if len(alerting.Silences) > 0 {
+		intervals := make([]any, 0, len(alerting.Silences))
+		for _, s := range alerting.Silences {
+			interval := map[string]any{
+				"times": []any{
+					map[string]any{"startTime": s.StartTime, "endTime": s.EndTime},
+				},
+			}
+			if len(s.Weekdays) > 0 {
+				interval["weekdays"] = toAnySlice(s.Weekdays)
+			}
+			intervals = append(intervals, map[string]any{
+				"name":          s.Name,
+				"timeIntervals": []any{interval},
+			})
+		}
+		spec["muteTimeIntervals"] = intervals
+	}
+
+	amc := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	amc.SetGroupVersionKind(gvk.AlertmanagerConfig)
+	amc.SetName(AlertmanagerConfigName)
+	amc.SetNamespace(namespace)
+
+	return amc
+}
+
+// newSilenceRoutes returns a route per silence notifying the given receiver, and muting the notifications
+// of the silenced alerts during the maintenance window.
+func newSilenceRoutes(receiver string, silences []serviceApi.AlertSilence) []any {
+	children := make([]any, 0, len(silences))
+	for _, s := range silences {
+		child := map[string]any{
+			"receiver":          receiver,
+			"muteTimeIntervals": []any{s.Name},
+		}
+
+		var matchers []any
+		if m := newAlertMatcher(alertComponentLabel, s.Components); m != nil {
+			matchers = append(matchers, m)
+		}
+		if m := newAlertMatcher(alertNameLabel, s.Alerts); m != nil {
+			matchers = append(matchers, m)
+		}
+		if len(matchers) > 0 {
+			child["matchers"] = matchers
+		}
+
+		children = append(children, child)
+	}
+
+	return children
+}
+
+func newAlertReceiver(r serviceApi.AlertReceiver) map[string]any {
+	receiver := map[string]any{
+		"name": r.Name,
+	}
+
+	switch {
+	case r.Webhook != nil:
+		receiver["webhookConfigs"] = []any{
+			map[string]any{
+				"urlSecret":    newSecretKeySelector(r.Webhook.URLSecret),
+				"sendResolved": r.Webhook.SendResolved,
+			},
+		}
+	case r.Email != nil:
+		cfg := map[string]any{
+			"to":           r.Email.To,
+			"from":         r.Email.From,
+			"smarthost":    r.Email.Smarthost,
+			"sendResolved": r.Email.SendResolved,
+		}
+		if r.Email.AuthPasswordSecret != nil {
+			cfg["authUsername"] = r.Email.AuthUsername
+			cfg["authPassword"] = newSecretKeySelector(*r.Email.AuthPasswordSecret)
+		}
+		receiver["emailConfigs"] = []any{cfg}
+	case r.PagerDuty != nil:
+		receiver["pagerdutyConfigs"] = []any{
+			map[string]any{
+				"routingKey":   newSecretKeySelector(r.PagerDuty.RoutingKeySecret),
+				"sendResolved": r.PagerDuty.SendResolved,
+			},
+		}
+	case r.Slack != nil:
+		cfg := map[string]any{
+			"apiURL":       newSecretKeySelector(r.Slack.APIURLSecret),
+			"sendResolved": r.Slack.SendResolved,
+		}
+		if r.Slack.Channel != "" {
+			cfg["channel"] = r.Slack.Channel
+		}
+		receiver["slackConfigs"] = []any{cfg}
+	}
+
+	return receiver
+}
+
+func newSecretKeySelector(ref serviceApi.SecretKeyReference) map[string]any {
+	return map[string]any{
+		"name": ref.Name,
+		"key":  ref.Key,
+	}
+}
+
+// newAlertMatcher returns a matcher for the alerts having the label set to any of the given values,
+// or nil if no values are given.
+func newAlertMatcher[T ~string](label string, values []T) map[string]any {
+	switch len(values) {
+	case 0:
+		return nil
+	case 1:
+		return map[string]any{
+			"name":      label,
+			"value":     string(values[0]),
+			"matchType": "=",
+		}
+	default:
+		quoted := make([]string, 0, len(values))
+		for _, v := range values {
+			quoted = append(quoted, regexp.QuoteMeta(string(v)))
+		}
+		slices.Sort(quoted)
+
+		return map[string]any{
+			"name":      label,
+			"value":     strings.Join(quoted, "|"),
+			"matchType": "=~",
+		}
+	}
+}
+
+// setAlertComponentLabel sets the component label on the alerting rules of a PrometheusRule deployed by the
+// operator, named after the component. Rules already having the label are left untouched.
+func setAlertComponentLabel(pr *unstructured.Unstructured) error {
+	component := prometheusRuleComponent(pr.GetName())
+	if component == "" {
+		return nil
+	}
+
+	groups, found, err := unstructured.NestedSlice(pr.Object, "spec", "groups")
+	if err != nil || !found {
+		return err
+	}
+
+	for _, g := range groups {
+		group, ok := g.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		rules, ok := group["rules"].([]any)
+		if !ok {
+			continue
+		}
+
+		for _, r := range rules {
+			rule, ok := r.(map[string]any)
+			if !ok {
+				continue
+			}
+			if _, isAlert := rule["alert"]; !isAlert {
+				continue
+			}
+
+			ruleLabels, ok := rule["labels"].(map[string]any)
+			if !ok {
+				ruleLabels = map[string]any{}
+			}
+			if _, exists := ruleLabels[alertComponentLabel]; !exists {
+				ruleLabels[alertComponentLabel] = component
+			}
+			rule["labels"] = ruleLabels
+		}
+	}
+
+	return unstructured.SetNestedSlice(pr.Object, groups, "spec", "groups")
+}
+
+func toAnySlice[T ~string](values []T) []any {
+	res := make([]any, 0, len(values))
+	for _, v := range values {
+		res = append(res, string(v))
+	}
+
+	return res
+}
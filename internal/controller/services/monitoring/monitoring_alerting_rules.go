@@ -0,0 +1,249 @@
+package monitoring
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/promql"
+)
+
+// alertParameterDefaults are the default values of the thresholds exposed as parameters by the alerting rules
+// of the components, available to the rules templates as .AlertParameters.<component>.<parameter>.
+var alertParameterDefaults = map[string]map[string]string{
+	"codeflare": {
+		"probeSuccessObjective": "0.99950",
+	},
+	"dashboard": {
+		"routeSuccessObjective": "0.99950",
+		"probeSuccessObjective": "0.98",
+	},
+	"datasciencepipelines": {
+		"routeSuccessObjective": "0.99950",
+		"probeSuccessObjective": "0.98000",
+	},
+	"feastoperator": {
+		"probeSuccessObjective": "0.98000",
+	},
+	"kserve": {
+		"probeSuccessObjective": "0.98000",
+	},
+	"llamastackoperator": {
+		"probeSuccessObjective": "0.98000",
+	},
+	"modelcontroller": {
+		"probeSuccessObjective": "0.98000",
+	},
+	"modelmeshserving": {
+		"probeSuccessObjective": "0.98000",
+	},
+	"modelregistry": {
+		"probeSuccessObjective": "0.98000",
+	},
+	"trustyai": {
+		"probeSuccessObjective": "0.98000",
+	},
+	"workbenches": {
+		"probeSuccessObjective":    "0.98000",
+		"pvcUsageWarningThreshold": "0.9",
+	},
+}
+
+// alertParameters returns the parameters of the alerting rules of the components, the defaults overridden
+// by the parameters set in the alerting configuration.
+func alertParameters(alerting *serviceApi.Alerting) (map[string]map[string]string, error) {
+	params := make(map[string]map[string]string, len(alertParameterDefaults))
+	for component, defaults := range alertParameterDefaults {
+		params[component] = maps.Clone(defaults)
+	}
+
+	if alerting == nil {
+		return params, nil
+	}
+
+	if err := ValidateAlertParameters(alerting); err != nil {
+		return nil, err
+	}
+
+	for _, r := range alerting.Rules {
+		for name, value := range r.Parameters {
+			params[r.Component][name] = value
+		}
+	}
+
+	return params, nil
+}
+
+// ValidateAlertParameters returns an error listing the parameters of the alerting configuration which are not
+// exposed by the alerting rules of their component.
+func ValidateAlertParameters(alerting *serviceApi.Alerting) error {
+	if alerting == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, r := range alerting.Rules {
+		for _, name := range slices.Sorted(maps.Keys(r.Parameters)) {
+			if _, ok := alertParameterDefaults[r.Component][name]; !ok {
+				errs = append(errs, fmt.Errorf("unknown alerting rules parameter %q for component %s", name, r.Component))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// prometheusRuleComponent returns the name of the component a PrometheusRule deployed by the operator
+// belongs to, or an empty string if the PrometheusRule is not named after a component.
+func prometheusRuleComponent(name string) string {
+	for _, suffix := range prometheusRulesSuffixes {
+		if c, ok := strings.CutSuffix(name, suffix); ok {
+			return c
+		}
+	}
+
+	return ""
+}
+
+// customizeComponentRules applies the customization of the alerting rules of a component to its
+// PrometheusRule: the disabled alerts are removed, the pending durations are overridden and the custom
+// rules are appended in a dedicated group.
+func customizeComponentRules(pr *unstructured.Unstructured, customization *serviceApi.ComponentAlertRules) error {
+	groups, _, err := unstructured.NestedSlice(pr.Object, "spec", "groups")
+	if err != nil {
+		return err
+	}
+
+	customized := make([]any, 0, len(groups)+1)
+	for _, g := range groups {
+		group, ok := g.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		rules, _ := group["rules"].([]any)
+		kept := make([]any, 0, len(rules))
+		for _, r := range rules {
+			rule, ok := r.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			alert, isAlert := rule["alert"].(string)
+			if !isAlert {
+				kept = append(kept, rule)
+				continue
+			}
+			if slices.Contains(customization.Disabled, alert) {
+				continue
+			}
+
+			ruleLabels, _ := rule["labels"].(map[string]any)
+			for _, o := range customization.Overrides {
+				if o.Alert != alert {
+					continue
+				}
+				if o.Severity != "" && ruleLabels[alertSeverityLabel] != string(o.Severity) {
+					continue
+				}
+				rule["for"] = o.For
+			}
+
+			kept = append(kept, rule)
+		}
+
+		// Prometheus rejects groups without rules
+		if len(kept) == 0 {
+			continue
+		}
+
+		group["rules"] = kept
+		customized = append(customized, group)
+	}
+
+	if len(customization.CustomRules) > 0 {
+		rules := make([]any, 0, len(customization.CustomRules))
+		for _, c := range customization.CustomRules {
+			rules = append(rules, newCustomAlertRule(c))
+		}
+
+		customized = append(customized, map[string]any{
+			"name":  customization.Component + "-custom-rules",
+			"rules": rules,
+		})
+	}
+
+	return unstructured.SetNestedSlice(pr.Object, customized, "spec", "groups")
+}
+
+func newCustomAlertRule(c serviceApi.CustomAlertRule) map[string]any {
+	rule := map[string]any{
+		"alert": c.Alert,
+		"expr":  c.Expr,
+	}
+	if c.For != "" {
+		rule["for"] = c.For
+	}
+
+	ruleLabels := make(map[string]any, len(c.Labels)+1)
+	for k, v := range c.Labels {
+		ruleLabels[k] = v
+	}
+	if c.Severity != "" {
+		ruleLabels[alertSeverityLabel] = string(c.Severity)
+	}
+	if len(ruleLabels) > 0 {
+		rule["labels"] = ruleLabels
+	}
+
+	if len(c.Annotations) > 0 {
+		annotations := make(map[string]any, len(c.Annotations))
+		for k, v := range c.Annotations {
+			annotations[k] = v
+		}
+		rule["annotations"] = annotations
+	}
+
+	return rule
+}
+
+// validatePrometheusRule checks the PromQL expressions of the rules of a PrometheusRule.
+func validatePrometheusRule(pr *unstructured.Unstructured) error {
+	groups, _, err := unstructured.NestedSlice(pr.Object, "spec", "groups")
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, g := range groups {
+		group, ok := g.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		rules, _ := group["rules"].([]any)
+		for _, r := range rules {
+			rule, ok := r.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			name, _ := rule["alert"].(string)
+			if name == "" {
+				name, _ = rule["record"].(string)
+			}
+
+			expr, _ := rule["expr"].(string)
+			if err := promql.Validate(expr); err != nil {
+				errs = append(errs, fmt.Errorf("rule %q of group %q: %w", name, group["name"], err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
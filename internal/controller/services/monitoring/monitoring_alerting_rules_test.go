@@ -0,0 +1,168 @@
+//nolint:testpackage // Need to test unexported functions
+package monitoring
+
+import (
+	"bytes"
+	"io/fs"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	componentMonitoring "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+func TestAlertParameters(t *testing.T) {
+	params, err := alertParameters(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "0.99950", params["dashboard"]["routeSuccessObjective"])
+
+	params, err = alertParameters(&serviceApi.Alerting{
+		Rules: []serviceApi.ComponentAlertRules{
+			{Component: "dashboard", Parameters: map[string]string{"routeSuccessObjective": "0.999"}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "0.999", params["dashboard"]["routeSuccessObjective"])
+	assert.Equal(t, "0.98", params["dashboard"]["probeSuccessObjective"])
+	// the defaults are not modified
+	assert.Equal(t, "0.99950", alertParameterDefaults["dashboard"]["routeSuccessObjective"])
+
+	_, err = alertParameters(&serviceApi.Alerting{
+		Rules: []serviceApi.ComponentAlertRules{
+			{Component: "kueue", Parameters: map[string]string{"probeSuccessObjective": "0.99"}},
+		},
+	})
+	require.ErrorContains(t, err, `unknown alerting rules parameter "probeSuccessObjective" for component kueue`)
+}
+
+func TestCustomizeComponentRules(t *testing.T) {
+	pr := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"groups": []any{
+				map[string]any{
+					"name": "slos",
+					"rules": []any{
+						map[string]any{"alert": "BurnRate", "expr": "up == 0", "for": "2m", "labels": map[string]any{"severity": "critical"}},
+						map[string]any{"alert": "BurnRate", "expr": "up == 0", "for": "1h", "labels": map[string]any{"severity": "warning"}},
+						map[string]any{"record": "some:record", "expr": "up"},
+					},
+				},
+				map[string]any{
+					"name": "noisy",
+					"rules": []any{
+						map[string]any{"alert": "Noisy", "expr": "up == 0"},
+					},
+				},
+			},
+		},
+	}}
+	pr.SetGroupVersionKind(gvk.PrometheusRule)
+	pr.SetName("dashboard-prometheusrules")
+
+	err := customizeComponentRules(pr, &serviceApi.ComponentAlertRules{
+		Component: "dashboard",
+		Disabled:  []string{"Noisy"},
+		Overrides: []serviceApi.AlertRuleOverride{
+			{Alert: "BurnRate", Severity: serviceApi.AlertSeverityWarning, For: "3h"},
+		},
+		CustomRules: []serviceApi.CustomAlertRule{
+			{
+				Alert:       "DashboardDown",
+				Expr:        `absent(up{job="dashboard"}) == 1`,
+				For:         "10m",
+				Severity:    serviceApi.AlertSeverityCritical,
+				Labels:      map[string]string{"team": "ui"},
+				Annotations: map[string]string{"summary": "Dashboard is down"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	groups, _, err := unstructured.NestedSlice(pr.Object, "spec", "groups")
+	require.NoError(t, err)
+	assert.Equal(t, []any{
+		map[string]any{
+			"name": "slos",
+			"rules": []any{
+				map[string]any{"alert": "BurnRate", "expr": "up == 0", "for": "2m", "labels": map[string]any{"severity": "critical"}},
+				map[string]any{"alert": "BurnRate", "expr": "up == 0", "for": "3h", "labels": map[string]any{"severity": "warning"}},
+				map[string]any{"record": "some:record", "expr": "up"},
+			},
+		},
+		map[string]any{
+			"name": "dashboard-custom-rules",
+			"rules": []any{
+				map[string]any{
+					"alert":       "DashboardDown",
+					"expr":        `absent(up{job="dashboard"}) == 1`,
+					"for":         "10m",
+					"labels":      map[string]any{"severity": "critical", "team": "ui"},
+					"annotations": map[string]any{"summary": "Dashboard is down"},
+				},
+			},
+		},
+	}, groups)
+
+	require.NoError(t, validatePrometheusRule(pr))
+}
+
+func TestValidatePrometheusRule(t *testing.T) {
+	pr := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"groups": []any{
+				map[string]any{
+					"name": "group",
+					"rules": []any{
+						map[string]any{"alert": "Valid", "expr": "up == 0"},
+						map[string]any{"alert": "Invalid", "expr": "sum(up"},
+						map[string]any{"record": "invalid:record", "expr": "rate(up[5x])"},
+					},
+				},
+			},
+		},
+	}}
+
+	err := validatePrometheusRule(pr)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `rule "Invalid" of group "group"`)
+	assert.Contains(t, err.Error(), `rule "invalid:record" of group "group"`)
+	assert.NotContains(t, err.Error(), `"Valid"`)
+}
+
+// TestComponentRulesTemplates renders the rules of all the components with the default parameters, and checks
+// that their expressions are valid.
+func TestComponentRulesTemplates(t *testing.T) {
+	params, err := alertParameters(nil)
+	require.NoError(t, err)
+
+	data := map[string]any{
+		"Namespace":            "monitoring-ns",
+		"ApplicationNamespace": "applications-ns",
+		"AlertParameters":      params,
+	}
+
+	paths, err := fs.Glob(componentMonitoring.ComponentRulesFS, "*/monitoring/*-prometheusrules.tmpl.yaml")
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+
+	for _, path := range paths {
+		t.Run(path, func(t *testing.T) {
+			tmpl, err := template.New(path).Option("missingkey=error").ParseFS(componentMonitoring.ComponentRulesFS, path)
+			require.NoError(t, err)
+
+			var buffer bytes.Buffer
+			require.NoError(t, tmpl.ExecuteTemplate(&buffer, path[strings.LastIndex(path, "/")+1:], data))
+
+			pr := &unstructured.Unstructured{}
+			require.NoError(t, yaml.Unmarshal(buffer.Bytes(), &pr.Object))
+			require.NoError(t, validatePrometheusRule(pr))
+		})
+	}
+}
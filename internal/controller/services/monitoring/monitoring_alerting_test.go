@@ -0,0 +1,292 @@
+//nolint:testpackage // Need to test unexported functions
+package monitoring
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+)
+
+func TestNewAlertmanagerConfig(t *testing.T) {
+	alerting := &serviceApi.Alerting{
+		DefaultReceiver: "platform",
+		Receivers: []serviceApi.AlertReceiver{
+			{
+				Name: "platform",
+				Webhook: &serviceApi.WebhookReceiver{
+					URLSecret:    serviceApi.SecretKeyReference{Name: "hooks", Key: "platform"},
+					SendResolved: true,
+				},
+			},
+			{
+				Name: "oncall",
+				PagerDuty: &serviceApi.PagerDutyReceiver{
+					RoutingKeySecret: serviceApi.SecretKeyReference{Name: "pagerduty", Key: "key"},
+				},
+			},
+		},
+		Routes: []serviceApi.AlertRoute{
+			{
+				Receiver:   "oncall",
+				Components: []string{"kserve", "modelmeshserving"},
+				Severities: []serviceApi.AlertSeverity{serviceApi.AlertSeverityCritical},
+			},
+		},
+		Inhibitions: []serviceApi.AlertInhibition{
+			{
+				SourceSeverity: serviceApi.AlertSeverityCritical,
+				TargetSeverity: serviceApi.AlertSeverityWarning,
+				Equal:          []string{"alertname"},
+			},
+		},
+		Silences: []serviceApi.AlertSilence{
+			{
+				Name:       "maintenance",
+				Components: []string{"kserve"},
+				Weekdays:   []serviceApi.Weekday{"saturday"},
+				StartTime:  "02:00",
+				EndTime:    "04:00",
+			},
+		},
+	}
+
+	amc := newAlertmanagerConfig("monitoring-ns", alerting)
+
+	assert.Equal(t, gvk.AlertmanagerConfig, amc.GroupVersionKind())
+	assert.Equal(t, AlertmanagerConfigName, amc.GetName())
+	assert.Equal(t, "monitoring-ns", amc.GetNamespace())
+
+	receivers, _, err := unstructured.NestedSlice(amc.Object, "spec", "receivers")
+	require.NoError(t, err)
+	assert.Equal(t, []any{
+		map[string]any{"name": nullReceiver},
+		map[string]any{
+			"name": "platform",
+			"webhookConfigs": []any{
+				map[string]any{
+					"urlSecret":    map[string]any{"name": "hooks", "key": "platform"},
+					"sendResolved": true,
+				},
+			},
+		},
+		map[string]any{
+			"name": "oncall",
+			"pagerdutyConfigs": []any{
+				map[string]any{
+					"routingKey":   map[string]any{"name": "pagerduty", "key": "key"},
+					"sendResolved": false,
+				},
+			},
+		},
+	}, receivers)
+
+	silenced := map[string]any{
+		"muteTimeIntervals": []any{"maintenance"},
+		"matchers": []any{
+			map[string]any{"name": alertComponentLabel, "value": "kserve", "matchType": "="},
+		},
+	}
+	silencedFor := func(receiver string) map[string]any {
+		r := map[string]any{"receiver": receiver}
+		for k, v := range silenced {
+			r[k] = v
+		}
+		return r
+	}
+
+	route, _, err := unstructured.NestedMap(amc.Object, "spec", "route")
+	require.NoError(t, err)
+	assert.Equal(t, "platform", route["receiver"])
+	assert.Equal(t, []any{
+		map[string]any{
+			"receiver": "oncall",
+			"matchers": []any{
+				map[string]any{"name": alertComponentLabel, "value": "kserve|modelmeshserving", "matchType": "=~"},
+				map[string]any{"name": alertSeverityLabel, "value": "critical", "matchType": "="},
+			},
+			"routes": []any{silencedFor("oncall")},
+		},
+		silencedFor("platform"),
+	}, route["routes"])
+
+	inhibitRules, _, err := unstructured.NestedSlice(amc.Object, "spec", "inhibitRules")
+	require.NoError(t, err)
+	assert.Equal(t, []any{
+		map[string]any{
+			"sourceMatch": []any{map[string]any{"name": alertSeverityLabel, "value": "critical", "matchType": "="}},
+			"targetMatch": []any{map[string]any{"name": alertSeverityLabel, "value": "warning", "matchType": "="}},
+			"equal":       []any{"alertname"},
+		},
+	}, inhibitRules)
+
+	intervals, _, err := unstructured.NestedSlice(amc.Object, "spec", "muteTimeIntervals")
+	require.NoError(t, err)
+	assert.Equal(t, []any{
+		map[string]any{
+			"name": "maintenance",
+			"timeIntervals": []any{
+				map[string]any{
+					"times":    []any{map[string]any{"startTime": "02:00", "endTime": "04:00"}},
+					"weekdays": []any{"saturday"},
+				},
+			},
+		},
+	}, intervals)
+}
+
+func TestNewAlertmanagerConfigWithoutDefaultReceiver(t *testing.T) {
+	amc := newAlertmanagerConfig("monitoring-ns", &serviceApi.Alerting{
+		Receivers: []serviceApi.AlertReceiver{
+			{
+				Name: "team",
+				Slack: &serviceApi.SlackReceiver{
+					APIURLSecret: serviceApi.SecretKeyReference{Name: "slack", Key: "url"},
+				},
+			},
+		},
+		Routes: []serviceApi.AlertRoute{
+			{Receiver: "team"},
+		},
+	})
+
+	route, _, err := unstructured.NestedMap(amc.Object, "spec", "route")
+	require.NoError(t, err)
+	assert.Equal(t, nullReceiver, route["receiver"])
+	assert.Equal(t, []any{map[string]any{"receiver": "team"}}, route["routes"])
+}
+
+func TestSetAlertComponentLabel(t *testing.T) {
+	newRules := func(name string) *unstructured.Unstructured {
+		pr := &unstructured.Unstructured{Object: map[string]any{
+			"spec": map[string]any{
+				"groups": []any{
+					map[string]any{
+						"name": "group",
+						"rules": []any{
+							map[string]any{"record": "some:record", "expr": "up"},
+							map[string]any{"alert": "SomeAlert", "expr": "up == 0", "labels": map[string]any{"severity": "critical"}},
+							map[string]any{"alert": "OtherAlert", "expr": "up == 0", "labels": map[string]any{"component": "custom"}},
+							map[string]any{"alert": "NoLabels", "expr": "up == 0"},
+						},
+					},
+				},
+			},
+		}}
+		pr.SetGroupVersionKind(gvk.PrometheusRule)
+		pr.SetName(name)
+
+		return pr
+	}
+
+	tests := []struct {
+		name      string
+		rulesName string
+		component any
+	}{
+		{name: "component rules", rulesName: "kserve-prometheusrules", component: "kserve"},
+		{name: "component alerts", rulesName: "kueue-alerts", component: "kueue"},
+		{name: "operator rules", rulesName: "operator-prometheusrules", component: "operator"},
+		{name: "other rules", rulesName: "unrelated", component: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := newRules(tt.rulesName)
+			require.NoError(t, setAlertComponentLabel(pr))
+
+			groups, _, err := unstructured.NestedSlice(pr.Object, "spec", "groups")
+			require.NoError(t, err)
+
+			rules, ok := groups[0].(map[string]any)["rules"].([]any)
+			require.True(t, ok)
+
+			assert.NotContains(t, rules[0], "labels")
+			assert.Equal(t, "custom", rules[2].(map[string]any)["labels"].(map[string]any)["component"])
+
+			for _, i := range []int{1, 3} {
+				ruleLabels, _ := rules[i].(map[string]any)["labels"].(map[string]any)
+				if tt.component == nil {
+					assert.NotContains(t, ruleLabels, "component")
+				} else {
+					assert.Equal(t, tt.component, ruleLabels["component"])
+				}
+			}
+		})
+	}
+}
+
+func TestApplyAlertmanagerMatcherStrategy(t *testing.T) {
+	s, err := scheme.New()
+	require.NoError(t, err)
+	s.AddKnownTypeWithName(gvk.Alertmanager, &unstructured.Unstructured{})
+
+	am := unstructured.Unstructured{}
+	am.SetGroupVersionKind(gvk.Alertmanager)
+	am.SetNamespace("monitoring")
+	am.SetName(monitoringStackName)
+
+	var applied []*unstructured.Unstructured
+
+	newClient := func(objs ...client.Object) client.Client {
+		cl, err := fakeclient.New(
+			fakeclient.WithScheme(s),
+			fakeclient.WithObjects(objs...),
+			fakeclient.WithInterceptorFuncs(interceptor.Funcs{
+				Patch: func(_ context.Context, _ client.WithWatch, obj client.Object, _ client.Patch, opts ...client.PatchOption) error {
+					u, ok := obj.(*unstructured.Unstructured)
+					require.True(t, ok)
+
+					po := client.PatchOptions{}
+					po.ApplyOptions(opts)
+					assert.Nil(t, po.Force, "the ownership of the Alertmanager fields must not be forced")
+
+					applied = append(applied, u)
+
+					return nil
+				},
+			}),
+		)
+		require.NoError(t, err)
+
+		return cl
+	}
+
+	// nothing is applied until the Alertmanager is created by the MonitoringStack
+	require.NoError(t, applyAlertmanagerMatcherStrategy(t.Context(), newClient(), "monitoring", true))
+	assert.Empty(t, applied)
+
+	// nothing is removed if the matcher strategy was never applied
+	require.NoError(t, applyAlertmanagerMatcherStrategy(t.Context(), newClient(&am), "monitoring", false))
+	assert.Empty(t, applied)
+
+	require.NoError(t, applyAlertmanagerMatcherStrategy(t.Context(), newClient(&am), "monitoring", true))
+	require.Len(t, applied, 1)
+	assert.Equal(t, client.ObjectKeyFromObject(&am), client.ObjectKeyFromObject(applied[0]))
+
+	strategy, _, err := unstructured.NestedString(applied[0].Object, "spec", "alertmanagerConfigMatcherStrategy", "type")
+	require.NoError(t, err)
+	assert.Equal(t, "None", strategy)
+
+	// the matcher strategy is removed by applying an empty configuration once disabled
+	am.SetManagedFields([]metav1.ManagedFieldsEntry{{
+		Manager:   alertmanagerFieldOwner,
+		Operation: metav1.ManagedFieldsOperationApply,
+	}})
+
+	applied = nil
+	require.NoError(t, applyAlertmanagerMatcherStrategy(t.Context(), newClient(&am), "monitoring", false))
+	require.Len(t, applied, 1)
+	assert.Equal(t, client.ObjectKeyFromObject(&am), client.ObjectKeyFromObject(applied[0]))
+	assert.NotContains(t, applied[0].Object, "spec")
+}
@@ -0,0 +1,197 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	cr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/registry"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+const (
+	// defaultMetricsPath is the path scraped when a metrics endpoint does not define one.
+	defaultMetricsPath = "/metrics"
+)
+
+// deployComponentMonitors generates a ServiceMonitor or a PodMonitor for each metrics endpoint of the enabled and
+// ready components, so that their metrics are scraped by the MonitoringStack. The monitors of the components
+// that are disabled or not ready anymore are garbage collected.
+func deployComponentMonitors(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	monitoring, ok := rr.Instance.(*serviceApi.Monitoring)
+	if !ok {
+		return errors.New("instance is not of type *services.Monitoring")
+	}
+
+	// the monitors are scraped by the MonitoringStack, deployed only when metrics are configured
+	if monitoring.Spec.Metrics == nil {
+		return nil
+	}
+
+	for _, kind := range []schema.GroupVersionKind{gvk.ServiceMonitor, gvk.PodMonitor} {
+		exists, err := cluster.HasCRD(ctx, rr.Client, kind)
+		if err != nil {
+			return fmt.Errorf("failed to check if %s CRD exists: %w", kind.Kind, err)
+		}
+		if !exists {
+			return nil
+		}
+	}
+
+	dsc, err := cluster.GetDSC(ctx, rr.Client)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to retrieve DataScienceCluster: %w", err)
+	}
+
+	var monitors []unstructured.Unstructured
+	var addErrors []error
+
+	forEachErr := cr.ForEach(func(ch cr.ComponentHandler) error {
+		provider, ok := ch.(cr.MetricsEndpointsProvider)
+		if !ok || !ch.IsEnabled(dsc) {
+			return nil
+		}
+
+		ready, err := isComponentReady(ctx, rr.Client, ch.NewCRObject(dsc))
+		if err != nil {
+			addErrors = append(addErrors, fmt.Errorf("failed to get status for component %s: %w", ch.GetName(), err))
+			return nil // Continue processing other components
+		}
+		if !ready {
+			return nil
+		}
+
+		for _, endpoint := range provider.MetricsEndpoints() {
+			monitor, err := newComponentMonitor(ch.GetName(), monitoring.Spec.Namespace, rr.DSCI.Spec.ApplicationsNamespace, endpoint)
+			if err != nil {
+				addErrors = append(addErrors, err)
+				continue
+			}
+			monitors = append(monitors, *monitor)
+		}
+
+		return nil
+	})
+	if forEachErr != nil {
+		return fmt.Errorf("failed to iterate components: %w", forEachErr)
+	}
+
+	for _, addErr := range addErrors {
+		logf.FromContext(ctx).Error(addErr, "Failed to generate monitors for component")
+	}
+
+	if len(monitors) > 0 {
+		rr.Resources = append(rr.Resources, monitors...)
+		rr.Templates = append(rr.Templates, odhtypes.TemplateInfo{
+			FS:   resourcesFS,
+			Path: ComponentMonitorsRBACTemplate,
+		})
+	}
+
+	if len(addErrors) > 0 {
+		return errors.New("errors occurred while generating monitors for components")
+	}
+
+	return nil
+}
+
+// newComponentMonitor returns the ServiceMonitor or the PodMonitor scraping a metrics endpoint of a component
+// from the monitoring namespace, the scraped metrics being labelled with the name of the component.
+func newComponentMonitor(component string, namespace string, appNamespace string, endpoint cr.MetricsEndpoint) (*unstructured.Unstructured, error) {
+	var kind schema.GroupVersionKind
+	var endpointsField string
+
+	switch endpoint.Kind {
+	case cr.ServiceMonitorEndpoint:
+		kind = gvk.ServiceMonitor
+		endpointsField = "endpoints"
+	case cr.PodMonitorEndpoint:
+		kind = gvk.PodMonitor
+		endpointsField = "podMetricsEndpoints"
+	default:
+		return nil, fmt.Errorf("unsupported kind %q for metrics endpoint %s of component %s", endpoint.Kind, endpoint.Name, component)
+	}
+
+	if endpoint.Name == "" {
+		return nil, fmt.Errorf("metrics endpoint of component %s has no name", component)
+	}
+	if len(endpoint.Selector) == 0 {
+		return nil, fmt.Errorf("metrics endpoint %s of component %s has no selector", endpoint.Name, component)
+	}
+
+	path := endpoint.Path
+	if path == "" {
+		path = defaultMetricsPath
+	}
+
+	ep := map[string]any{
+		"path":     path,
+		"interval": "30s",
+		"relabelings": []any{
+			map[string]any{
+				"action":      "replace",
+				"targetLabel": metricComponentLabel,
+				"replacement": component,
+			},
+		},
+	}
+
+	// named ports are referenced directly, numbered ones through the target port
+	if endpoint.Port.Type == intstr.String {
+		ep["port"] = endpoint.Port.StrVal
+	} else {
+		ep["targetPort"] = int64(endpoint.Port.IntVal)
+	}
+
+	if endpoint.TLS != nil {
+		ep["scheme"] = "https"
+		tls := map[string]any{}
+		if endpoint.TLS.ServerName != "" {
+			tls["serverName"] = endpoint.TLS.ServerName
+		}
+		if endpoint.TLS.InsecureSkipVerify {
+			tls["insecureSkipVerify"] = true
+		}
+		ep["tlsConfig"] = tls
+	}
+
+	matchLabels := make(map[string]any, len(endpoint.Selector))
+	for k, v := range endpoint.Selector {
+		matchLabels[k] = v
+	}
+
+	monitor := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			endpointsField: []any{ep},
+			"namespaceSelector": map[string]any{
+				"matchNames": []any{appNamespace},
+			},
+			"selector": map[string]any{
+				"matchLabels": matchLabels,
+			},
+		},
+	}}
+	monitor.SetGroupVersionKind(kind)
+	monitor.SetName(componentMonitorName(component, endpoint))
+	monitor.SetNamespace(namespace)
+
+	return monitor, nil
+}
+
+// componentMonitorName returns the name of the ServiceMonitor or of the PodMonitor generated for a metrics
+// endpoint of a component.
+func componentMonitorName(component string, endpoint cr.MetricsEndpoint) string {
+	return component + "-" + endpoint.Name
+}
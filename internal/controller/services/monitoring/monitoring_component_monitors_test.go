@@ -0,0 +1,105 @@
+//nolint:testpackage // Need to test unexported functions
+package monitoring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	cr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/registry"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+func TestNewComponentMonitor(t *testing.T) {
+	t.Run("PodMonitor with a numbered port", func(t *testing.T) {
+		monitor, err := newComponentMonitor("kserve", "monitoring-ns", "apps-ns", cr.MetricsEndpoint{
+			Name:     "controller-manager",
+			Kind:     cr.PodMonitorEndpoint,
+			Selector: map[string]string{"control-plane": "kserve-controller-manager"},
+			Port:     intstr.FromInt32(8080),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, gvk.PodMonitor, monitor.GroupVersionKind())
+		assert.Equal(t, "kserve-controller-manager", monitor.GetName())
+		assert.Equal(t, "monitoring-ns", monitor.GetNamespace())
+
+		namespaces, _, err := unstructured.NestedStringSlice(monitor.Object, "spec", "namespaceSelector", "matchNames")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"apps-ns"}, namespaces)
+
+		selector, _, err := unstructured.NestedStringMap(monitor.Object, "spec", "selector", "matchLabels")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"control-plane": "kserve-controller-manager"}, selector)
+
+		endpoints, _, err := unstructured.NestedSlice(monitor.Object, "spec", "podMetricsEndpoints")
+		require.NoError(t, err)
+		require.Len(t, endpoints, 1)
+
+		ep := endpoints[0].(map[string]any)
+		assert.Equal(t, int64(8080), ep["targetPort"])
+		assert.Equal(t, defaultMetricsPath, ep["path"])
+		assert.NotContains(t, ep, "scheme")
+
+		relabelings := ep["relabelings"].([]any)
+		require.Len(t, relabelings, 1)
+		assert.Equal(t, metricComponentLabel, relabelings[0].(map[string]any)["targetLabel"])
+		assert.Equal(t, "kserve", relabelings[0].(map[string]any)["replacement"])
+	})
+
+	t.Run("ServiceMonitor with a named port and TLS", func(t *testing.T) {
+		monitor, err := newComponentMonitor("modelregistry", "monitoring-ns", "apps-ns", cr.MetricsEndpoint{
+			Name:     "controller-manager",
+			Kind:     cr.ServiceMonitorEndpoint,
+			Selector: map[string]string{"app": "model-registry-operator"},
+			Port:     intstr.FromString("https"),
+			Path:     "/custom-metrics",
+			TLS:      &cr.MetricsEndpointTLS{ServerName: "metrics.apps-ns.svc", InsecureSkipVerify: true},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, gvk.ServiceMonitor, monitor.GroupVersionKind())
+
+		endpoints, _, err := unstructured.NestedSlice(monitor.Object, "spec", "endpoints")
+		require.NoError(t, err)
+		require.Len(t, endpoints, 1)
+
+		ep := endpoints[0].(map[string]any)
+		assert.Equal(t, "https", ep["port"])
+		assert.NotContains(t, ep, "targetPort")
+		assert.Equal(t, "/custom-metrics", ep["path"])
+		assert.Equal(t, "https", ep["scheme"])
+		assert.Equal(t, map[string]any{"serverName": "metrics.apps-ns.svc", "insecureSkipVerify": true}, ep["tlsConfig"])
+	})
+
+	t.Run("invalid endpoints", func(t *testing.T) {
+		_, err := newComponentMonitor("kserve", "monitoring-ns", "apps-ns", cr.MetricsEndpoint{
+			Name:     "controller-manager",
+			Kind:     "Probe",
+			Selector: map[string]string{"app": "kserve"},
+		})
+		require.ErrorContains(t, err, "unsupported kind")
+
+		_, err = newComponentMonitor("kserve", "monitoring-ns", "apps-ns", cr.MetricsEndpoint{
+			Kind:     cr.PodMonitorEndpoint,
+			Selector: map[string]string{"app": "kserve"},
+		})
+		require.ErrorContains(t, err, "has no name")
+
+		_, err = newComponentMonitor("kserve", "monitoring-ns", "apps-ns", cr.MetricsEndpoint{
+			Name: "controller-manager",
+			Kind: cr.PodMonitorEndpoint,
+		})
+		require.ErrorContains(t, err, "has no selector")
+	})
+}
+
+func TestDeployComponentMonitorsWithoutMetrics(t *testing.T) {
+	rr := newLogsRequest(t, nil)
+	require.NoError(t, deployComponentMonitors(t.Context(), rr))
+	assert.Empty(t, rr.Resources)
+	assert.Empty(t, rr.Templates)
+}
@@ -23,9 +23,11 @@ import (
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v1"
@@ -34,8 +36,10 @@ import (
 	sr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/registry"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/gc"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/openshift"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/template"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/deployments"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
@@ -85,20 +89,27 @@ func monitoringNamespace(_ context.Context, rr *odhtypes.ReconciliationRequest)
 }
 
 func (h *serviceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) error {
+	instrumentWorkloads, cleanupWorkloadInstrumentation := newWorkloadInstrumentationActions(mgr.GetAPIReader())
+	reconcileStorage := newStorageAction(mgr.GetAPIReader())
+
 	_, err := reconciler.ReconcilerFor(mgr, &serviceApi.Monitoring{}).
 		Owns(&rbacv1.Role{}).
 		Owns(&rbacv1.RoleBinding{}).
 		// operands - openshift
-		Owns(&routev1.Route{}).
+		Owns(&routev1.Route{}, reconciler.Dynamic(actions.IfOpenShift())).
 		// operands - owned dynmically depends on external operators are installed for monitoring
 		// TODO: add more here later when enable other operator
 		OwnsGVK(gvk.MonitoringStack, reconciler.Dynamic(reconciler.CrdExists(gvk.MonitoringStack))).
 		OwnsGVK(gvk.TempoMonolithic, reconciler.Dynamic(reconciler.CrdExists(gvk.TempoMonolithic))).
 		OwnsGVK(gvk.TempoStack, reconciler.Dynamic(reconciler.CrdExists(gvk.TempoStack))).
+		OwnsGVK(gvk.LokiStack, reconciler.Dynamic(reconciler.CrdExists(gvk.LokiStack))).
 		OwnsGVK(gvk.Instrumentation, reconciler.Dynamic(reconciler.CrdExists(gvk.Instrumentation))).
 		OwnsGVK(gvk.OpenTelemetryCollector, reconciler.Dynamic(reconciler.CrdExists(gvk.OpenTelemetryCollector))).
 		OwnsGVK(gvk.ServiceMonitor, reconciler.Dynamic(reconciler.CrdExists(gvk.ServiceMonitor))).
+		OwnsGVK(gvk.PodMonitor, reconciler.Dynamic(reconciler.CrdExists(gvk.PodMonitor))).
 		OwnsGVK(gvk.PrometheusRule, reconciler.Dynamic(reconciler.CrdExists(gvk.PrometheusRule))).
+		OwnsGVK(gvk.AlertmanagerConfig, reconciler.Dynamic(reconciler.CrdExists(gvk.AlertmanagerConfig))).
+		OwnsGVK(gvk.PersesDashboard, reconciler.Dynamic(reconciler.CrdExists(gvk.PersesDashboard))).
 		// operands - watched
 		//
 		// By default the Watches functions adds:
@@ -117,6 +128,19 @@ func (h *serviceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) er
 		WithAction(deployments.NewAction(
 			deployments.InNamespaceFn(monitoringNamespace),
 		)).
+		// a created namespace may be a target of the instrumentation which was not found
+		Watches(
+			&corev1.Namespace{},
+			reconciler.WithEventHandler(handlers.ToNamed(serviceApi.MonitoringInstanceName)),
+			reconciler.WithPredicates(resources.Created()),
+		).
+		// the matcher strategy is applied once the Alertmanager is created, and again if reverted
+		WatchesGVK(
+			gvk.Alertmanager,
+			reconciler.Dynamic(reconciler.CrdExists(gvk.Alertmanager)),
+			reconciler.WithEventHandler(handlers.ToNamed(serviceApi.MonitoringInstanceName)),
+			reconciler.WithPredicates(resources.CreatedOrUpdatedOrDeletedNamed(monitoringStackName), predicate.GenerationChangedPredicate{}),
+		).
 		Watches(
 			&extv1.CustomResourceDefinition{},
 			reconciler.WithEventHandler(
@@ -129,16 +153,25 @@ func (h *serviceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) er
 		WithAction(addMonitoringCapability).
 		WithAction(deployMonitoringStack).
 		WithAction(deployAlerting).
+		WithAction(deployComponentMonitors).
+		WithAction(deployOperatorObservability).
 		WithAction(deployTempo).
+		WithAction(reconcileStorage).
 		WithAction(deployOpenTelemetryCollector).
 		WithAction(deployInstrumentation).
+		WithAction(instrumentWorkloads).
+		WithAction(deployLogs).
 		WithAction(template.NewAction(
 			template.WithDataFn(getTemplateData),
 		)).
+		WithAction(customizeAlertingRules).
+		WithAction(labelAlertingRules).
+		WithAction(openshift.NewAction()).
 		WithAction(deploy.NewAction(
 			deploy.WithCache(),
 		)).
 		WithAction(gc.NewAction()).
+		WithFinalizer(cleanupWorkloadInstrumentation).
 		Build(ctx)
 
 	if err != nil {
@@ -5,12 +5,13 @@ import (
 	"embed"
 	"errors"
 	"fmt"
+	"slices"
 
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
-	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
 	cr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/registry"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
@@ -30,26 +31,16 @@ const (
 	CollectorServiceMonitorsTemplate = "resources/collector-servicemonitors.tmpl.yaml"
 	CollectorRBACTemplate            = "resources/collector-rbac.tmpl.yaml"
 	PrometheusRouteTemplate          = "resources/prometheus-route.tmpl.yaml"
+	PrometheusFederationTemplate     = "resources/prometheus-federation.tmpl.yaml"
 	InstrumentationTemplate          = "resources/instrumentation.tmpl.yaml"
+	LogsCollectorTemplate            = "resources/logs-collector.tmpl.yaml"
+	LogsCollectorRBACTemplate        = "resources/logs-collector-rbac.tmpl.yaml"
+	LokiStackTemplate                = "resources/lokistack.tmpl.yaml"
+	ComponentMonitorsRBACTemplate    = "resources/component-monitors-rbac.tmpl.yaml"
+	OperatorServiceMonitorTemplate   = "resources/operator-servicemonitor.tmpl.yaml"
+	OperatorRecordingRulesTemplate   = "resources/operator-recordingrules.tmpl.yaml"
 )
 
-var componentRules = map[string]string{
-	componentApi.DashboardComponentName:            "rhods-dashboard",
-	componentApi.WorkbenchesComponentName:          "workbenches",
-	componentApi.KueueComponentName:                "kueue",
-	componentApi.CodeFlareComponentName:            "codeflare",
-	componentApi.DataSciencePipelinesComponentName: "data-science-pipelines-operator",
-	componentApi.ModelMeshServingComponentName:     "model-mesh",
-	componentApi.RayComponentName:                  "ray",
-	componentApi.TrustyAIComponentName:             "trustyai",
-	componentApi.KserveComponentName:               "kserve",
-	componentApi.TrainingOperatorComponentName:     "trainingoperator",
-	componentApi.ModelRegistryComponentName:        "model-registry-operator",
-	componentApi.ModelControllerComponentName:      "odh-model-controller",
-	componentApi.FeastOperatorComponentName:        "feastoperator",
-	componentApi.LlamaStackOperatorComponentName:   "llamastackoperator",
-}
-
 //go:embed resources
 //go:embed monitoring
 var resourcesFS embed.FS
@@ -86,22 +77,31 @@ func updatePrometheusConfigMap(ctx context.Context, rr *odhtypes.ReconciliationR
 		return fmt.Errorf("failed to retrieve DataScienceCluster: %w", err)
 	}
 
-	return cr.ForEach(func(ch cr.ComponentHandler) error {
-		ci := ch.NewCRObject(dsc)
-		if ch.IsEnabled(dsc) {
-			ready, err := isComponentReady(ctx, rr.Client, ci)
-			if err != nil {
-				return fmt.Errorf("failed to get component status %w", err)
-			}
-			if !ready { // not ready, skip change on prom rules
-				return nil
-			}
-			// add
-			return updatePrometheusConfig(ctx, true, componentRules[ch.GetName()])
-		} else {
-			return updatePrometheusConfig(ctx, false, componentRules[ch.GetName()])
+	// components are added once ready, and left untouched while they are not ready yet
+	components := map[string]bool{}
+	err = cr.ForEach(func(ch cr.ComponentHandler) error {
+		prefix := cr.PrometheusRulesPrefix(ch)
+		if prefix == "" {
+			return nil
+		}
+		if !ch.IsEnabled(dsc) {
+			components[prefix] = false
+			return nil
+		}
+		ready, err := isComponentReady(ctx, rr.Client, ch.NewCRObject(dsc))
+		if err != nil {
+			return fmt.Errorf("failed to get component status %w", err)
 		}
+		if ready {
+			components[prefix] = true
+		}
+		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	return newPrometheusRuleFiles(dirFS(prometheusConfigPath), prometheusConfigFile).Update(ctx, components)
 }
 
 func deployMonitoringStack(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
@@ -147,6 +147,13 @@ func deployMonitoringStack(ctx context.Context, rr *odhtypes.ReconciliationReque
 		},
 	}
 
+	if monitoring.Spec.Metrics.Federation != nil {
+		template = append(template, odhtypes.TemplateInfo{
+			FS:   resourcesFS,
+			Path: PrometheusFederationTemplate,
+		})
+	}
+
 	rr.Templates = append(rr.Templates, template...)
 
 	return nil
@@ -228,7 +235,7 @@ func deployTempo(ctx context.Context, rr *odhtypes.ReconciliationRequest) error
 
 	var requiredCRD schema.GroupVersionKind
 	var templatePath string
-	if traces.Storage.Backend == "pv" {
+	if traces.Storage.Backend == pvTracesBackend {
 		requiredCRD = gvk.TempoMonolithic
 		templatePath = TempoMonolithicTemplate
 	} else {
@@ -250,6 +257,26 @@ func deployTempo(ctx context.Context, rr *odhtypes.ReconciliationRequest) error
 		return nil
 	}
 
+	backend, err := tracesBackend(ctx, rr.Client, monitoring)
+	if err != nil {
+		return err
+	}
+
+	// Switching the backend deletes the stored traces, the deployed Tempo is kept as it is until the switch is
+	// acknowledged, the StorageReconciled condition reporting it
+	if backend != traces.Storage.Backend {
+		tempo, err := frozenTempo(ctx, rr.Client, monitoring.Spec.Namespace, backend)
+		if err != nil {
+			return fmt.Errorf("failed to get the deployed Tempo: %w", err)
+		}
+		if tempo != nil {
+			rr.Resources = append(rr.Resources, *tempo)
+		}
+
+		rr.Conditions.MarkTrue(status.ConditionTempoAvailable)
+		return nil
+	}
+
 	rr.Conditions.MarkTrue(status.ConditionTempoAvailable)
 
 	template := []odhtypes.TemplateInfo{
@@ -263,6 +290,85 @@ func deployTempo(ctx context.Context, rr *odhtypes.ReconciliationRequest) error
 	return nil
 }
 
+// deployLogs creates the logs collector, and the LokiStack storing the logs, based on the Monitoring CR
+// configuration. Without the LokiStack CRD, the collector is still deployed if the logs are exported to an
+// OTLP endpoint.
+func deployLogs(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	monitoring, ok := rr.Instance.(*serviceApi.Monitoring)
+	if !ok {
+		return errors.New("instance is not of type *services.Monitoring")
+	}
+
+	// Read logs configuration directly from Monitoring CR
+	if monitoring.Spec.Logs == nil {
+		// No logs configuration - GC action will clean up any existing logs resources
+		rr.Conditions.MarkFalse(
+			status.ConditionLogsAvailable,
+			conditions.WithReason(status.LogsNotConfiguredReason),
+			conditions.WithMessage(status.LogsNotConfiguredMessage),
+		)
+		return nil
+	}
+
+	crdExists, err := cluster.HasCRD(ctx, rr.Client, gvk.OpenTelemetryCollector)
+	if err != nil {
+		return fmt.Errorf("failed to check if CRD exists: %w", err)
+	}
+	if !crdExists {
+		// CRD not available, skip logs deployment (this is expected when the operator is not installed)
+		rr.Conditions.MarkFalse(
+			status.ConditionLogsAvailable,
+			conditions.WithReason(gvk.OpenTelemetryCollector.Kind+"CRDNotFoundReason"),
+			conditions.WithMessage("%s CRD Not Found", gvk.OpenTelemetryCollector.Kind),
+		)
+		return nil
+	}
+
+	storage, err := hasLogsStorage(ctx, rr, monitoring.Spec.Logs)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case monitoring.Spec.Logs.Storage == nil || storage:
+		rr.Conditions.MarkTrue(status.ConditionLogsAvailable)
+	case monitoring.Spec.Logs.OTLP == nil:
+		// nothing to export the logs to
+		rr.Conditions.MarkFalse(
+			status.ConditionLogsAvailable,
+			conditions.WithReason(gvk.LokiStack.Kind+"CRDNotFoundReason"),
+			conditions.WithMessage("%s CRD Not Found", gvk.LokiStack.Kind),
+		)
+		return nil
+	default:
+		rr.Conditions.MarkFalse(
+			status.ConditionLogsAvailable,
+			conditions.WithReason(gvk.LokiStack.Kind+"CRDNotFoundReason"),
+			conditions.WithMessage("%s CRD Not Found, the logs are only exported to the OTLP endpoint", gvk.LokiStack.Kind),
+		)
+	}
+
+	template := []odhtypes.TemplateInfo{
+		{
+			FS:   resourcesFS,
+			Path: LogsCollectorTemplate,
+		},
+		{
+			FS:   resourcesFS,
+			Path: LogsCollectorRBACTemplate,
+		},
+	}
+	if storage {
+		template = append(template, odhtypes.TemplateInfo{
+			FS:   resourcesFS,
+			Path: LokiStackTemplate,
+		})
+	}
+	rr.Templates = append(rr.Templates, template...)
+
+	return nil
+}
+
 // deployInstrumentation manages OpenTelemetry Instrumentation CRs using templates.
 func deployInstrumentation(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	monitoring, ok := rr.Instance.(*serviceApi.Monitoring)
@@ -316,6 +422,10 @@ func deployAlerting(ctx context.Context, rr *odhtypes.ReconciliationRequest) err
 	}
 
 	if monitoring.Spec.Alerting == nil {
+		if err := applyAlertmanagerMatcherStrategy(ctx, rr.Client, monitoring.Spec.Namespace, false); err != nil {
+			return err
+		}
+
 		rr.Conditions.MarkFalse(
 			status.ConditionAlertingAvailable,
 			conditions.WithReason(status.AlertingNotConfiguredReason),
@@ -338,6 +448,32 @@ func deployAlerting(ctx context.Context, rr *odhtypes.ReconciliationRequest) err
 		return nil
 	}
 
+	// Receivers, inhibitions and silences are rendered in an AlertmanagerConfig
+	if !hasAlertmanagerConfig(monitoring.Spec.Alerting) {
+		if err := applyAlertmanagerMatcherStrategy(ctx, rr.Client, monitoring.Spec.Namespace, false); err != nil {
+			return err
+		}
+	} else {
+		exists, err := cluster.HasCRD(ctx, rr.Client, gvk.AlertmanagerConfig)
+		if err != nil {
+			return fmt.Errorf("failed to check if %s CRD exists: %w", gvk.AlertmanagerConfig.Kind, err)
+		}
+		if !exists {
+			rr.Conditions.MarkFalse(
+				status.ConditionAlertingAvailable,
+				conditions.WithReason(gvk.AlertmanagerConfig.Kind+"CRDNotFoundReason"),
+				conditions.WithMessage("%s CRD Not Found", gvk.AlertmanagerConfig.Kind),
+			)
+			return nil
+		}
+
+		rr.Resources = append(rr.Resources, *newAlertmanagerConfig(monitoring.Spec.Namespace, monitoring.Spec.Alerting))
+
+		if err := applyAlertmanagerMatcherStrategy(ctx, rr.Client, monitoring.Spec.Namespace, true); err != nil {
+			return err
+		}
+	}
+
 	rr.Conditions.MarkTrue(status.ConditionAlertingAvailable)
 	// Add operator prometheus rules, we can deploy operator alerts without any components
 	templates := []odhtypes.TemplateInfo{
@@ -419,3 +555,66 @@ func deployAlerting(ctx context.Context, rr *odhtypes.ReconciliationRequest) err
 
 	return nil
 }
+
+// customizeAlertingRules applies the alerting rules customization of the Monitoring CR to the PrometheusRules
+// rendered for the components, and validates the PromQL expressions of the resulting rules before they are deployed.
+func customizeAlertingRules(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
+	monitoring, ok := rr.Instance.(*serviceApi.Monitoring)
+	if !ok {
+		return errors.New("instance is not of type *services.Monitoring")
+	}
+
+	var customizations []serviceApi.ComponentAlertRules
+	if monitoring.Spec.Alerting != nil {
+		customizations = monitoring.Spec.Alerting.Rules
+	}
+
+	err := rr.ForEachResource(func(u *unstructured.Unstructured) (bool, error) {
+		if u.GroupVersionKind() != gvk.PrometheusRule {
+			return false, nil
+		}
+
+		component := prometheusRuleComponent(u.GetName())
+		if component == "" {
+			return false, nil
+		}
+
+		idx := slices.IndexFunc(customizations, func(c serviceApi.ComponentAlertRules) bool {
+			return c.Component == component
+		})
+		if idx >= 0 {
+			if err := customizeComponentRules(u, &customizations[idx]); err != nil {
+				return false, fmt.Errorf("failed to customize PrometheusRule %s: %w", u.GetName(), err)
+			}
+		}
+
+		if err := validatePrometheusRule(u); err != nil {
+			return false, fmt.Errorf("invalid PrometheusRule %s: %w", u.GetName(), err)
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		rr.Conditions.MarkFalse(
+			status.ConditionAlertingAvailable,
+			conditions.WithReason(status.InvalidAlertingRulesReason),
+			conditions.WithMessage("%s", err.Error()),
+		)
+
+		return err
+	}
+
+	return nil
+}
+
+// labelAlertingRules sets the component label on the alerting rules deployed by the operator,
+// so that alerts can be routed and silenced by component.
+func labelAlertingRules(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
+	return rr.ForEachResource(func(u *unstructured.Unstructured) (bool, error) {
+		if u.GroupVersionKind() != gvk.PrometheusRule {
+			return false, nil
+		}
+
+		return false, setAlertComponentLabel(u)
+	})
+}
@@ -4,12 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/go-multierror"
-	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -23,6 +24,7 @@ import (
 	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
 	cond "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/otelcol"
 )
 
 const (
@@ -44,12 +46,34 @@ func getTemplateData(ctx context.Context, rr *odhtypes.ReconciliationRequest) (m
 
 	templateData["Traces"] = monitoring.Spec.Traces != nil
 	templateData["Metrics"] = monitoring.Spec.Metrics != nil
+	templateData["Logs"] = monitoring.Spec.Logs != nil
 	templateData["AcceleratorMetrics"] = monitoring.Spec.Metrics != nil
 	templateData["ApplicationNamespace"] = rr.DSCI.Spec.ApplicationsNamespace
 
+	// The operator namespace is only known when running in a cluster
+	if operatorNs, err := cluster.GetOperatorNamespace(); err == nil {
+		templateData["OperatorNamespace"] = operatorNs
+	}
+
+	// Always set the alerting rules parameters, referenced by the component rules templates
+	alertParams, err := alertParameters(monitoring.Spec.Alerting)
+	if err != nil {
+		return nil, err
+	}
+	templateData["AlertParameters"] = alertParams
+
 	// Always set metrics exporters data (even if empty to allow clean template logic)
-	templateData["MetricsExporters"] = make(map[string]interface{})
+	templateData["MetricsExporters"] = make(map[string]any)
 	templateData["MetricsExporterNames"] = []string{}
+	templateData["MetricsExtensions"] = make(map[string]any)
+	templateData["MetricsExtensionNames"] = []string{}
+	templateData["MetricsProcessors"] = make(map[string]any)
+	templateData["MetricsProcessorNames"] = []string{}
+	templateData["MetricsPipelines"] = []map[string]string{}
+	templateData["CollectorEnv"] = []corev1.EnvVar{}
+	templateData["CollectorVolumes"] = []corev1.Volume{}
+	templateData["CollectorVolumeMounts"] = []corev1.VolumeMount{}
+	templateData["TracesTailSampling"] = nil
 
 	// Add metrics-related data if metrics are configured
 	if metrics := monitoring.Spec.Metrics; metrics != nil {
@@ -114,60 +138,101 @@ func getTemplateData(ctx context.Context, rr *odhtypes.ReconciliationRequest) (m
 		}
 		templateData["Replicas"] = strconv.Itoa(int(replicas))
 
+		// Remote-write targets and federation endpoint aggregating the metrics of several clusters
+		maps.Copy(templateData, federationTemplateData(metrics, cluster.GetClusterInfo().ID))
+
 		// Handle custom metrics exporters
-		if metrics.Exporters != nil {
-			validExporters := make(map[string]interface{})
-			var exporterNames []string
-
-			for name, configYAML := range metrics.Exporters {
-				if isReservedExporterName(name) {
-					return nil, fmt.Errorf("exporter name '%s' is reserved and cannot be used", name)
-				}
-
-				// Trim and parse YAML configuration string
-				cfgStr := strings.TrimSpace(configYAML)
-				var cfg interface{}
-				if err := yaml.Unmarshal([]byte(cfgStr), &cfg); err != nil {
-					return nil, fmt.Errorf("invalid YAML configuration for exporter '%s': %w", name, err)
-				}
-
-				// Require a mapping/object to avoid invalid collector config
-				cfgMap, ok := cfg.(map[string]interface{})
-				if !ok {
-					return nil, fmt.Errorf("exporter '%s' configuration must be a YAML mapping/object", name)
-				}
-
-				validExporters[name] = cfgMap
-				exporterNames = append(exporterNames, name)
+		validExporters := make(map[string]any)
+		var exporterNames []string
+
+		for name, configYAML := range metrics.Exporters {
+			if isReservedExporterName(name) {
+				return nil, fmt.Errorf("exporter name '%s' is reserved and cannot be used", name)
 			}
 
-			// Ensure deterministic order in templates/pipelines
-			sort.Strings(exporterNames)
-			templateData["MetricsExporters"] = validExporters
-			templateData["MetricsExporterNames"] = exporterNames
+			cfg, err := otelcol.ParseExporterConfig(name, configYAML)
+			if err != nil {
+				return nil, err
+			}
+
+			validExporters[name] = cfg
+			exporterNames = append(exporterNames, name)
+		}
+
+		// Handle typed metrics destinations
+		destinations, err := newCollectorExporters("metrics", metrics.Destinations)
+		if err != nil {
+			return nil, err
 		}
+
+		for name, cfg := range destinations.Exporters {
+			if _, ok := validExporters[name]; ok {
+				return nil, fmt.Errorf("exporter '%s' is defined by both exporters and destinations", name)
+			}
+			validExporters[name] = cfg
+		}
+		exporterNames = append(exporterNames, destinations.PipelineExporters...)
+
+		// Ensure deterministic order in templates/pipelines
+		sort.Strings(exporterNames)
+		templateData["MetricsExporters"] = validExporters
+		templateData["MetricsExporterNames"] = exporterNames
+		templateData["MetricsExtensions"] = destinations.Extensions
+		templateData["MetricsExtensionNames"] = slices.Sorted(maps.Keys(destinations.Extensions))
+		templateData["MetricsProcessors"] = destinations.Processors
+		templateData["MetricsProcessorNames"] = slices.Sorted(maps.Keys(destinations.Processors))
+		templateData["MetricsPipelines"] = destinations.Pipelines
+		templateData["CollectorEnv"] = destinations.Env
+		templateData["CollectorVolumes"] = destinations.Volumes
+		templateData["CollectorVolumeMounts"] = destinations.VolumeMounts
 	}
 
 	// Add traces-related data if traces are configured
 	if traces := monitoring.Spec.Traces; traces != nil {
 		templateData["OtlpEndpoint"] = fmt.Sprintf("http://data-science-collector.%s.svc.cluster.local:4317", monitoring.Spec.Namespace)
-		templateData["SampleRatio"] = traces.SampleRatio
+
+		tracesData, err := tracesTemplateData(traces, monitoring.Spec.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(templateData, tracesData)
 		templateData["Backend"] = traces.Storage.Backend // backend has default "pv" set in API
 
 		// Add retention for all backends (both TempoMonolithic and TempoStack)
 		templateData["TracesRetention"] = traces.Storage.Retention.Duration.String()
 
+		// The collector exports to the backend the traces are stored in, which is not the configured one while its
+		// switch is not acknowledged
+		backend, err := tracesBackend(ctx, rr.Client, monitoring)
+		if err != nil {
+			return nil, err
+		}
+
 		// Add tempo-related data from traces.Storage fields (Storage is a struct, not a pointer)
-		switch traces.Storage.Backend {
+		switch backend {
 		case "pv":
 			templateData["TempoEndpoint"] = fmt.Sprintf("tempo-data-science-tempomonolithic.%s.svc.cluster.local:4317", monitoring.Spec.Namespace)
-			templateData["Size"] = traces.Storage.Size
 		case "s3", "gcs":
 			templateData["TempoEndpoint"] = fmt.Sprintf("tempo-data-science-tempostack-gateway.%s.svc.cluster.local:4317", monitoring.Spec.Namespace)
+		}
+
+		switch traces.Storage.Backend {
+		case "pv":
+			templateData["Size"] = traces.Storage.Size
+		case "s3", "gcs":
 			templateData["Secret"] = traces.Storage.Secret
 		}
 	}
 
+	// Add logs-related data if logs are configured
+	if logs := monitoring.Spec.Logs; logs != nil {
+		logsData, err := logsTemplateData(ctx, rr, logs)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(templateData, logsData)
+	}
+
 	return templateData, nil
 }
 
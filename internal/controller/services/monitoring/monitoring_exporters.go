@@ -0,0 +1,223 @@
+package monitoring
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+)
+
+const (
+	// collectorSecretsPath is the directory the Secrets referenced by the destinations are mounted in.
+	collectorSecretsPath = "/etc/otelcol/secrets"
+
+	// metricComponentLabel is the label holding the name of the component a scraped metric belongs to.
+	metricComponentLabel = "component"
+)
+
+// collectorExporters holds the OpenTelemetry Collector configuration rendered for the destinations of a signal.
+type collectorExporters struct {
+	signal string
+
+	// Exporters maps the exporter names to their configuration.
+	Exporters map[string]any
+	// PipelineExporters are the exporters of the signal pipeline, the exporters with a filter have their
+	// own pipeline.
+	PipelineExporters []string
+	// Extensions maps the authenticator extension names to their configuration.
+	Extensions map[string]any
+	// Processors maps the filter processor names to their configuration.
+	Processors map[string]any
+	// Pipelines are the metrics pipelines of the exporters with a filter.
+	Pipelines []map[string]string
+
+	Env          []corev1.EnvVar
+	Volumes      []corev1.Volume
+	VolumeMounts []corev1.VolumeMount
+}
+
+// newCollectorExporters renders the destinations of a signal, such as metrics or logs, into OpenTelemetry
+// Collector exporters, the Secrets they reference being passed to the collector through environment variables
+// and mounted files.
+func newCollectorExporters(signal string, destinations []serviceApi.MetricsDestination) (*collectorExporters, error) {
+	res := &collectorExporters{
+		signal:     signal,
+		Exporters:  map[string]any{},
+		Extensions: map[string]any{},
+		Processors: map[string]any{},
+	}
+
+	var secrets []string
+	mountSecret := func(name string, key string) string {
+		if !slices.Contains(secrets, name) {
+			secrets = append(secrets, name)
+		}
+		return path.Join(collectorSecretsPath, name, key)
+	}
+
+	for _, d := range destinations {
+		exporterName := MetricsDestinationExporterName(d)
+		if isReservedExporterName(exporterName) {
+			return nil, fmt.Errorf("destination '%s' exporter name '%s' is reserved and cannot be used", d.Name, exporterName)
+		}
+
+		cfg := map[string]any{}
+		if d.Type == serviceApi.MetricsDestinationKafka {
+			cfg["brokers"] = toAnySlice(d.Kafka.Brokers)
+			if d.Kafka.Topic != "" {
+				cfg["topic"] = d.Kafka.Topic
+			}
+			if d.Kafka.Encoding != "" {
+				cfg["encoding"] = d.Kafka.Encoding
+			}
+		} else {
+			cfg["endpoint"] = d.Endpoint
+		}
+
+		if d.Type == serviceApi.MetricsDestinationPrometheusRemoteWrite {
+			// by default resource attributes are dropped
+			cfg["resource_to_telemetry_conversion"] = map[string]any{"enabled": true}
+		}
+
+		if len(d.Headers) > 0 {
+			headers := make(map[string]any, len(d.Headers))
+			for k, v := range d.Headers {
+				headers[k] = v
+			}
+			cfg["headers"] = headers
+		}
+
+		if d.TLS != nil {
+			tls := map[string]any{}
+			if d.TLS.Insecure {
+				tls["insecure"] = true
+			}
+			if d.TLS.InsecureSkipVerify {
+				tls["insecure_skip_verify"] = true
+			}
+			if d.TLS.CASecret != nil {
+				tls["ca_file"] = mountSecret(d.TLS.CASecret.Name, d.TLS.CASecret.Key)
+			}
+			if d.TLS.ClientCertSecret != "" {
+				tls["cert_file"] = mountSecret(d.TLS.ClientCertSecret, corev1.TLSCertKey)
+				tls["key_file"] = mountSecret(d.TLS.ClientCertSecret, corev1.TLSPrivateKeyKey)
+			}
+			cfg["tls"] = tls
+		}
+
+		if d.Auth != nil {
+			switch {
+			case d.Type == serviceApi.MetricsDestinationKafka && d.Auth.Basic != nil:
+				cfg["auth"] = map[string]any{
+					"sasl": map[string]any{
+						"username":  d.Auth.Basic.Username,
+						"password":  res.secretEnv(d, "PASSWORD", d.Auth.Basic.PasswordSecret),
+						"mechanism": "PLAIN",
+					},
+				}
+			case d.Auth.BearerTokenSecret != nil:
+				extension := "bearertokenauth/" + d.Name
+				res.Extensions[extension] = map[string]any{
+					"token": res.secretEnv(d, "TOKEN", *d.Auth.BearerTokenSecret),
+				}
+				cfg["auth"] = map[string]any{"authenticator": extension}
+			case d.Auth.Basic != nil:
+				extension := "basicauth/" + d.Name
+				res.Extensions[extension] = map[string]any{
+					"client_auth": map[string]any{
+						"username": d.Auth.Basic.Username,
+						"password": res.secretEnv(d, "PASSWORD", d.Auth.Basic.PasswordSecret),
+					},
+				}
+				cfg["auth"] = map[string]any{"authenticator": extension}
+			}
+		}
+
+		res.Exporters[exporterName] = cfg
+
+		if d.Filter == nil {
+			res.PipelineExporters = append(res.PipelineExporters, exporterName)
+			continue
+		}
+
+		processor := "filter/" + d.Name
+		res.Processors[processor] = newMetricsFilterProcessor(d.Filter)
+		res.Pipelines = append(res.Pipelines, map[string]string{
+			"Name":      "metrics/" + d.Name,
+			"Processor": processor,
+			"Exporter":  exporterName,
+		})
+	}
+
+	slices.Sort(secrets)
+	for i, name := range secrets {
+		volume := fmt.Sprintf("%s-destination-secret-%d", signal, i)
+		res.Volumes = append(res.Volumes, corev1.Volume{
+			Name: volume,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: name},
+			},
+		})
+		res.VolumeMounts = append(res.VolumeMounts, corev1.VolumeMount{
+			Name:      volume,
+			MountPath: path.Join(collectorSecretsPath, name),
+			ReadOnly:  true,
+		})
+	}
+
+	return res, nil
+}
+
+// secretEnv adds an environment variable to the collector holding the value of a Secret key, and returns
+// the reference to the variable to use in the collector configuration.
+func (c *collectorExporters) secretEnv(d serviceApi.MetricsDestination, suffix string, ref serviceApi.SecretKeyReference) string {
+	name := strings.ToUpper(strings.ReplaceAll(c.signal+"_DESTINATION_"+d.Name+"_"+suffix, "-", "_"))
+
+	c.Env = append(c.Env, corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+				Key:                  ref.Key,
+			},
+		},
+	})
+
+	return "${env:" + name + "}"
+}
+
+// newMetricsFilterProcessor returns a filter processor dropping the data points of the metrics not matching
+// the filter.
+func newMetricsFilterProcessor(filter *serviceApi.MetricsFilter) map[string]any {
+	var conditions []any
+	if len(filter.IncludeComponents) > 0 {
+		conditions = append(conditions, fmt.Sprintf(`not IsMatch(attributes["%s"], "^(%s)$")`,
+			metricComponentLabel, strings.Join(filter.IncludeComponents, "|")))
+	}
+	if len(filter.ExcludeComponents) > 0 {
+		conditions = append(conditions, fmt.Sprintf(`IsMatch(attributes["%s"], "^(%s)$")`,
+			metricComponentLabel, strings.Join(filter.ExcludeComponents, "|")))
+	}
+
+	return map[string]any{
+		"error_mode": "ignore",
+		"metrics": map[string]any{
+			"datapoint": conditions,
+		},
+	}
+}
+
+// MetricsDestinationExporterName returns the name of the OpenTelemetry Collector exporter rendered for a
+// metrics destination.
+func MetricsDestinationExporterName(d serviceApi.MetricsDestination) string {
+	typ := string(d.Type)
+	if d.Type == serviceApi.MetricsDestinationOTLPGRPC {
+		typ = "otlp"
+	}
+
+	return typ + "/" + d.Name
+}
@@ -0,0 +1,265 @@
+//nolint:testpackage // Need to test unexported functions
+package monitoring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/template"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+)
+
+func newTestDestinations() []serviceApi.MetricsDestination {
+	return []serviceApi.MetricsDestination{
+		{
+			Name:     "thanos",
+			Type:     serviceApi.MetricsDestinationPrometheusRemoteWrite,
+			Endpoint: "https://thanos.example.com/api/v1/receive",
+			TLS: &serviceApi.ExporterTLS{
+				CASecret:         &serviceApi.SecretKeyReference{Name: "thanos-ca", Key: "ca.crt"},
+				ClientCertSecret: "thanos-client",
+			},
+			Auth: &serviceApi.ExporterAuth{
+				BearerTokenSecret: &serviceApi.SecretKeyReference{Name: "thanos-token", Key: "token"},
+			},
+			Headers: map[string]string{"X-Tenant": "ai"},
+		},
+		{
+			Name:     "vendor-backend",
+			Type:     serviceApi.MetricsDestinationOTLPGRPC,
+			Endpoint: "otlp.vendor.example.com:4317",
+			Auth: &serviceApi.ExporterAuth{
+				Basic: &serviceApi.BasicAuth{
+					Username:       "ai",
+					PasswordSecret: serviceApi.SecretKeyReference{Name: "vendor", Key: "password"},
+				},
+			},
+			Filter: &serviceApi.MetricsFilter{IncludeComponents: []string{"kserve", "kueue"}},
+		},
+		{
+			Name: "events",
+			Type: serviceApi.MetricsDestinationKafka,
+			Kafka: &serviceApi.KafkaDestination{
+				Brokers:  []string{"kafka-0:9092", "kafka-1:9092"},
+				Topic:    "otlp_metrics",
+				Encoding: "otlp_proto",
+			},
+			TLS: &serviceApi.ExporterTLS{
+				CASecret: &serviceApi.SecretKeyReference{Name: "thanos-ca", Key: "ca.crt"},
+			},
+			Auth: &serviceApi.ExporterAuth{
+				Basic: &serviceApi.BasicAuth{
+					Username:       "ai",
+					PasswordSecret: serviceApi.SecretKeyReference{Name: "kafka", Key: "password"},
+				},
+			},
+		},
+	}
+}
+
+func TestNewCollectorExporters(t *testing.T) {
+	res, err := newCollectorExporters("metrics", newTestDestinations())
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{
+		"prometheusremotewrite/thanos": map[string]any{
+			"endpoint":                         "https://thanos.example.com/api/v1/receive",
+			"resource_to_telemetry_conversion": map[string]any{"enabled": true},
+			"headers":                          map[string]any{"X-Tenant": "ai"},
+			"tls": map[string]any{
+				"ca_file":   "/etc/otelcol/secrets/thanos-ca/ca.crt",
+				"cert_file": "/etc/otelcol/secrets/thanos-client/tls.crt",
+				"key_file":  "/etc/otelcol/secrets/thanos-client/tls.key",
+			},
+			"auth": map[string]any{"authenticator": "bearertokenauth/thanos"},
+		},
+		"otlp/vendor-backend": map[string]any{
+			"endpoint": "otlp.vendor.example.com:4317",
+			"auth":     map[string]any{"authenticator": "basicauth/vendor-backend"},
+		},
+		"kafka/events": map[string]any{
+			"brokers":  []any{"kafka-0:9092", "kafka-1:9092"},
+			"topic":    "otlp_metrics",
+			"encoding": "otlp_proto",
+			"tls":      map[string]any{"ca_file": "/etc/otelcol/secrets/thanos-ca/ca.crt"},
+			"auth": map[string]any{
+				"sasl": map[string]any{
+					"username":  "ai",
+					"password":  "${env:METRICS_DESTINATION_EVENTS_PASSWORD}",
+					"mechanism": "PLAIN",
+				},
+			},
+		},
+	}, res.Exporters)
+
+	assert.Equal(t, map[string]any{
+		"bearertokenauth/thanos": map[string]any{"token": "${env:METRICS_DESTINATION_THANOS_TOKEN}"},
+		"basicauth/vendor-backend": map[string]any{
+			"client_auth": map[string]any{
+				"username": "ai",
+				"password": "${env:METRICS_DESTINATION_VENDOR_BACKEND_PASSWORD}",
+			},
+		},
+	}, res.Extensions)
+
+	// the filtered destination gets its own pipeline
+	assert.Equal(t, []string{"prometheusremotewrite/thanos", "kafka/events"}, res.PipelineExporters)
+	assert.Equal(t, []map[string]string{
+		{"Name": "metrics/vendor-backend", "Processor": "filter/vendor-backend", "Exporter": "otlp/vendor-backend"},
+	}, res.Pipelines)
+	assert.Equal(t, map[string]any{
+		"filter/vendor-backend": map[string]any{
+			"error_mode": "ignore",
+			"metrics": map[string]any{
+				"datapoint": []any{`not IsMatch(attributes["component"], "^(kserve|kueue)$")`},
+			},
+		},
+	}, res.Processors)
+
+	envNames := make([]string, 0, len(res.Env))
+	for _, e := range res.Env {
+		envNames = append(envNames, e.Name)
+	}
+	assert.Equal(t, []string{
+		"METRICS_DESTINATION_THANOS_TOKEN",
+		"METRICS_DESTINATION_VENDOR_BACKEND_PASSWORD",
+		"METRICS_DESTINATION_EVENTS_PASSWORD",
+	}, envNames)
+	assert.Equal(t, &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "thanos-token"},
+		Key:                  "token",
+	}, res.Env[0].ValueFrom.SecretKeyRef)
+
+	// the Secrets shared by several destinations are mounted once
+	require.Len(t, res.Volumes, 2)
+	assert.Equal(t, "thanos-ca", res.Volumes[0].Secret.SecretName)
+	assert.Equal(t, "thanos-client", res.Volumes[1].Secret.SecretName)
+	assert.Equal(t, []corev1.VolumeMount{
+		{Name: "metrics-destination-secret-0", MountPath: "/etc/otelcol/secrets/thanos-ca", ReadOnly: true},
+		{Name: "metrics-destination-secret-1", MountPath: "/etc/otelcol/secrets/thanos-client", ReadOnly: true},
+	}, res.VolumeMounts)
+}
+
+func TestNewMetricsFilterProcessor(t *testing.T) {
+	p := newMetricsFilterProcessor(&serviceApi.MetricsFilter{
+		IncludeComponents: []string{"kserve"},
+		ExcludeComponents: []string{"dashboard", "workbenches"},
+	})
+
+	assert.Equal(t, []any{
+		`not IsMatch(attributes["component"], "^(kserve)$")`,
+		`IsMatch(attributes["component"], "^(dashboard|workbenches)$")`,
+	}, p["metrics"].(map[string]any)["datapoint"])
+}
+
+func TestGetTemplateDataMetricsDestinations(t *testing.T) {
+	newRequest := func(metrics *serviceApi.Metrics) *odhtypes.ReconciliationRequest {
+		return &odhtypes.ReconciliationRequest{
+			Instance: &serviceApi.Monitoring{
+				Spec: serviceApi.MonitoringSpec{
+					MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+						Namespace: "test-namespace",
+						Metrics:   metrics,
+					},
+				},
+			},
+			DSCI: &dsciv1.DSCInitialization{
+				Spec: dsciv1.DSCInitializationSpec{
+					ApplicationsNamespace: "test-app-namespace",
+				},
+			},
+		}
+	}
+
+	data, err := getTemplateData(t.Context(), newRequest(&serviceApi.Metrics{
+		Exporters:    map[string]string{"debug": "verbosity: basic"},
+		Destinations: newTestDestinations(),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"debug", "kafka/events", "prometheusremotewrite/thanos"}, data["MetricsExporterNames"])
+	assert.Len(t, data["MetricsExporters"], 4)
+	assert.Equal(t, []string{"basicauth/vendor-backend", "bearertokenauth/thanos"}, data["MetricsExtensionNames"])
+	assert.Equal(t, []string{"filter/vendor-backend"}, data["MetricsProcessorNames"])
+
+	_, err = getTemplateData(t.Context(), newRequest(&serviceApi.Metrics{
+		Exporters: map[string]string{"otlp/vendor-backend": "endpoint: otlp.vendor.example.com:4317"},
+		Destinations: []serviceApi.MetricsDestination{
+			{Name: "vendor-backend", Type: serviceApi.MetricsDestinationOTLPGRPC, Endpoint: "otlp.vendor.example.com:4317"},
+		},
+	}))
+	require.ErrorContains(t, err, "defined by both exporters and destinations")
+
+	_, err = getTemplateData(t.Context(), newRequest(&serviceApi.Metrics{
+		Destinations: []serviceApi.MetricsDestination{
+			{Name: "tempo", Type: serviceApi.MetricsDestinationOTLPGRPC, Endpoint: "tempo:4317"},
+		},
+	}))
+	require.ErrorContains(t, err, "reserved")
+}
+
+// TestRenderCollectorMetricsDestinations renders the collector with metrics destinations, and checks that the
+// configuration references the rendered exporters, extensions and processors.
+func TestRenderCollectorMetricsDestinations(t *testing.T) {
+	cl, err := fakeclient.New()
+	require.NoError(t, err)
+
+	rr := &odhtypes.ReconciliationRequest{
+		Client: cl,
+		Instance: &serviceApi.Monitoring{
+			ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+			Spec: serviceApi.MonitoringSpec{
+				MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+					Namespace: "test-namespace",
+					Metrics:   &serviceApi.Metrics{Destinations: newTestDestinations()},
+				},
+			},
+		},
+		DSCI: &dsciv1.DSCInitialization{
+			Spec: dsciv1.DSCInitializationSpec{
+				ApplicationsNamespace: "test-app-namespace",
+			},
+		},
+		Templates: []odhtypes.TemplateInfo{{FS: resourcesFS, Path: OpenTelemetryCollectorTemplate}},
+	}
+
+	action := template.NewAction(template.WithCache(false), template.WithDataFn(getTemplateData))
+	require.NoError(t, action(t.Context(), rr))
+	require.Len(t, rr.Resources, 1)
+
+	collector := rr.Resources[0].Object
+
+	extensions, _, err := unstructured.NestedStringSlice(collector, "spec", "config", "service", "extensions")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bearertokenauth", "basicauth/vendor-backend", "bearertokenauth/thanos"}, extensions)
+
+	exporters, _, err := unstructured.NestedStringSlice(collector, "spec", "config", "service", "pipelines", "metrics", "exporters")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"prometheus", "kafka/events", "prometheusremotewrite/thanos"}, exporters)
+
+	pipeline, _, err := unstructured.NestedMap(collector, "spec", "config", "service", "pipelines", "metrics/vendor-backend")
+	require.NoError(t, err)
+	assert.Equal(t, []any{"memory_limiter", "k8sattributes", "resourcedetection", "filter/vendor-backend", "batch"}, pipeline["processors"])
+	assert.Equal(t, []any{"otlp/vendor-backend"}, pipeline["exporters"])
+
+	for _, name := range []string{"kafka/events", "prometheusremotewrite/thanos", "otlp/vendor-backend"} {
+		_, found, err := unstructured.NestedMap(collector, "spec", "config", "exporters", name)
+		require.NoError(t, err)
+		assert.True(t, found, "exporter %s not rendered", name)
+	}
+
+	env, _, err := unstructured.NestedSlice(collector, "spec", "env")
+	require.NoError(t, err)
+	assert.Len(t, env, 3)
+
+	mounts, _, err := unstructured.NestedSlice(collector, "spec", "volumeMounts")
+	require.NoError(t, err)
+	assert.Len(t, mounts, 2)
+}
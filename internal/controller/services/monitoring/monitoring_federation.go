@@ -0,0 +1,149 @@
+package monitoring
+
+import (
+	"os"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+)
+
+const (
+	// clusterLabel is the label identifying the cluster the metrics sent to the remote-write targets come from.
+	clusterLabel = "cluster"
+	// oauthProxyImageEnv is the environment variable holding the image of the proxy protecting the federation
+	// endpoint.
+	oauthProxyImageEnv = "RELATED_IMAGE_OSE_OAUTH_PROXY_IMAGE"
+	// defaultOAuthProxyImage is the image of the proxy when oauthProxyImageEnv is not set.
+	defaultOAuthProxyImage = "registry.redhat.io/openshift4/ose-oauth-proxy@sha256:4bef31eb993feb6f1096b51b4876c65a6fb1f4401fee97fa4f4542b6b7c9bc46"
+)
+
+// federationTemplateData returns the template data of the remote-write targets of the MonitoringStack, and of the
+// proxy exposing its federation endpoint.
+func federationTemplateData(metrics *serviceApi.Metrics, clusterID string) map[string]any {
+	data := map[string]any{
+		"RemoteWrite":     remoteWriteConfig(metrics.RemoteWrite, clusterID),
+		"Federation":      metrics.Federation != nil,
+		"FederationHost":  "",
+		"OAuthProxyImage": defaultOAuthProxyImage,
+	}
+
+	if metrics.Federation != nil {
+		data["FederationHost"] = metrics.Federation.Host
+	}
+	if image := os.Getenv(oauthProxyImageEnv); image != "" {
+		data["OAuthProxyImage"] = image
+	}
+
+	return data
+}
+
+// remoteWriteConfig returns the remote-write configuration of the Prometheus of the MonitoringStack. The samples
+// are labelled with the ID of the cluster, when known, after the relabeling rules of the target.
+func remoteWriteConfig(targets []serviceApi.RemoteWriteTarget, clusterID string) []any {
+	res := make([]any, 0, len(targets))
+
+	for _, target := range targets {
+		config := map[string]any{
+			"name": target.Name,
+			"url":  target.URL,
+		}
+
+		if auth := target.Auth; auth != nil {
+			switch {
+			case auth.BearerTokenSecret != nil:
+				config["authorization"] = map[string]any{
+					"type":        "Bearer",
+					"credentials": secretKeySelector(auth.BearerTokenSecret.Name, auth.BearerTokenSecret.Key),
+				}
+			case auth.BasicAuthSecret != "":
+				config["basicAuth"] = map[string]any{
+					"username": secretKeySelector(auth.BasicAuthSecret, "username"),
+					"password": secretKeySelector(auth.BasicAuthSecret, "password"),
+				}
+			}
+		}
+
+		if tls := target.TLS; tls != nil {
+			tlsConfig := map[string]any{}
+			if tls.InsecureSkipVerify {
+				tlsConfig["insecureSkipVerify"] = true
+			}
+			if tls.CASecret != nil {
+				tlsConfig["ca"] = map[string]any{"secret": secretKeySelector(tls.CASecret.Name, tls.CASecret.Key)}
+			}
+			if tls.ClientCertSecret != "" {
+				tlsConfig["cert"] = map[string]any{"secret": secretKeySelector(tls.ClientCertSecret, "tls.crt")}
+				tlsConfig["keySecret"] = secretKeySelector(tls.ClientCertSecret, "tls.key")
+			}
+			config["tlsConfig"] = tlsConfig
+		}
+
+		if queue := target.QueueConfig; queue != nil {
+			queueConfig := map[string]any{}
+			for k, v := range map[string]int32{
+				"capacity":          queue.Capacity,
+				"minShards":         queue.MinShards,
+				"maxShards":         queue.MaxShards,
+				"maxSamplesPerSend": queue.MaxSamplesPerSend,
+			} {
+				if v != 0 {
+					queueConfig[k] = v
+				}
+			}
+			if queue.BatchSendDeadline != nil {
+				queueConfig["batchSendDeadline"] = queue.BatchSendDeadline.Duration.String()
+			}
+			config["queueConfig"] = queueConfig
+		}
+
+		relabelConfigs := make([]any, 0, len(target.WriteRelabelConfigs)+1)
+		for _, rc := range target.WriteRelabelConfigs {
+			relabelConfigs = append(relabelConfigs, relabelConfig(rc))
+		}
+		if clusterID != "" {
+			relabelConfigs = append(relabelConfigs, relabelConfig(serviceApi.RelabelConfig{
+				TargetLabel: clusterLabel,
+				Replacement: clusterID,
+				Action:      "replace",
+			}))
+		}
+		if len(relabelConfigs) > 0 {
+			config["writeRelabelConfigs"] = relabelConfigs
+		}
+
+		res = append(res, config)
+	}
+
+	return res
+}
+
+// relabelConfig returns a Prometheus relabeling rule, omitting its unset fields.
+func relabelConfig(rc serviceApi.RelabelConfig) map[string]any {
+	res := map[string]any{}
+	if len(rc.SourceLabels) > 0 {
+		sourceLabels := make([]any, 0, len(rc.SourceLabels))
+		for _, l := range rc.SourceLabels {
+			sourceLabels = append(sourceLabels, l)
+		}
+		res["sourceLabels"] = sourceLabels
+	}
+	for k, v := range map[string]string{
+		"regex":       rc.Regex,
+		"targetLabel": rc.TargetLabel,
+		"replacement": rc.Replacement,
+		"action":      rc.Action,
+	} {
+		if v != "" {
+			res[k] = v
+		}
+	}
+
+	return res
+}
+
+// secretKeySelector returns a reference to a key of a Secret of the monitoring namespace.
+func secretKeySelector(name string, key string) map[string]any {
+	return map[string]any{
+		"name": name,
+		"key":  key,
+	}
+}
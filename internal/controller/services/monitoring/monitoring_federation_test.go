@@ -0,0 +1,138 @@
+//nolint:testpackage // Need to test unexported functions
+package monitoring
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/template"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+)
+
+func newTestRemoteWrite() []serviceApi.RemoteWriteTarget {
+	return []serviceApi.RemoteWriteTarget{
+		{
+			Name: "central",
+			URL:  "https://thanos.example.com/api/v1/receive",
+			Auth: &serviceApi.RemoteWriteAuth{
+				BearerTokenSecret: &serviceApi.SecretKeyReference{Name: "central-token", Key: "token"},
+			},
+			TLS: &serviceApi.ExporterTLS{
+				CASecret:         &serviceApi.SecretKeyReference{Name: "central-ca", Key: "ca.crt"},
+				ClientCertSecret: "central-client",
+			},
+			QueueConfig: &serviceApi.RemoteWriteQueueConfig{
+				MaxShards:         10,
+				BatchSendDeadline: &metav1.Duration{Duration: 5 * time.Second},
+			},
+			WriteRelabelConfigs: []serviceApi.RelabelConfig{
+				{SourceLabels: []string{"__name__"}, Regex: "go_.*", Action: "drop"},
+			},
+		},
+		{
+			Name: "backup",
+			URL:  "https://backup.example.com/api/v1/write",
+			Auth: &serviceApi.RemoteWriteAuth{BasicAuthSecret: "backup-auth"},
+		},
+	}
+}
+
+func TestRemoteWriteConfig(t *testing.T) {
+	config := remoteWriteConfig(newTestRemoteWrite(), "2b7a9c1e")
+	require.Len(t, config, 2)
+
+	assert.Equal(t, map[string]any{
+		"name": "central",
+		"url":  "https://thanos.example.com/api/v1/receive",
+		"authorization": map[string]any{
+			"type":        "Bearer",
+			"credentials": map[string]any{"name": "central-token", "key": "token"},
+		},
+		"tlsConfig": map[string]any{
+			"ca":        map[string]any{"secret": map[string]any{"name": "central-ca", "key": "ca.crt"}},
+			"cert":      map[string]any{"secret": map[string]any{"name": "central-client", "key": "tls.crt"}},
+			"keySecret": map[string]any{"name": "central-client", "key": "tls.key"},
+		},
+		"queueConfig": map[string]any{
+			"maxShards":         int32(10),
+			"batchSendDeadline": "5s",
+		},
+		// the cluster label is added after the relabeling rules of the target
+		"writeRelabelConfigs": []any{
+			map[string]any{"sourceLabels": []any{"__name__"}, "regex": "go_.*", "action": "drop"},
+			map[string]any{"targetLabel": clusterLabel, "replacement": "2b7a9c1e", "action": "replace"},
+		},
+	}, config[0])
+
+	assert.Equal(t, map[string]any{
+		"username": map[string]any{"name": "backup-auth", "key": "username"},
+		"password": map[string]any{"name": "backup-auth", "key": "password"},
+	}, config[1].(map[string]any)["basicAuth"])
+
+	t.Run("unknown cluster", func(t *testing.T) {
+		config := remoteWriteConfig(newTestRemoteWrite()[1:], "")
+		require.Len(t, config, 1)
+		assert.NotContains(t, config[0], "writeRelabelConfigs")
+	})
+}
+
+// TestRenderFederation renders the MonitoringStack with remote-write targets, and the proxy exposing its
+// federation endpoint.
+func TestRenderFederation(t *testing.T) {
+	cl, err := fakeclient.New()
+	require.NoError(t, err)
+
+	rr := &odhtypes.ReconciliationRequest{
+		Client: cl,
+		Instance: &serviceApi.Monitoring{
+			ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+			Spec: serviceApi.MonitoringSpec{
+				MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+					Namespace: "test-namespace",
+					Metrics: &serviceApi.Metrics{
+						RemoteWrite: newTestRemoteWrite(),
+						Federation:  &serviceApi.MetricsFederation{Host: "federate.apps.example.com"},
+					},
+				},
+			},
+		},
+		DSCI: &dsciv1.DSCInitialization{
+			Spec: dsciv1.DSCInitializationSpec{
+				ApplicationsNamespace: "test-app-namespace",
+			},
+		},
+		Templates: []odhtypes.TemplateInfo{
+			{FS: resourcesFS, Path: MonitoringStackTemplate},
+			{FS: resourcesFS, Path: PrometheusFederationTemplate},
+		},
+	}
+
+	action := template.NewAction(template.WithCache(false), template.WithDataFn(getTemplateData))
+	require.NoError(t, action(t.Context(), rr))
+	require.Len(t, rr.Resources, 7)
+
+	remoteWrite, _, err := unstructured.NestedSlice(rr.Resources[0].Object, "spec", "prometheusConfig", "remoteWrite")
+	require.NoError(t, err)
+	require.Len(t, remoteWrite, 2)
+	assert.Equal(t, "https://thanos.example.com/api/v1/receive", remoteWrite[0].(map[string]any)["url"])
+
+	route := rr.Resources[6]
+	assert.Equal(t, gvk.Route.Kind, route.GetKind())
+
+	host, _, err := unstructured.NestedString(route.Object, "spec", "host")
+	require.NoError(t, err)
+	assert.Equal(t, "federate.apps.example.com", host)
+
+	path, _, err := unstructured.NestedString(route.Object, "spec", "path")
+	require.NoError(t, err)
+	assert.Equal(t, "/federate", path)
+}
@@ -0,0 +1,324 @@
+package monitoring
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+const (
+	// instrumentationName is the name of the Instrumentation CRs deployed by the Monitoring service.
+	instrumentationName = "data-science-instrumentation"
+	// instrumentationFieldOwner is the field manager of the injection annotations set on the instrumented workloads.
+	instrumentationFieldOwner = "monitoring.opendatahub.io/instrumentation"
+	// instrumentedLabel marks the namespaces and the Deployments holding injection annotations.
+	instrumentedLabel = "monitoring.opendatahub.io/instrumented"
+	// instrumentedDeploymentsFieldOwner is the field manager of the label marking the namespaces holding instrumented
+	// Deployments, distinct from instrumentationFieldOwner so that both can be applied to the same namespace.
+	instrumentedDeploymentsFieldOwner = "monitoring.opendatahub.io/instrumented-deployments"
+	// instrumentedDeploymentsLabel marks the namespaces holding instrumented Deployments, so that the Deployments
+	// are only listed in these namespaces.
+	instrumentedDeploymentsLabel = "monitoring.opendatahub.io/instrumented-deployments"
+	// injectAnnotationPrefix is the prefix of the annotations requesting the OpenTelemetry operator to inject the
+	// auto-instrumentation of a language.
+	injectAnnotationPrefix = "instrumentation.opentelemetry.io/inject-"
+)
+
+// instrumentedWorkload is a namespace, or a Deployment, whose pods get the auto-instrumentation injected.
+type instrumentedWorkload struct {
+	Kind schema.GroupVersionKind
+	Key  client.ObjectKey
+}
+
+// workloadInstrumentation sets the injection annotations on the namespaces and the Deployments targeted by the
+// traces instrumentation. The annotations are applied with a dedicated field manager, so that they are removed
+// from the workloads which are not targeted anymore by applying an empty object with the same field manager.
+//
+// The Deployments of the target namespaces are not watched, a Deployment created after the reconciliation of the
+// Monitoring service, and matching a workload selector, is instrumented at the next reconciliation.
+type workloadInstrumentation struct {
+	// reader reads the Deployments of the target namespaces, which are not cached by the operator
+	reader client.Reader
+}
+
+// newWorkloadInstrumentationActions returns the action setting the injection annotations, and the finalizer
+// removing them.
+func newWorkloadInstrumentationActions(reader client.Reader) (actions.Fn, actions.Fn) {
+	a := &workloadInstrumentation{reader: reader}
+	return a.run, a.cleanup
+}
+
+func (a *workloadInstrumentation) run(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	monitoring, ok := rr.Instance.(*serviceApi.Monitoring)
+	if !ok {
+		return errors.New("instance is not of type *services.Monitoring")
+	}
+
+	desired := map[instrumentedWorkload]map[string]string{}
+	var missing []string
+
+	if traces := monitoring.Spec.Traces; traces != nil && len(traces.Instrumentation) > 0 {
+		exists, err := cluster.HasCRD(ctx, rr.Client, gvk.Instrumentation)
+		if err != nil {
+			return fmt.Errorf("failed to check if %s CRD exists: %w", gvk.Instrumentation.Kind, err)
+		}
+
+		// the annotations reference the Instrumentation CRs, which are only deployed when the CRD exists
+		if exists {
+			desired, missing, err = a.desiredWorkloads(ctx, rr.Client, traces.Instrumentation)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := a.sync(ctx, rr.Client, desired); err != nil {
+		return err
+	}
+
+	if len(missing) > 0 {
+		rr.Conditions.MarkFalse(
+			status.ConditionInstrumentationAvailable,
+			conditions.WithReason(status.InstrumentationNamespaceNotFoundReason),
+			conditions.WithMessage("Instrumented namespaces not found: %s", strings.Join(missing, ", ")),
+		)
+	}
+
+	return nil
+}
+
+func (a *workloadInstrumentation) cleanup(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	return a.sync(ctx, rr.Client, nil)
+}
+
+// desiredWorkloads returns the injection annotations of the namespaces, and of the Deployments, targeted by the
+// instrumentation, with the target namespaces which do not exist.
+func (a *workloadInstrumentation) desiredWorkloads(
+	ctx context.Context,
+	cli client.Client,
+	targets []serviceApi.InstrumentationTarget,
+) (map[instrumentedWorkload]map[string]string, []string, error) {
+	res := map[instrumentedWorkload]map[string]string{}
+	var missing []string
+
+	for _, target := range targets {
+		// the namespaces are checked first, applying the annotations would create them
+		err := cli.Get(ctx, client.ObjectKey{Name: target.Namespace}, &corev1.Namespace{})
+		if k8serr.IsNotFound(err) {
+			missing = append(missing, target.Namespace)
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get namespace %s: %w", target.Namespace, err)
+		}
+
+		annotations := injectAnnotations(target.Languages)
+
+		if len(target.WorkloadSelector) == 0 {
+			res[instrumentedWorkload{
+				Kind: gvk.Namespace,
+				Key:  client.ObjectKey{Name: target.Namespace},
+			}] = annotations
+
+			continue
+		}
+
+		deployments := appsv1.DeploymentList{}
+		err = a.reader.List(ctx, &deployments,
+			client.InNamespace(target.Namespace),
+			client.MatchingLabels(target.WorkloadSelector),
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list the Deployments of namespace %s: %w", target.Namespace, err)
+		}
+
+		for _, d := range deployments.Items {
+			res[instrumentedWorkload{
+				Kind: gvk.Deployment,
+				Key:  client.ObjectKeyFromObject(&d),
+			}] = annotations
+		}
+	}
+
+	return res, missing, nil
+}
+
+// sync applies the injection annotations of the desired workloads, and removes them from the workloads which are
+// not desired anymore. The namespaces of the desired Deployments are marked before the Deployments are annotated,
+// and unmarked once the annotations are removed, so that no instrumented Deployment is left untracked.
+func (a *workloadInstrumentation) sync(ctx context.Context, cli client.Client, desired map[instrumentedWorkload]map[string]string) error {
+	desiredNamespaces := map[string]struct{}{}
+	for w := range desired {
+		if w.Kind == gvk.Deployment {
+			desiredNamespaces[w.Key.Namespace] = struct{}{}
+		}
+	}
+
+	for _, ns := range slices.Sorted(maps.Keys(desiredNamespaces)) {
+		if err := applyInstrumentedDeploymentsLabel(ctx, cli, ns, true); err != nil {
+			return err
+		}
+	}
+
+	current, namespaces, err := a.instrumentedWorkloads(ctx, cli)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range current {
+		if _, ok := desired[w]; ok {
+			continue
+		}
+
+		logf.FromContext(ctx).V(3).Info("removing instrumentation", "kind", w.Kind.Kind, "key", w.Key)
+
+		if err := applyInjectAnnotations(ctx, cli, w, nil); err != nil {
+			return err
+		}
+	}
+
+	for _, w := range slices.SortedFunc(maps.Keys(desired), compareWorkloads) {
+		if err := applyInjectAnnotations(ctx, cli, w, desired[w]); err != nil {
+			return err
+		}
+	}
+
+	for _, ns := range namespaces {
+		if _, ok := desiredNamespaces[ns]; ok {
+			continue
+		}
+
+		if err := applyInstrumentedDeploymentsLabel(ctx, cli, ns, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// instrumentedWorkloads returns the namespaces and the Deployments currently holding injection annotations, with
+// the namespaces marked as holding instrumented Deployments. The namespaces are read from the cache, and the
+// Deployments, which are not cached, are only listed in the marked namespaces.
+func (a *workloadInstrumentation) instrumentedWorkloads(ctx context.Context, cli client.Client) ([]instrumentedWorkload, []string, error) {
+	var res []instrumentedWorkload
+
+	namespaces := corev1.NamespaceList{}
+	if err := cli.List(ctx, &namespaces, client.HasLabels{instrumentedLabel}); err != nil {
+		return nil, nil, fmt.Errorf("failed to list instrumented namespaces: %w", err)
+	}
+	for _, ns := range namespaces.Items {
+		res = append(res, instrumentedWorkload{Kind: gvk.Namespace, Key: client.ObjectKey{Name: ns.Name}})
+	}
+
+	marked := corev1.NamespaceList{}
+	if err := cli.List(ctx, &marked, client.HasLabels{instrumentedDeploymentsLabel}); err != nil {
+		return nil, nil, fmt.Errorf("failed to list the namespaces holding instrumented Deployments: %w", err)
+	}
+
+	markedNames := make([]string, 0, len(marked.Items))
+	for _, ns := range marked.Items {
+		markedNames = append(markedNames, ns.Name)
+
+		deployments := appsv1.DeploymentList{}
+		err := a.reader.List(ctx, &deployments, client.InNamespace(ns.Name), client.HasLabels{instrumentedLabel})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list the instrumented Deployments of namespace %s: %w", ns.Name, err)
+		}
+		for _, d := range deployments.Items {
+			res = append(res, instrumentedWorkload{Kind: gvk.Deployment, Key: client.ObjectKeyFromObject(&d)})
+		}
+	}
+
+	return res, markedNames, nil
+}
+
+// applyInjectAnnotations applies the injection annotations of a workload, nil annotations removing the ones
+// previously applied. Namespaces are annotated directly, Deployments on the template of their pods.
+func applyInjectAnnotations(ctx context.Context, cli client.Client, w instrumentedWorkload, annotations map[string]string) error {
+	obj := unstructured.Unstructured{Object: map[string]any{}}
+	obj.SetGroupVersionKind(w.Kind)
+	obj.SetName(w.Key.Name)
+	obj.SetNamespace(w.Key.Namespace)
+
+	if annotations != nil {
+		obj.SetLabels(map[string]string{instrumentedLabel: "true"})
+
+		values := make(map[string]any, len(annotations))
+		for k, v := range annotations {
+			values[k] = v
+		}
+
+		path := []string{"metadata", "annotations"}
+		if w.Kind == gvk.Deployment {
+			path = []string{"spec", "template", "metadata", "annotations"}
+		}
+
+		if err := unstructured.SetNestedMap(obj.Object, values, path...); err != nil {
+			return err
+		}
+	}
+
+	err := cli.Patch(ctx, &obj, client.Apply, client.FieldOwner(instrumentationFieldOwner), client.ForceOwnership)
+	if err != nil {
+		return fmt.Errorf("failed to apply the instrumentation of %s %s: %w", w.Kind.Kind, w.Key, err)
+	}
+
+	return nil
+}
+
+// applyInstrumentedDeploymentsLabel marks, or unmarks, a namespace as holding instrumented Deployments.
+func applyInstrumentedDeploymentsLabel(ctx context.Context, cli client.Client, namespace string, marked bool) error {
+	obj := unstructured.Unstructured{Object: map[string]any{}}
+	obj.SetGroupVersionKind(gvk.Namespace)
+	obj.SetName(namespace)
+
+	if marked {
+		obj.SetLabels(map[string]string{instrumentedDeploymentsLabel: "true"})
+	}
+
+	err := cli.Patch(ctx, &obj, client.Apply, client.FieldOwner(instrumentedDeploymentsFieldOwner), client.ForceOwnership)
+	if err != nil {
+		return fmt.Errorf("failed to apply the %s label of namespace %s: %w", instrumentedDeploymentsLabel, namespace, err)
+	}
+
+	return nil
+}
+
+// injectAnnotations returns the annotations injecting the auto-instrumentation of the given languages, configured
+// by the Instrumentation CR of the namespace.
+func injectAnnotations(languages []serviceApi.InstrumentationLanguage) map[string]string {
+	res := make(map[string]string, len(languages))
+	for _, l := range languages {
+		res[injectAnnotationPrefix+string(l)] = instrumentationName
+	}
+
+	return res
+}
+
+// compareWorkloads orders the workloads by kind, namespace and name.
+func compareWorkloads(a instrumentedWorkload, b instrumentedWorkload) int {
+	return cmp.Or(
+		strings.Compare(a.Kind.Kind, b.Kind.Kind),
+		strings.Compare(a.Key.String(), b.Key.String()),
+	)
+}
@@ -0,0 +1,120 @@
+//nolint:testpackage // Need to test unexported functions
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+)
+
+func TestDesiredWorkloads(t *testing.T) {
+	cl, err := fakeclient.New(fakeclient.WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+			Name:      "predictor",
+			Namespace: "team-b",
+			Labels:    map[string]string{"app": "inference"},
+		}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+			Name:      "ui",
+			Namespace: "team-b",
+			Labels:    map[string]string{"app": "ui"},
+		}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+			Name:      "predictor",
+			Namespace: "team-c",
+			Labels:    map[string]string{"app": "inference"},
+		}},
+	))
+	require.NoError(t, err)
+
+	a := &workloadInstrumentation{reader: cl}
+
+	targets := append(newTestTraces().Instrumentation, serviceApi.InstrumentationTarget{
+		Namespace: "team-d",
+		Languages: []serviceApi.InstrumentationLanguage{serviceApi.InstrumentationLanguageJava},
+	})
+
+	desired, missing, err := a.desiredWorkloads(t.Context(), cl, targets)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"team-d"}, missing)
+
+	assert.Equal(t, map[instrumentedWorkload]map[string]string{
+		{Kind: gvk.Namespace, Key: client.ObjectKey{Name: "team-a"}}: {
+			"instrumentation.opentelemetry.io/inject-java": instrumentationName,
+		},
+		{Kind: gvk.Deployment, Key: client.ObjectKey{Namespace: "team-b", Name: "predictor"}}: {
+			"instrumentation.opentelemetry.io/inject-python": instrumentationName,
+		},
+	}, desired)
+}
+
+func TestSyncWorkloads(t *testing.T) {
+	var applied []string
+
+	cl, err := fakeclient.New(
+		fakeclient.WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Name:   "team-c",
+				Labels: map[string]string{instrumentedDeploymentsLabel: "true"},
+			}},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+				Name:      "predictor",
+				Namespace: "team-c",
+				Labels:    map[string]string{instrumentedLabel: "true"},
+			}},
+		),
+		fakeclient.WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(_ context.Context, _ client.WithWatch, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+				u, ok := obj.(*unstructured.Unstructured)
+				require.True(t, ok)
+
+				applied = append(applied, fmt.Sprintf("%s %s %v", u.GetKind(), client.ObjectKeyFromObject(u), len(u.GetLabels()) > 0))
+
+				return nil
+			},
+		}),
+	)
+	require.NoError(t, err)
+
+	a := &workloadInstrumentation{reader: cl}
+
+	err = a.sync(t.Context(), cl, map[instrumentedWorkload]map[string]string{
+		{Kind: gvk.Deployment, Key: client.ObjectKey{Namespace: "team-b", Name: "predictor"}}: {
+			"instrumentation.opentelemetry.io/inject-python": instrumentationName,
+		},
+	})
+	require.NoError(t, err)
+
+	// the namespaces holding the desired Deployments are marked first, and the others unmarked last
+	assert.Equal(t, []string{
+		"Namespace /team-b true",
+		"Deployment team-c/predictor false",
+		"Deployment team-b/predictor true",
+		"Namespace /team-c false",
+	}, applied)
+}
+
+func TestInjectAnnotations(t *testing.T) {
+	assert.Equal(t, map[string]string{
+		"instrumentation.opentelemetry.io/inject-nodejs": instrumentationName,
+		"instrumentation.opentelemetry.io/inject-dotnet": instrumentationName,
+	}, injectAnnotations([]serviceApi.InstrumentationLanguage{
+		serviceApi.InstrumentationLanguageNodeJS,
+		serviceApi.InstrumentationLanguageDotNet,
+	}))
+}
@@ -0,0 +1,138 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+const (
+	// logsOTLPDestination is the name of the destination rendered for the OTLP endpoint of the logs.
+	logsOTLPDestination = "external"
+	// lokiStackExporter is the name of the exporter sending the logs to the LokiStack.
+	lokiStackExporter = "otlphttp/lokistack"
+)
+
+// componentLogLabels maps the components to the app.opendatahub.io/<name> labels set on their pods, the logs of
+// the pods with one of these labels are collected.
+var componentLogLabels = map[string][]string{
+	componentApi.DashboardComponentName:            {"dashboard", "rhods-dashboard"},
+	componentApi.WorkbenchesComponentName:          {"workbenches"},
+	componentApi.KueueComponentName:                {"kueue"},
+	componentApi.CodeFlareComponentName:            {"codeflare"},
+	componentApi.DataSciencePipelinesComponentName: {"data-science-pipelines-operator"},
+	componentApi.ModelMeshServingComponentName:     {"model-mesh"},
+	componentApi.RayComponentName:                  {"ray"},
+	componentApi.TrustyAIComponentName:             {"trustyai"},
+	componentApi.KserveComponentName:               {"kserve"},
+	componentApi.TrainingOperatorComponentName:     {"trainingoperator"},
+	componentApi.ModelRegistryComponentName:        {"model-registry-operator"},
+	componentApi.ModelControllerComponentName:      {"odh-model-controller"},
+	componentApi.FeastOperatorComponentName:        {"feastoperator"},
+	componentApi.LlamaStackOperatorComponentName:   {"llamastackoperator"},
+}
+
+// logComponentLabels returns the component labels of the collected pods, sorted by label.
+func logComponentLabels() []map[string]string {
+	var res []map[string]string
+	for _, component := range slices.Sorted(maps.Keys(componentLogLabels)) {
+		for _, name := range componentLogLabels[component] {
+			res = append(res, map[string]string{
+				"Component": component,
+				"Label":     labels.ODH.Component(name),
+			})
+		}
+	}
+
+	return res
+}
+
+// hasLogsStorage reports whether the logs are stored in a LokiStack, which requires the LokiStack CRD.
+func hasLogsStorage(ctx context.Context, rr *odhtypes.ReconciliationRequest, logs *serviceApi.Logs) (bool, error) {
+	if logs.Storage == nil {
+		return false, nil
+	}
+
+	exists, err := cluster.HasCRD(ctx, rr.Client, gvk.LokiStack)
+	if err != nil {
+		return false, fmt.Errorf("failed to check if %s CRD exists: %w", gvk.LokiStack.Kind, err)
+	}
+
+	return exists, nil
+}
+
+// logsTemplateData returns the template data of the logs collector and of the LokiStack.
+func logsTemplateData(ctx context.Context, rr *odhtypes.ReconciliationRequest, logs *serviceApi.Logs) (map[string]any, error) {
+	storage, err := hasLogsStorage(ctx, rr, logs)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]any{
+		"LogsComponentLabels": logComponentLabels(),
+		"LogsStorage":         storage,
+	}
+
+	// logs are labelled with the name of the DataScienceCluster, if any
+	dscName := ""
+	dsc, err := cluster.GetDSC(ctx, rr.Client)
+	switch {
+	case err == nil:
+		dscName = dsc.Name
+	case !k8serr.IsNotFound(err):
+		return nil, fmt.Errorf("failed to retrieve DataScienceCluster: %w", err)
+	}
+	data["DSCName"] = dscName
+
+	var exporterNames []string
+	if storage {
+		data["LogsStorageBackend"] = logs.Storage.Backend
+		data["LogsStorageSecret"] = logs.Storage.Secret
+		data["LogsStorageClassName"] = logs.Storage.StorageClassName
+		data["LogsStorageSize"] = logs.Storage.Size
+		data["LogsRetentionDays"] = logs.Storage.RetentionDays
+		exporterNames = append(exporterNames, lokiStackExporter)
+	}
+
+	var destinations []serviceApi.MetricsDestination
+	if logs.OTLP != nil {
+		typ := serviceApi.MetricsDestinationOTLPGRPC
+		if logs.OTLP.Protocol == "http" {
+			typ = serviceApi.MetricsDestinationOTLPHTTP
+		}
+		destinations = append(destinations, serviceApi.MetricsDestination{
+			Name:     logsOTLPDestination,
+			Type:     typ,
+			Endpoint: logs.OTLP.Endpoint,
+			TLS:      logs.OTLP.TLS,
+			Auth:     logs.OTLP.Auth,
+			Headers:  logs.OTLP.Headers,
+		})
+	}
+
+	exporters, err := newCollectorExporters("logs", destinations)
+	if err != nil {
+		return nil, err
+	}
+	exporterNames = append(exporterNames, exporters.PipelineExporters...)
+
+	data["LogsExporters"] = exporters.Exporters
+	data["LogsExporterNames"] = exporterNames
+	data["LogsExtensions"] = exporters.Extensions
+	data["LogsExtensionNames"] = slices.Sorted(maps.Keys(exporters.Extensions))
+	data["LogsCollectorEnv"] = exporters.Env
+	data["LogsCollectorVolumes"] = exporters.Volumes
+	data["LogsCollectorVolumeMounts"] = exporters.VolumeMounts
+
+	return data, nil
+}
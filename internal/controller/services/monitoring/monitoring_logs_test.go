@@ -0,0 +1,198 @@
+//nolint:testpackage // Need to test unexported functions
+package monitoring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/template"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+)
+
+// newLogsRequest returns a reconciliation request of a Monitoring CR with the given logs configuration, the
+// given CRDs being installed.
+func newLogsRequest(t *testing.T, logs *serviceApi.Logs, crds ...schema.GroupVersionKind) *odhtypes.ReconciliationRequest {
+	t.Helper()
+
+	s, err := scheme.New()
+	require.NoError(t, err)
+
+	objs := []client.Object{&dscv1.DataScienceCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsc"},
+	}}
+	for _, crd := range crds {
+		s.AddKnownTypeWithName(crd, &unstructured.Unstructured{})
+
+		obj := mocks.NewMockCRD(crd.Group, crd.Version, crd.Kind, "monitoring")
+		obj.Status.StoredVersions = append(obj.Status.StoredVersions, crd.Version)
+		objs = append(objs, obj)
+	}
+
+	cl, err := fakeclient.New(fakeclient.WithScheme(s), fakeclient.WithObjects(objs...))
+	require.NoError(t, err)
+
+	monitoring := &serviceApi.Monitoring{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+		Spec: serviceApi.MonitoringSpec{
+			MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+				Namespace: "test-namespace",
+				Logs:      logs,
+			},
+		},
+	}
+
+	return &odhtypes.ReconciliationRequest{
+		Client:   cl,
+		Instance: monitoring,
+		DSCI: &dsciv1.DSCInitialization{
+			Spec: dsciv1.DSCInitializationSpec{
+				ApplicationsNamespace: "test-app-namespace",
+			},
+		},
+		Conditions: conditions.NewManager(monitoring, status.ConditionTypeReady),
+	}
+}
+
+func TestDeployLogs(t *testing.T) {
+	rr := newLogsRequest(t, nil)
+	require.NoError(t, deployLogs(t.Context(), rr))
+	assert.Empty(t, rr.Templates)
+	c := rr.Conditions.GetCondition(status.ConditionLogsAvailable)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Equal(t, status.LogsNotConfiguredReason, c.Reason)
+
+	rr = newLogsRequest(t, &serviceApi.Logs{
+		OTLP: &serviceApi.LogsOTLP{Endpoint: "otlp.example.com:4317", Protocol: "grpc"},
+	})
+	require.NoError(t, deployLogs(t.Context(), rr))
+	assert.Empty(t, rr.Templates)
+	c = rr.Conditions.GetCondition(status.ConditionLogsAvailable)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Equal(t, gvk.OpenTelemetryCollector.Kind+"CRDNotFoundReason", c.Reason)
+
+	rr = newLogsRequest(t, &serviceApi.Logs{
+		Storage: &serviceApi.LogsStorage{Backend: "s3", Secret: "logs-storage"},
+	}, gvk.OpenTelemetryCollector, gvk.LokiStack)
+	require.NoError(t, deployLogs(t.Context(), rr))
+	assert.Equal(t, []string{LogsCollectorTemplate, LogsCollectorRBACTemplate, LokiStackTemplate}, templatePaths(rr))
+	c = rr.Conditions.GetCondition(status.ConditionLogsAvailable)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionTrue, c.Status)
+
+	// nothing is deployed without the LokiStack CRD if the logs are not exported to an OTLP endpoint
+	rr = newLogsRequest(t, &serviceApi.Logs{
+		Storage: &serviceApi.LogsStorage{Backend: "s3", Secret: "logs-storage"},
+	}, gvk.OpenTelemetryCollector)
+	require.NoError(t, deployLogs(t.Context(), rr))
+	assert.Empty(t, rr.Templates)
+	c = rr.Conditions.GetCondition(status.ConditionLogsAvailable)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Equal(t, gvk.LokiStack.Kind+"CRDNotFoundReason", c.Reason)
+
+	// the collector still exports the logs to the OTLP endpoint without the LokiStack CRD
+	rr = newLogsRequest(t, &serviceApi.Logs{
+		Storage: &serviceApi.LogsStorage{Backend: "s3", Secret: "logs-storage"},
+		OTLP:    &serviceApi.LogsOTLP{Endpoint: "otlp.example.com:4317", Protocol: "grpc"},
+	}, gvk.OpenTelemetryCollector)
+	require.NoError(t, deployLogs(t.Context(), rr))
+	assert.Equal(t, []string{LogsCollectorTemplate, LogsCollectorRBACTemplate}, templatePaths(rr))
+	c = rr.Conditions.GetCondition(status.ConditionLogsAvailable)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Equal(t, gvk.LokiStack.Kind+"CRDNotFoundReason", c.Reason)
+	assert.Contains(t, c.Message, "only exported to the OTLP endpoint")
+
+	action := template.NewAction(template.WithCache(false), template.WithDataFn(getTemplateData))
+	require.NoError(t, action(t.Context(), rr))
+	require.Len(t, rr.Resources, 3)
+
+	exporters, _, err := unstructured.NestedStringSlice(rr.Resources[0].Object, "spec", "config", "service", "pipelines", "logs", "exporters")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"otlp/external"}, exporters)
+}
+
+func templatePaths(rr *odhtypes.ReconciliationRequest) []string {
+	paths := make([]string, 0, len(rr.Templates))
+	for _, t := range rr.Templates {
+		paths = append(paths, t.Path)
+	}
+
+	return paths
+}
+
+// TestRenderLogs renders the logs collector and the LokiStack, and checks that the logs pipeline exports the
+// logs of the component pods to the LokiStack and to the OTLP endpoint.
+func TestRenderLogs(t *testing.T) {
+	rr := newLogsRequest(t, &serviceApi.Logs{
+		Storage: &serviceApi.LogsStorage{
+			Backend:          "s3",
+			Secret:           "logs-storage",
+			StorageClassName: "gp3-csi",
+			Size:             "1x.extra-small",
+			RetentionDays:    7,
+		},
+		OTLP: &serviceApi.LogsOTLP{
+			Endpoint: "https://otlp.example.com",
+			Protocol: "http",
+			Auth: &serviceApi.ExporterAuth{
+				BearerTokenSecret: &serviceApi.SecretKeyReference{Name: "otlp-token", Key: "token"},
+			},
+		},
+	}, gvk.OpenTelemetryCollector, gvk.LokiStack)
+	rr.Templates = []odhtypes.TemplateInfo{
+		{FS: resourcesFS, Path: LogsCollectorTemplate},
+		{FS: resourcesFS, Path: LogsCollectorRBACTemplate},
+		{FS: resourcesFS, Path: LokiStackTemplate},
+	}
+
+	action := template.NewAction(template.WithCache(false), template.WithDataFn(getTemplateData))
+	require.NoError(t, action(t.Context(), rr))
+	require.Len(t, rr.Resources, 4)
+
+	collector := rr.Resources[0].Object
+
+	exporters, _, err := unstructured.NestedStringSlice(collector, "spec", "config", "service", "pipelines", "logs", "exporters")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"otlphttp/lokistack", "otlphttp/external"}, exporters)
+
+	extensions, _, err := unstructured.NestedStringSlice(collector, "spec", "config", "service", "extensions")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bearertokenauth", "bearertokenauth/external"}, extensions)
+
+	statements, _, err := unstructured.NestedSlice(collector, "spec", "config", "processors", "transform/odh", "log_statements")
+	require.NoError(t, err)
+	require.Len(t, statements, 1)
+	assert.Contains(t, statements[0].(map[string]any)["statements"],
+		`set(attributes["odh.component"], "kserve") where attributes["app.opendatahub.io/kserve"] == "true"`)
+	assert.Contains(t, statements[0].(map[string]any)["statements"],
+		`set(attributes["odh.datasciencecluster"], "default-dsc") where attributes["odh.component"] != nil`)
+
+	env, _, err := unstructured.NestedSlice(collector, "spec", "env")
+	require.NoError(t, err)
+	require.Len(t, env, 2)
+	assert.Equal(t, "LOGS_DESTINATION_EXTERNAL_TOKEN", env[1].(map[string]any)["name"])
+
+	lokiStack := rr.Resources[3]
+	assert.Equal(t, gvk.LokiStack.Kind, lokiStack.GetKind())
+	retention, _, err := unstructured.NestedInt64(lokiStack.Object, "spec", "limits", "global", "retention", "days")
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), retention)
+}
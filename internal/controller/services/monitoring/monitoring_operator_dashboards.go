@@ -0,0 +1,286 @@
+package monitoring
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+const (
+	// OperatorDashboardName is the name of the operator health dashboards.
+	OperatorDashboardName = "data-science-operator-health"
+	// operatorDashboardTitle is the title of the operator health dashboards.
+	operatorDashboardTitle = "Data Science Operator Health"
+	// grafanaDashboardLabel is the label of the ConfigMaps discovered as dashboards by Grafana.
+	grafanaDashboardLabel = "grafana_dashboard"
+	// grafanaDashboardKey is the key of the ConfigMap holding the Grafana dashboard definition.
+	grafanaDashboardKey = OperatorDashboardName + ".json"
+)
+
+// dashboardPanel is a time series panel of the operator health dashboards.
+type dashboardPanel struct {
+	Title string
+	Query string
+	// Legend is the label identifying the series of the panel.
+	Legend string
+	Unit   string
+}
+
+// operatorDashboardPanels are the panels of the operator health dashboards, they are based on the recording rules
+// of the operator.
+var operatorDashboardPanels = []dashboardPanel{
+	{
+		Title:  "Reconcile error ratio",
+		Query:  "odh_operator:reconcile_errors:ratio_rate5m",
+		Legend: "controller",
+		Unit:   "percentunit",
+	},
+	{
+		Title:  "Reconcile error budget burn rate (1h)",
+		Query:  "odh_operator:reconcile_errors:burnrate1h",
+		Legend: "controller",
+		Unit:   "short",
+	},
+	{
+		Title:  "Reconcile duration (p99)",
+		Query:  "odh_operator:reconcile_duration_seconds:p99_5m",
+		Legend: "controller",
+		Unit:   "s",
+	},
+	{
+		Title:  "Time to ready (p95)",
+		Query:  "odh_operator:time_to_ready_seconds:p95_1h",
+		Legend: "controller",
+		Unit:   "s",
+	},
+	{
+		Title:  "Ready instances",
+		Query:  "odh_operator:instance_ready",
+		Legend: "controller",
+		Unit:   "short",
+	},
+	{
+		Title:  "Rendered manifests size (p90)",
+		Query:  "odh_operator:manifests_size:p90_1h",
+		Legend: "controller",
+		Unit:   "short",
+	},
+	{
+		Title:  "Webhook admission latency (p99)",
+		Query:  "odh_operator:webhook_admission_duration_seconds:p99_5m",
+		Legend: "webhook",
+		Unit:   "s",
+	},
+}
+
+// deployOperatorObservability deploys the recording rules of the operator SLIs and SLOs, the ServiceMonitor scraping
+// the operator metrics and the operator health dashboards when metrics are configured. The Grafana dashboard is
+// shipped in a ConfigMap, the Perses one is only deployed when the PersesDashboard CRD exists.
+func deployOperatorObservability(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	monitoring, ok := rr.Instance.(*serviceApi.Monitoring)
+	if !ok {
+		return errors.New("instance is not of type *services.Monitoring")
+	}
+
+	if monitoring.Spec.Metrics == nil {
+		return nil
+	}
+
+	for _, kind := range []schema.GroupVersionKind{gvk.ServiceMonitor, gvk.PrometheusRule} {
+		exists, err := cluster.HasCRD(ctx, rr.Client, kind)
+		if err != nil {
+			return fmt.Errorf("failed to check if %s CRD exists: %w", kind.Kind, err)
+		}
+		if !exists {
+			return nil
+		}
+	}
+
+	rr.Templates = append(rr.Templates, odhtypes.TemplateInfo{
+		FS:   resourcesFS,
+		Path: OperatorRecordingRulesTemplate,
+	})
+
+	// the operator namespace is unknown when the operator does not run in a cluster
+	if _, err := cluster.GetOperatorNamespace(); err == nil {
+		rr.Templates = append(rr.Templates, odhtypes.TemplateInfo{
+			FS:   resourcesFS,
+			Path: OperatorServiceMonitorTemplate,
+		})
+	} else {
+		logf.FromContext(ctx).V(3).Info("operator metrics not scraped", "reason", err.Error())
+	}
+
+	grafana, err := newGrafanaDashboard(monitoring.Spec.Namespace)
+	if err != nil {
+		return err
+	}
+	if err := rr.AddResources(grafana); err != nil {
+		return err
+	}
+
+	exists, err := cluster.HasCRD(ctx, rr.Client, gvk.PersesDashboard)
+	if err != nil {
+		return fmt.Errorf("failed to check if %s CRD exists: %w", gvk.PersesDashboard.Kind, err)
+	}
+	if exists {
+		rr.Resources = append(rr.Resources, *newPersesDashboard(monitoring.Spec.Namespace))
+	}
+
+	return nil
+}
+
+// newGrafanaDashboard returns the ConfigMap holding the Grafana definition of the operator health dashboard.
+func newGrafanaDashboard(namespace string) (*corev1.ConfigMap, error) {
+	panels := make([]any, 0, len(operatorDashboardPanels))
+	for i, p := range operatorDashboardPanels {
+		panels = append(panels, map[string]any{
+			"id":    i + 1,
+			"type":  "timeseries",
+			"title": p.Title,
+			"datasource": map[string]any{
+				"type": "prometheus",
+				"uid":  "${datasource}",
+			},
+			"gridPos": map[string]any{"x": (i % 2) * 12, "y": (i / 2) * 8, "w": 12, "h": 8},
+			"targets": []any{
+				map[string]any{
+					"refId":        "A",
+					"expr":         p.Query,
+					"legendFormat": "{{" + p.Legend + "}}",
+				},
+			},
+			"fieldConfig": map[string]any{
+				"defaults": map[string]any{"unit": p.Unit},
+			},
+		})
+	}
+
+	dashboard := map[string]any{
+		"uid":           OperatorDashboardName,
+		"title":         operatorDashboardTitle,
+		"schemaVersion": 39,
+		"editable":      false,
+		"time":          map[string]any{"from": "now-6h", "to": "now"},
+		"templating": map[string]any{
+			"list": []any{
+				map[string]any{
+					"name":  "datasource",
+					"label": "Data source",
+					"type":  "datasource",
+					"query": "prometheus",
+				},
+			},
+		},
+		"panels": panels,
+	}
+
+	content, err := json.MarshalIndent(dashboard, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the Grafana dashboard: %w", err)
+	}
+
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      OperatorDashboardName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				grafanaDashboardLabel: "1",
+			},
+		},
+		Data: map[string]string{
+			grafanaDashboardKey: string(content),
+		},
+	}, nil
+}
+
+// newPersesDashboard returns the PersesDashboard of the operator health dashboard.
+func newPersesDashboard(namespace string) *unstructured.Unstructured {
+	panels := make(map[string]any, len(operatorDashboardPanels))
+	items := make([]any, 0, len(operatorDashboardPanels))
+	for i, p := range operatorDashboardPanels {
+		key := fmt.Sprintf("panel%d", i)
+		panels[key] = map[string]any{
+			"kind": "Panel",
+			"spec": map[string]any{
+				"display": map[string]any{"name": p.Title},
+				"plugin": map[string]any{
+					"kind": "TimeSeriesChart",
+					"spec": map[string]any{
+						"yAxis": map[string]any{
+							"format": map[string]any{"unit": persesUnit(p.Unit)},
+						},
+					},
+				},
+				"queries": []any{
+					map[string]any{
+						"kind": "TimeSeriesQuery",
+						"spec": map[string]any{
+							"plugin": map[string]any{
+								"kind": "PrometheusTimeSeriesQuery",
+								"spec": map[string]any{
+									"query":            p.Query,
+									"seriesNameFormat": "{{" + p.Legend + "}}",
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		items = append(items, map[string]any{
+			"x":       int64((i % 2) * 12),
+			"y":       int64((i / 2) * 8),
+			"width":   int64(12),
+			"height":  int64(8),
+			"content": map[string]any{"$ref": "#/spec/panels/" + key},
+		})
+	}
+
+	dashboard := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"display":  map[string]any{"name": operatorDashboardTitle},
+			"duration": "6h",
+			"panels":   panels,
+			"layouts": []any{
+				map[string]any{
+					"kind": "Grid",
+					"spec": map[string]any{"items": items},
+				},
+			},
+		},
+	}}
+	dashboard.SetGroupVersionKind(gvk.PersesDashboard)
+	dashboard.SetName(OperatorDashboardName)
+	dashboard.SetNamespace(namespace)
+
+	return dashboard
+}
+
+// persesUnit returns the Perses unit matching a Grafana unit.
+func persesUnit(unit string) string {
+	switch unit {
+	case "percentunit":
+		return "percent-decimal"
+	case "s":
+		return "seconds"
+	default:
+		return "decimal"
+	}
+}
@@ -0,0 +1,116 @@
+//nolint:testpackage // Need to test unexported functions
+package monitoring
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+func TestOperatorRecordingRules(t *testing.T) {
+	content, err := resourcesFS.ReadFile(OperatorRecordingRulesTemplate)
+	require.NoError(t, err)
+
+	tmpl, err := template.New("rules").Option("missingkey=error").Parse(string(content))
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	require.NoError(t, tmpl.Execute(&out, map[string]any{"Namespace": "monitoring-ns"}))
+
+	var rule struct {
+		Spec map[string]any `yaml:"spec"`
+	}
+	require.NoError(t, yaml.Unmarshal(out.Bytes(), &rule))
+
+	spec, err := yaml.Marshal(rule.Spec)
+	require.NoError(t, err)
+	require.NoError(t, validateRulesDocument(string(spec)))
+
+	var doc rulesDocument
+	require.NoError(t, yaml.Unmarshal(spec, &doc))
+
+	records := map[string]bool{}
+	for _, g := range doc.Groups {
+		for _, r := range g.Rules {
+			records[r.Record] = true
+		}
+	}
+
+	// the dashboards only query the recorded SLIs and SLOs
+	for _, p := range operatorDashboardPanels {
+		assert.True(t, records[p.Query], "panel %q queries %s which is not recorded", p.Title, p.Query)
+	}
+}
+
+func TestNewGrafanaDashboard(t *testing.T) {
+	cm, err := newGrafanaDashboard("monitoring-ns")
+	require.NoError(t, err)
+
+	assert.Equal(t, OperatorDashboardName, cm.Name)
+	assert.Equal(t, "monitoring-ns", cm.Namespace)
+	assert.Equal(t, "1", cm.Labels[grafanaDashboardLabel])
+	require.Contains(t, cm.Data, grafanaDashboardKey)
+
+	var dashboard struct {
+		UID    string `json:"uid"`
+		Panels []struct {
+			Title   string `json:"title"`
+			Targets []struct {
+				Expr         string `json:"expr"`
+				LegendFormat string `json:"legendFormat"`
+			} `json:"targets"`
+		} `json:"panels"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(cm.Data[grafanaDashboardKey]), &dashboard))
+
+	assert.Equal(t, OperatorDashboardName, dashboard.UID)
+	require.Len(t, dashboard.Panels, len(operatorDashboardPanels))
+	for i, p := range operatorDashboardPanels {
+		assert.Equal(t, p.Title, dashboard.Panels[i].Title)
+		require.Len(t, dashboard.Panels[i].Targets, 1)
+		assert.Equal(t, p.Query, dashboard.Panels[i].Targets[0].Expr)
+		assert.Equal(t, "{{"+p.Legend+"}}", dashboard.Panels[i].Targets[0].LegendFormat)
+	}
+}
+
+func TestNewPersesDashboard(t *testing.T) {
+	dashboard := newPersesDashboard("monitoring-ns")
+
+	assert.Equal(t, gvk.PersesDashboard, dashboard.GroupVersionKind())
+	assert.Equal(t, OperatorDashboardName, dashboard.GetName())
+	assert.Equal(t, "monitoring-ns", dashboard.GetNamespace())
+
+	panels, _, err := unstructured.NestedMap(dashboard.Object, "spec", "panels")
+	require.NoError(t, err)
+	assert.Len(t, panels, len(operatorDashboardPanels))
+
+	layouts, _, err := unstructured.NestedSlice(dashboard.Object, "spec", "layouts")
+	require.NoError(t, err)
+	require.Len(t, layouts, 1)
+
+	items, _, err := unstructured.NestedSlice(layouts[0].(map[string]any), "spec", "items")
+	require.NoError(t, err)
+	require.Len(t, items, len(operatorDashboardPanels))
+
+	// every layout item references an existing panel
+	for _, item := range items {
+		ref, _, err := unstructured.NestedString(item.(map[string]any), "content", "$ref")
+		require.NoError(t, err)
+		assert.Contains(t, panels, ref[len("#/spec/panels/"):])
+	}
+}
+
+func TestDeployOperatorObservabilityWithoutMetrics(t *testing.T) {
+	rr := newLogsRequest(t, nil)
+	require.NoError(t, deployOperatorObservability(t.Context(), rr))
+	assert.Empty(t, rr.Resources)
+	assert.Empty(t, rr.Templates)
+}
@@ -0,0 +1,239 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"gopkg.in/yaml.v3"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/promql"
+)
+
+const (
+	// prometheusConfigKey is the key of the legacy prometheus ConfigMap holding the prometheus configuration.
+	prometheusConfigKey = "prometheus.yml"
+	// ruleFilesKey is the key of the prometheus configuration listing the loaded rule files.
+	ruleFilesKey = "rule_files"
+)
+
+// ruleFileKeyRegexp matches the keys of the legacy prometheus ConfigMap holding the rules of a component, the
+// first group being the rules prefix of the component.
+var ruleFileKeyRegexp = regexp.MustCompile(`^(.+)-(recording|alerting)\.rules$`)
+
+// prometheusConfigFS is the filesystem holding the legacy prometheus ConfigMap.
+type prometheusConfigFS interface {
+	fs.ReadFileFS
+	WriteFile(name string, data []byte) error
+}
+
+// dirFS is a prometheusConfigFS reading and writing the files of a directory on disk.
+type dirFS string
+
+func (d dirFS) Open(name string) (fs.File, error) {
+	return os.DirFS(string(d)).Open(name)
+}
+
+func (d dirFS) ReadFile(name string) ([]byte, error) {
+	return fs.ReadFile(os.DirFS(string(d)), name)
+}
+
+func (d dirFS) WriteFile(name string, data []byte) error {
+	path := filepath.Join(string(d), filepath.FromSlash(name))
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, info.Mode().Perm())
+}
+
+// prometheusConfigMap is the legacy prometheus ConfigMap, the data keys are discovered dynamically.
+type prometheusConfigMap struct {
+	APIVersion string            `yaml:"apiVersion"`
+	Kind       string            `yaml:"kind"`
+	Metadata   map[string]any    `yaml:"metadata"`
+	Data       map[string]string `yaml:"data"`
+}
+
+// rulesDocument is the content of a rules file of the legacy prometheus ConfigMap.
+type rulesDocument struct {
+	Groups []struct {
+		Name  string `yaml:"name"`
+		Rules []struct {
+			Record string `yaml:"record"`
+			Alert  string `yaml:"alert"`
+			Expr   string `yaml:"expr"`
+		} `yaml:"rules"`
+	} `yaml:"groups"`
+}
+
+// prometheusRuleFiles keeps the rule_files list of the legacy prometheus configuration in sync with the
+// components, each component loading its <prefix>-recording.rules and <prefix>-alerting.rules files through
+// the <prefix>*.rules pattern.
+type prometheusRuleFiles struct {
+	fsys prometheusConfigFS
+	name string
+}
+
+// newPrometheusRuleFiles returns a prometheusRuleFiles managing the ConfigMap stored in the given file of fsys.
+func newPrometheusRuleFiles(fsys prometheusConfigFS, name string) *prometheusRuleFiles {
+	return &prometheusRuleFiles{
+		fsys: fsys,
+		name: name,
+	}
+}
+
+// Update adds the rule files of the components set to true and removes the ones of the components set to false,
+// the components are identified by their rules prefix and the ones not in the map are left untouched. The
+// components without any rules in the ConfigMap are skipped. Every rules document of the ConfigMap is validated
+// before the file is written, the file being written only when the rule_files list changes.
+func (p *prometheusRuleFiles) Update(ctx context.Context, components map[string]bool) error {
+	content, err := p.fsys.ReadFile(p.name)
+	if err != nil {
+		return fmt.Errorf("failed to read prometheus config %s: %w", p.name, err)
+	}
+
+	var cm prometheusConfigMap
+	if err := yaml.Unmarshal(content, &cm); err != nil {
+		return fmt.Errorf("failed to parse prometheus config %s: %w", p.name, err)
+	}
+
+	prefixes, err := validateRuleFiles(cm.Data)
+	if err != nil {
+		return fmt.Errorf("invalid prometheus config %s: %w", p.name, err)
+	}
+
+	components = maps.Clone(components)
+	for _, prefix := range slices.Sorted(maps.Keys(components)) {
+		if !slices.Contains(prefixes, prefix) {
+			logf.FromContext(ctx).Info("No rules found in prometheus config, skipping", "prefix", prefix, "config", p.name)
+			delete(components, prefix)
+		}
+	}
+
+	var config map[string]any
+	if err := yaml.Unmarshal([]byte(cm.Data[prometheusConfigKey]), &config); err != nil {
+		return fmt.Errorf("failed to parse %s of prometheus config %s: %w", prometheusConfigKey, p.name, err)
+	}
+
+	var current []string
+	if ruleFiles, ok := config[ruleFilesKey].([]any); ok {
+		for _, f := range ruleFiles {
+			if s, ok := f.(string); ok {
+				current = append(current, s)
+			}
+		}
+	}
+
+	desired := updateRuleFiles(current, components)
+	if slices.Equal(current, desired) {
+		return nil
+	}
+
+	config[ruleFilesKey] = desired
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	cm.Data[prometheusConfigKey] = string(data)
+
+	content, err = yaml.Marshal(&cm)
+	if err != nil {
+		return err
+	}
+
+	return p.fsys.WriteFile(p.name, content)
+}
+
+// updateRuleFiles returns the rule files list with the patterns of the components set to true, and without the
+// patterns of the components set to false.
+func updateRuleFiles(current []string, components map[string]bool) []string {
+	res := make([]string, 0, len(current)+len(components))
+	for _, f := range current {
+		prefix, isComponent := strings.CutSuffix(f, "*.rules")
+		if enabled, ok := components[prefix]; isComponent && ok && !enabled {
+			continue
+		}
+		res = append(res, f)
+	}
+
+	for _, prefix := range slices.Sorted(maps.Keys(components)) {
+		pattern := prefix + "*.rules"
+		if components[prefix] && !slices.Contains(res, pattern) {
+			res = append(res, pattern)
+		}
+	}
+
+	return res
+}
+
+// validateRuleFiles validates the rules documents of the ConfigMap data, and returns the rules prefixes of the
+// discovered <prefix>-recording.rules and <prefix>-alerting.rules keys.
+func validateRuleFiles(data map[string]string) ([]string, error) {
+	if _, ok := data[prometheusConfigKey]; !ok {
+		return nil, fmt.Errorf("missing %s key", prometheusConfigKey)
+	}
+
+	var prefixes []string
+	var errs *multierror.Error
+
+	for _, key := range slices.Sorted(maps.Keys(data)) {
+		m := ruleFileKeyRegexp.FindStringSubmatch(key)
+		if m == nil {
+			continue
+		}
+
+		if !slices.Contains(prefixes, m[1]) {
+			prefixes = append(prefixes, m[1])
+		}
+
+		if err := validateRulesDocument(data[key]); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("%s: %w", key, err))
+		}
+	}
+
+	return prefixes, errs.ErrorOrNil()
+}
+
+// validateRulesDocument checks that a rules document holds named groups of recording or alerting rules with
+// valid PromQL expressions.
+func validateRulesDocument(content string) error {
+	var doc rulesDocument
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return err
+	}
+
+	if len(doc.Groups) == 0 {
+		return errors.New("no rule groups found")
+	}
+
+	var errs *multierror.Error
+	for i, g := range doc.Groups {
+		if g.Name == "" {
+			errs = multierror.Append(errs, fmt.Errorf("group %d has no name", i))
+		}
+
+		for j, r := range g.Rules {
+			if (r.Record == "") == (r.Alert == "") {
+				errs = multierror.Append(errs, fmt.Errorf("group %q rule %d must define exactly one of record and alert", g.Name, j))
+			}
+			if err := promql.Validate(r.Expr); err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("group %q rule %d has an invalid expression: %w", g.Name, j, err))
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
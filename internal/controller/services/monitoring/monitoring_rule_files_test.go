@@ -0,0 +1,188 @@
+//nolint:testpackage // Need to test unexported functions
+package monitoring
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	cr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/registry"
+
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/codeflare"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/dashboard"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/datasciencepipelines"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/feastoperator"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/kserve"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/kueue"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/llamastackoperator"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/modelcontroller"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/modelmeshserving"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/modelregistry"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/ray"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/trainingoperator"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/trustyai"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/workbenches"
+)
+
+const testPrometheusConfig = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: prometheus
+  namespace: <odh_monitoring_project>
+data:
+  prometheus.yml: |
+    rule_files:
+      - operator-recording.rules
+      - kserve*.rules
+    global:
+      scrape_interval: 10s
+  operator-recording.rules: |
+    groups:
+      - name: SLOs - RHODS Operator v2
+        rules:
+        - expr: rate(controller_runtime_reconcile_total{result!="success"}[15m])
+          record: controller_runtime_reconcile_total:rate15m
+  kserve-recording.rules: |
+    groups:
+      - name: SLOs - KServe
+        rules:
+        - expr: sum(up{job="kserve"})
+          record: kserve:up
+  kserve-alerting.rules: |
+    groups:
+      - name: SLOs - KServe
+        rules:
+        - alert: KServe down
+          expr: absent(up{job="kserve"})
+  new-component-alerting.rules: |
+    groups:
+      - name: New component
+        rules:
+        - alert: New component down
+          expr: absent(up{job="new-component"}) == 1
+`
+
+// memFS is an in-memory prometheusConfigFS.
+type memFS struct {
+	fstest.MapFS
+}
+
+func (m memFS) WriteFile(name string, data []byte) error {
+	m.MapFS[name] = &fstest.MapFile{Data: data}
+	return nil
+}
+
+func newMemFS(content string) memFS {
+	return memFS{fstest.MapFS{prometheusConfigFile: &fstest.MapFile{Data: []byte(content)}}}
+}
+
+func readRuleFiles(t *testing.T, fsys memFS) (prometheusConfigMap, []any) {
+	t.Helper()
+
+	var cm prometheusConfigMap
+	require.NoError(t, yaml.Unmarshal(fsys.MapFS[prometheusConfigFile].Data, &cm))
+
+	var config map[string]any
+	require.NoError(t, yaml.Unmarshal([]byte(cm.Data[prometheusConfigKey]), &config))
+
+	ruleFiles, _ := config[ruleFilesKey].([]any)
+	return cm, ruleFiles
+}
+
+func TestPrometheusRuleFilesUpdate(t *testing.T) {
+	fsys := newMemFS(testPrometheusConfig)
+	rf := newPrometheusRuleFiles(fsys, prometheusConfigFile)
+
+	// discovered components are added without any change to the code
+	require.NoError(t, rf.Update(t.Context(), map[string]bool{"new-component": true}))
+	cm, ruleFiles := readRuleFiles(t, fsys)
+	assert.Equal(t, []any{"operator-recording.rules", "kserve*.rules", "new-component*.rules"}, ruleFiles)
+	assert.Equal(t, "<odh_monitoring_project>", cm.Metadata["namespace"])
+	assert.Len(t, cm.Data, 5)
+
+	// disabled components are removed, the components not listed are left untouched
+	require.NoError(t, rf.Update(t.Context(), map[string]bool{"kserve": false}))
+	_, ruleFiles = readRuleFiles(t, fsys)
+	assert.Equal(t, []any{"operator-recording.rules", "new-component*.rules"}, ruleFiles)
+
+	// the file is not written when the rule files do not change
+	written := fsys.MapFS[prometheusConfigFile].Data
+	require.NoError(t, rf.Update(t.Context(), map[string]bool{"new-component": true, "kserve": false}))
+	assert.Equal(t, written, fsys.MapFS[prometheusConfigFile].Data)
+
+	// components without rules are skipped, the other components are still updated
+	require.NoError(t, rf.Update(t.Context(), map[string]bool{"unknown": true, "kserve": true}))
+	_, ruleFiles = readRuleFiles(t, fsys)
+	assert.Equal(t, []any{"operator-recording.rules", "new-component*.rules", "kserve*.rules"}, ruleFiles)
+}
+
+func TestPrometheusRuleFilesValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   string
+		message string
+	}{
+		{
+			name:    "invalid expression",
+			rules:   "groups:\n  - name: g\n    rules:\n    - alert: a\n      expr: sum(up{job=\"kserve\"}\n",
+			message: "group \"g\" rule 0 has an invalid expression",
+		},
+		{
+			name:    "record and alert",
+			rules:   "groups:\n  - name: g\n    rules:\n    - alert: a\n      record: r\n      expr: up\n",
+			message: "must define exactly one of record and alert",
+		},
+		{
+			name:    "unnamed group",
+			rules:   "groups:\n  - rules:\n    - alert: a\n      expr: up\n",
+			message: "group 0 has no name",
+		},
+		{
+			name:    "no groups",
+			rules:   "groups: []\n",
+			message: "no rule groups found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cm := prometheusConfigMap{Data: map[string]string{
+				prometheusConfigKey:     "rule_files: []\n",
+				"broken-alerting.rules": tt.rules,
+			}}
+			content, err := yaml.Marshal(&cm)
+			require.NoError(t, err)
+
+			fsys := newMemFS(string(content))
+			err = newPrometheusRuleFiles(fsys, prometheusConfigFile).Update(t.Context(), map[string]bool{"broken": true})
+			require.ErrorContains(t, err, "broken-alerting.rules")
+			require.ErrorContains(t, err, tt.message)
+		})
+	}
+}
+
+// TestPrometheusConfigManifest checks that the rules of the shipped prometheus ConfigMap are valid, and that the rules
+// of every registered component are found in it.
+func TestPrometheusConfigManifest(t *testing.T) {
+	content, err := os.ReadFile(filepath.Join("..", "..", "..", "..", "config", "monitoring", "prometheus", "apps", prometheusConfigFile))
+	require.NoError(t, err)
+
+	var cm prometheusConfigMap
+	require.NoError(t, yaml.Unmarshal(content, &cm))
+
+	prefixes, err := validateRuleFiles(cm.Data)
+	require.NoError(t, err)
+
+	err = cr.ForEach(func(ch cr.ComponentHandler) error {
+		prefix := cr.PrometheusRulesPrefix(ch)
+		assert.NotEmpty(t, prefix, "no rules prefix for component %s", ch.GetName())
+		assert.Contains(t, prefixes, prefix, "no rules found for component %s", ch.GetName())
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,315 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+const (
+	// monitoringStackName is the name of the MonitoringStack, and of the Prometheus it deploys.
+	monitoringStackName = "data-science-monitoringstack"
+	// tempoMonolithicName is the name of the TempoMonolithic storing the traces on a persistent volume.
+	tempoMonolithicName = "data-science-tempomonolithic"
+	// tempoStackName is the name of the TempoStack storing the traces in an object storage.
+	tempoStackName = "data-science-tempostack"
+	// pvTracesBackend is the traces storage backend deployed as a TempoMonolithic.
+	pvTracesBackend = "pv"
+
+	instanceLabel = "app.kubernetes.io/instance"
+	nameLabel     = "app.kubernetes.io/name"
+)
+
+// storageVolumes identifies the PersistentVolumeClaims of a stack and their desired size.
+type storageVolumes struct {
+	Stack    string
+	Selector client.MatchingLabels
+	Size     resource.Quantity
+}
+
+// monitoringStorage reconciles the storage of the MonitoringStack and of the TempoMonolithic, expanding their
+// PersistentVolumeClaims in place since their size cannot be changed through the stacks, and reports the storage
+// changes which cannot be applied in the StorageReconciled condition.
+type monitoringStorage struct {
+	// reader reads the PersistentVolumeClaims and the StorageClasses, which are not cached by the operator
+	reader client.Reader
+}
+
+// newStorageAction returns the action reconciling the storage of the monitoring stacks.
+func newStorageAction(reader client.Reader) actions.Fn {
+	a := &monitoringStorage{reader: reader}
+	return a.run
+}
+
+func (a *monitoringStorage) run(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	monitoring, ok := rr.Instance.(*serviceApi.Monitoring)
+	if !ok {
+		return errors.New("instance is not of type *services.Monitoring")
+	}
+
+	if monitoring.Spec.Metrics == nil && monitoring.Spec.Traces == nil {
+		rr.Conditions.MarkFalse(
+			status.ConditionStorageReconciled,
+			conditions.WithReason(status.StorageNotConfiguredReason),
+			conditions.WithMessage(status.StorageNotConfiguredMessage),
+		)
+		return nil
+	}
+
+	if traces := monitoring.Spec.Traces; traces != nil {
+		backend, err := tracesBackend(ctx, rr.Client, monitoring)
+		if err != nil {
+			return err
+		}
+
+		if backend != traces.Storage.Backend {
+			rr.Conditions.MarkFalse(
+				status.ConditionStorageReconciled,
+				conditions.WithReason(status.StorageMigrationNotAcknowledgedReason),
+				conditions.WithMessage("Switching the traces storage from %s to %s deletes the stored traces, set the %s annotation to %s to proceed",
+					backend, traces.Storage.Backend, annotations.StorageMigrationAnnotation, traces.Storage.Backend),
+			)
+			return nil
+		}
+	}
+
+	var pending []string
+	var refused []string
+	reason := status.VolumeResizeInProgressReason
+
+	for _, volumes := range desiredStorageVolumes(monitoring) {
+		claims := corev1.PersistentVolumeClaimList{}
+		if err := a.reader.List(ctx, &claims, client.InNamespace(monitoring.Spec.Namespace), volumes.Selector); err != nil {
+			return fmt.Errorf("failed to list the PersistentVolumeClaims of %s: %w", volumes.Stack, err)
+		}
+
+		for i := range claims.Items {
+			pvc := &claims.Items[i]
+
+			requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+			switch requested.Cmp(volumes.Size) {
+			case 1:
+				reason = status.VolumeShrinkNotSupportedReason
+				refused = append(refused, fmt.Sprintf("%s cannot be shrunk from %s to %s", pvc.Name, requested.String(), volumes.Size.String()))
+				continue
+			case -1:
+				expandable, err := a.allowsVolumeExpansion(ctx, pvc)
+				if err != nil {
+					return err
+				}
+				if !expandable {
+					reason = status.VolumeExpansionNotSupportedReason
+					refused = append(refused, fmt.Sprintf("%s cannot be expanded, its storage class does not allow volume expansion", pvc.Name))
+					continue
+				}
+
+				if err := expandVolume(ctx, rr.Client, pvc, volumes.Size); err != nil {
+					return err
+				}
+			}
+
+			if !isVolumeResized(pvc) {
+				pending = append(pending, pvc.Name)
+			}
+		}
+	}
+
+	switch {
+	case len(refused) > 0:
+		rr.Conditions.MarkFalse(
+			status.ConditionStorageReconciled,
+			conditions.WithReason(reason),
+			conditions.WithMessage("%s", strings.Join(refused, ", ")),
+		)
+	case len(pending) > 0:
+		rr.Conditions.MarkFalse(
+			status.ConditionStorageReconciled,
+			conditions.WithReason(status.VolumeResizeInProgressReason),
+			conditions.WithMessage("Waiting for the resize of %s", strings.Join(pending, ", ")),
+			conditions.WithSeverity(common.ConditionSeverityInfo),
+		)
+	default:
+		rr.Conditions.MarkTrue(status.ConditionStorageReconciled)
+	}
+
+	return nil
+}
+
+// allowsVolumeExpansion checks if the storage class of a PersistentVolumeClaim allows expanding its volume.
+func (a *monitoringStorage) allowsVolumeExpansion(ctx context.Context, pvc *corev1.PersistentVolumeClaim) (bool, error) {
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return false, nil
+	}
+
+	sc := storagev1.StorageClass{}
+	err := a.reader.Get(ctx, client.ObjectKey{Name: *pvc.Spec.StorageClassName}, &sc)
+	switch {
+	case k8serr.IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("failed to get StorageClass %s: %w", *pvc.Spec.StorageClassName, err)
+	}
+
+	return sc.AllowVolumeExpansion != nil && *sc.AllowVolumeExpansion, nil
+}
+
+// desiredStorageVolumes returns the PersistentVolumeClaims of the stacks having a configured size.
+func desiredStorageVolumes(monitoring *serviceApi.Monitoring) []storageVolumes {
+	var res []storageVolumes
+
+	if metrics := monitoring.Spec.Metrics; metrics != nil && metrics.Storage != nil && !metrics.Storage.Size.IsZero() {
+		res = append(res, storageVolumes{
+			Stack:    monitoringStackName,
+			Selector: client.MatchingLabels{instanceLabel: monitoringStackName, nameLabel: "prometheus"},
+			Size:     metrics.Storage.Size,
+		})
+	}
+
+	if traces := monitoring.Spec.Traces; traces != nil && traces.Storage.Backend == pvTracesBackend && traces.Storage.Size != "" {
+		// an invalid size is reported when the TempoMonolithic is deployed
+		if size, err := resource.ParseQuantity(traces.Storage.Size); err == nil {
+			res = append(res, storageVolumes{
+				Stack:    tempoMonolithicName,
+				Selector: client.MatchingLabels{instanceLabel: tempoMonolithicName},
+				Size:     size,
+			})
+		}
+	}
+
+	return res
+}
+
+// expandVolume requests the expansion of the volume of a PersistentVolumeClaim.
+func expandVolume(ctx context.Context, cli client.Client, pvc *corev1.PersistentVolumeClaim, size resource.Quantity) error {
+	patch := client.MergeFrom(pvc.DeepCopy())
+
+	if pvc.Spec.Resources.Requests == nil {
+		pvc.Spec.Resources.Requests = corev1.ResourceList{}
+	}
+	pvc.Spec.Resources.Requests[corev1.ResourceStorage] = size
+
+	if err := cli.Patch(ctx, pvc, patch); err != nil {
+		return fmt.Errorf("failed to expand PersistentVolumeClaim %s: %w", pvc.Name, err)
+	}
+
+	return nil
+}
+
+// isVolumeResized checks if the capacity of the volume of a PersistentVolumeClaim matches its request.
+func isVolumeResized(pvc *corev1.PersistentVolumeClaim) bool {
+	requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]
+
+	// the claim is not bound yet
+	if !ok {
+		return true
+	}
+
+	return capacity.Cmp(requested) >= 0
+}
+
+// tracesBackend returns the storage backend the traces are stored in. It is the configured backend, unless the
+// traces are stored in another backend and the switch, which deletes the stored traces, has not been acknowledged
+// by setting the StorageMigrationAnnotation to the configured backend.
+func tracesBackend(ctx context.Context, cli client.Client, monitoring *serviceApi.Monitoring) (string, error) {
+	desired := monitoring.Spec.Traces.Storage.Backend
+
+	current, err := currentTracesBackend(ctx, cli, monitoring.Spec.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	if current == "" || current == desired {
+		return desired, nil
+	}
+	if monitoring.GetAnnotations()[annotations.StorageMigrationAnnotation] == desired {
+		return desired, nil
+	}
+
+	return current, nil
+}
+
+// currentTracesBackend returns the storage backend of the deployed TempoMonolithic or TempoStack, if any.
+func currentTracesBackend(ctx context.Context, cli client.Client, namespace string) (string, error) {
+	tempo, err := getTempo(ctx, cli, namespace, pvTracesBackend)
+	if err != nil {
+		return "", err
+	}
+	if tempo != nil {
+		return pvTracesBackend, nil
+	}
+
+	// the backend of a TempoStack is the type of its object storage
+	tempo, err = getTempo(ctx, cli, namespace, "")
+	if err != nil || tempo == nil {
+		return "", err
+	}
+
+	backend, _, err := unstructured.NestedString(tempo.Object, "spec", "storage", "secret", "type")
+	if err != nil {
+		return "", fmt.Errorf("failed to read the storage of %s: %w", tempoStackName, err)
+	}
+
+	return backend, nil
+}
+
+// getTempo returns the TempoMonolithic, for the pv backend, or the TempoStack deployed in the monitoring namespace,
+// nil if it does not exist.
+func getTempo(ctx context.Context, cli client.Client, namespace string, backend string) (*unstructured.Unstructured, error) {
+	tempo := &unstructured.Unstructured{}
+	tempo.SetGroupVersionKind(gvk.TempoStack)
+	name := tempoStackName
+	if backend == pvTracesBackend {
+		tempo.SetGroupVersionKind(gvk.TempoMonolithic)
+		name = tempoMonolithicName
+	}
+
+	err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, tempo)
+	switch {
+	case k8serr.IsNotFound(err), meta.IsNoMatchError(err):
+		return nil, nil //nolint:nilnil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get %s %s: %w", tempo.GetKind(), name, err)
+	}
+
+	return tempo, nil
+}
+
+// frozenTempo returns the deployed TempoMonolithic or TempoStack as it is, to be deployed again while a switch of
+// the traces storage backend is not acknowledged.
+func frozenTempo(ctx context.Context, cli client.Client, namespace string, backend string) (*unstructured.Unstructured, error) {
+	tempo, err := getTempo(ctx, cli, namespace, backend)
+	if err != nil || tempo == nil {
+		return nil, err
+	}
+
+	spec, _, err := unstructured.NestedMap(tempo.Object, "spec")
+	if err != nil {
+		return nil, err
+	}
+
+	res := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	res.SetGroupVersionKind(tempo.GroupVersionKind())
+	res.SetName(tempo.GetName())
+	res.SetNamespace(tempo.GetNamespace())
+
+	return res, nil
+}
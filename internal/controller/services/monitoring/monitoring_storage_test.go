@@ -0,0 +1,190 @@
+//nolint:testpackage // Need to test unexported functions
+package monitoring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+)
+
+func newStorageRequest(t *testing.T, spec serviceApi.MonitoringCommonSpec, objs ...client.Object) *odhtypes.ReconciliationRequest {
+	t.Helper()
+
+	cl, err := fakeclient.New(fakeclient.WithObjects(objs...))
+	require.NoError(t, err)
+
+	spec.Namespace = "test-namespace"
+	monitoring := &serviceApi.Monitoring{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+		Spec:       serviceApi.MonitoringSpec{MonitoringCommonSpec: spec},
+	}
+
+	return &odhtypes.ReconciliationRequest{
+		Client:     cl,
+		Instance:   monitoring,
+		Conditions: conditions.NewManager(monitoring, status.ConditionTypeReady),
+	}
+}
+
+func newPrometheusPVC(size string, capacity string) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "prometheus-data-science-monitoringstack-db-0",
+			Namespace: "test-namespace",
+			Labels:    map[string]string{instanceLabel: monitoringStackName, nameLabel: "prometheus"},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			StorageClassName: ptr.To("gp3-csi"),
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+			},
+		},
+		Status: corev1.PersistentVolumeClaimStatus{
+			Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(capacity)},
+		},
+	}
+}
+
+func newStorageClass(expandable bool) *storagev1.StorageClass {
+	return &storagev1.StorageClass{
+		ObjectMeta:           metav1.ObjectMeta{Name: "gp3-csi"},
+		AllowVolumeExpansion: ptr.To(expandable),
+	}
+}
+
+func newMetrics(size string) *serviceApi.Metrics {
+	return &serviceApi.Metrics{
+		Storage: &serviceApi.MetricsStorage{Size: resource.MustParse(size)},
+	}
+}
+
+func TestStorageVolumeExpansion(t *testing.T) {
+	rr := newStorageRequest(t,
+		serviceApi.MonitoringCommonSpec{Metrics: newMetrics("10Gi")},
+		newPrometheusPVC("5Gi", "5Gi"),
+		newStorageClass(true),
+	)
+
+	require.NoError(t, newStorageAction(rr.Client)(t.Context(), rr))
+
+	pvc := corev1.PersistentVolumeClaim{}
+	require.NoError(t, rr.Client.Get(t.Context(), client.ObjectKeyFromObject(newPrometheusPVC("5Gi", "5Gi")), &pvc))
+	size := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	assert.Equal(t, "10Gi", size.String())
+
+	c := rr.Conditions.GetCondition(status.ConditionStorageReconciled)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Equal(t, status.VolumeResizeInProgressReason, c.Reason)
+}
+
+func TestStorageVolumeResizeRefused(t *testing.T) {
+	tests := []struct {
+		name   string
+		size   string
+		reason string
+	}{
+		{name: "shrink", size: "2Gi", reason: status.VolumeShrinkNotSupportedReason},
+		{name: "expansion not allowed", size: "10Gi", reason: status.VolumeExpansionNotSupportedReason},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := newStorageRequest(t,
+				serviceApi.MonitoringCommonSpec{Metrics: newMetrics(tt.size)},
+				newPrometheusPVC("5Gi", "5Gi"),
+				newStorageClass(false),
+			)
+
+			require.NoError(t, newStorageAction(rr.Client)(t.Context(), rr))
+
+			pvc := corev1.PersistentVolumeClaim{}
+			require.NoError(t, rr.Client.Get(t.Context(), client.ObjectKeyFromObject(newPrometheusPVC("5Gi", "5Gi")), &pvc))
+			size := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+			assert.Equal(t, "5Gi", size.String())
+
+			c := rr.Conditions.GetCondition(status.ConditionStorageReconciled)
+			require.NotNil(t, c)
+			assert.Equal(t, metav1.ConditionFalse, c.Status)
+			assert.Equal(t, tt.reason, c.Reason)
+		})
+	}
+}
+
+func TestStorageReconciled(t *testing.T) {
+	rr := newStorageRequest(t,
+		serviceApi.MonitoringCommonSpec{Metrics: newMetrics("5Gi")},
+		newPrometheusPVC("5Gi", "5Gi"),
+	)
+
+	require.NoError(t, newStorageAction(rr.Client)(t.Context(), rr))
+
+	c := rr.Conditions.GetCondition(status.ConditionStorageReconciled)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionTrue, c.Status)
+}
+
+func TestTracesBackend(t *testing.T) {
+	tempo := &unstructured.Unstructured{}
+	tempo.SetGroupVersionKind(gvk.TempoMonolithic)
+	tempo.SetName(tempoMonolithicName)
+	tempo.SetNamespace("test-namespace")
+	require.NoError(t, unstructured.SetNestedField(tempo.Object, "10Gi", "spec", "storage", "traces", "size"))
+
+	traces := &serviceApi.Traces{
+		Storage: serviceApi.TracesStorage{Backend: "s3", Secret: "traces-storage"},
+	}
+
+	t.Run("no deployed backend", func(t *testing.T) {
+		rr := newStorageRequest(t, serviceApi.MonitoringCommonSpec{Traces: traces})
+
+		backend, err := tracesBackend(t.Context(), rr.Client, rr.Instance.(*serviceApi.Monitoring))
+		require.NoError(t, err)
+		assert.Equal(t, "s3", backend)
+	})
+
+	t.Run("switch not acknowledged", func(t *testing.T) {
+		rr := newStorageRequest(t, serviceApi.MonitoringCommonSpec{Traces: traces}, tempo.DeepCopy())
+
+		backend, err := tracesBackend(t.Context(), rr.Client, rr.Instance.(*serviceApi.Monitoring))
+		require.NoError(t, err)
+		assert.Equal(t, pvTracesBackend, backend)
+
+		require.NoError(t, newStorageAction(rr.Client)(t.Context(), rr))
+		c := rr.Conditions.GetCondition(status.ConditionStorageReconciled)
+		require.NotNil(t, c)
+		assert.Equal(t, status.StorageMigrationNotAcknowledgedReason, c.Reason)
+
+		// the deployed TempoMonolithic is kept as it is
+		frozen, err := frozenTempo(t.Context(), rr.Client, "test-namespace", backend)
+		require.NoError(t, err)
+		require.NotNil(t, frozen)
+		assert.Equal(t, gvk.TempoMonolithic, frozen.GroupVersionKind())
+		assert.Equal(t, tempo.Object["spec"], frozen.Object["spec"])
+	})
+
+	t.Run("switch acknowledged", func(t *testing.T) {
+		rr := newStorageRequest(t, serviceApi.MonitoringCommonSpec{Traces: traces}, tempo.DeepCopy())
+		rr.Instance.SetAnnotations(map[string]string{annotations.StorageMigrationAnnotation: "s3"})
+
+		backend, err := tracesBackend(t.Context(), rr.Client, rr.Instance.(*serviceApi.Monitoring))
+		require.NoError(t, err)
+		assert.Equal(t, "s3", backend)
+	})
+}
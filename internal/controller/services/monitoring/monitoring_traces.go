@@ -0,0 +1,175 @@
+package monitoring
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+)
+
+const (
+	// serviceNameAttribute is the resource attribute identifying the service which emitted a span.
+	serviceNameAttribute = "service.name"
+	// traceIDRatioSampler is the sampler of the instrumented workloads when the collector does not sample traces.
+	traceIDRatioSampler = "traceidratio"
+	// alwaysOnSampler is the sampler of the instrumented workloads when the collector samples traces, the sampling
+	// decision of the parent span being honored.
+	alwaysOnSampler = "parentbased_always_on"
+)
+
+// tracesTemplateData returns the template data of the Instrumentation CRs and of the traces pipeline of the
+// OpenTelemetry Collector.
+func tracesTemplateData(traces *serviceApi.Traces, namespace string) (map[string]any, error) {
+	data := map[string]any{
+		"Sampler":                   traceIDRatioSampler,
+		"SampleRatio":               traces.SampleRatio,
+		"TracesTailSampling":        nil,
+		"InstrumentationNamespaces": instrumentationNamespaces(traces, namespace),
+	}
+
+	if traces.Sampling != nil {
+		tailSampling, err := tailSamplingConfig(traces.Sampling, traces.SampleRatio)
+		if err != nil {
+			return nil, err
+		}
+
+		// the workloads send all the traces, the collector decides which ones are kept
+		data["Sampler"] = alwaysOnSampler
+		data["TracesTailSampling"] = tailSampling
+	}
+
+	return data, nil
+}
+
+// instrumentationNamespaces returns the namespaces an Instrumentation CR is deployed in, the monitoring namespace
+// being always the first one.
+func instrumentationNamespaces(traces *serviceApi.Traces, namespace string) []string {
+	res := []string{namespace}
+	for _, target := range traces.Instrumentation {
+		if !slices.Contains(res, target.Namespace) {
+			res = append(res, target.Namespace)
+		}
+	}
+
+	return res
+}
+
+// tailSamplingConfig returns the configuration of the tail_sampling processor. The traces not kept by the errors,
+// latency and per service policies are sampled with the default ratio, the traces of the services with a custom
+// ratio being excluded from the default policy.
+func tailSamplingConfig(sampling *serviceApi.TracesSampling, defaultRatio string) (map[string]any, error) {
+	var policies []any
+
+	if sampling.Errors {
+		policies = append(policies, map[string]any{
+			"name": "errors",
+			"type": "status_code",
+			"status_code": map[string]any{
+				"status_codes": []any{"ERROR"},
+			},
+		})
+	}
+
+	if sampling.LatencyThreshold != nil {
+		policies = append(policies, map[string]any{
+			"name": "latency",
+			"type": "latency",
+			"latency": map[string]any{
+				"threshold_ms": sampling.LatencyThreshold.Milliseconds(),
+			},
+		})
+	}
+
+	services := make([]any, 0, len(sampling.Services))
+	for _, s := range sampling.Services {
+		percentage, err := samplingPercentage(s.SampleRatio)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sample ratio of service %s: %w", s.Service, err)
+		}
+
+		policies = append(policies, map[string]any{
+			"name": "service-" + s.Service,
+			"type": "and",
+			"and": map[string]any{
+				"and_sub_policy": []any{
+					serviceNamePolicy([]any{s.Service}, false),
+					probabilisticPolicy(percentage),
+				},
+			},
+		})
+		services = append(services, s.Service)
+	}
+
+	percentage, err := samplingPercentage(defaultRatio)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sample ratio: %w", err)
+	}
+
+	if len(services) == 0 {
+		policies = append(policies, probabilisticPolicy(percentage))
+	} else {
+		policies = append(policies, map[string]any{
+			"name": "default",
+			"type": "and",
+			"and": map[string]any{
+				"and_sub_policy": []any{
+					serviceNamePolicy(services, true),
+					probabilisticPolicy(percentage),
+				},
+			},
+		})
+	}
+
+	return map[string]any{
+		"decision_wait": sampling.DecisionWait.Duration.String(),
+		"policies":      policies,
+	}, nil
+}
+
+// serviceNamePolicy returns a tail sampling policy matching the spans of the given services, or of all the other
+// services when invert is set.
+func serviceNamePolicy(services []any, invert bool) map[string]any {
+	policy := map[string]any{
+		"key":    serviceNameAttribute,
+		"values": services,
+	}
+	if invert {
+		policy["invert_match"] = true
+	}
+
+	return map[string]any{
+		"name":             "service-name",
+		"type":             "string_attribute",
+		"string_attribute": policy,
+	}
+}
+
+// probabilisticPolicy returns a tail sampling policy keeping the given percentage of the traces.
+func probabilisticPolicy(percentage float64) map[string]any {
+	return map[string]any{
+		"name": "ratio",
+		"type": "probabilistic",
+		"probabilistic": map[string]any{
+			"sampling_percentage": percentage,
+		},
+	}
+}
+
+// samplingPercentage converts a sample ratio between 0.0 and 1.0 into a percentage, an empty ratio keeping all
+// the traces.
+func samplingPercentage(ratio string) (float64, error) {
+	if ratio == "" {
+		return 100, nil
+	}
+
+	value, err := strconv.ParseFloat(ratio, 64)
+	if err != nil {
+		return 0, err
+	}
+	if value < 0 || value > 1 {
+		return 0, fmt.Errorf("%s is not between 0.0 and 1.0", ratio)
+	}
+
+	return value * 100, nil
+}
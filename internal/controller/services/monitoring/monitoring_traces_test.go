@@ -0,0 +1,141 @@
+//nolint:testpackage // Need to test unexported functions
+package monitoring
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/template"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+)
+
+func newTestTraces() *serviceApi.Traces {
+	return &serviceApi.Traces{
+		Storage:     serviceApi.TracesStorage{Backend: "pv"},
+		SampleRatio: "0.1",
+		Sampling: &serviceApi.TracesSampling{
+			DecisionWait:     metav1.Duration{Duration: 10 * time.Second},
+			Errors:           true,
+			LatencyThreshold: &metav1.Duration{Duration: 2 * time.Second},
+			Services: []serviceApi.ServiceSampling{
+				{Service: "checkout", SampleRatio: "0.5"},
+			},
+		},
+		Instrumentation: []serviceApi.InstrumentationTarget{
+			{Namespace: "team-a", Languages: []serviceApi.InstrumentationLanguage{serviceApi.InstrumentationLanguageJava}},
+			{
+				Namespace:        "team-b",
+				Languages:        []serviceApi.InstrumentationLanguage{serviceApi.InstrumentationLanguagePython},
+				WorkloadSelector: map[string]string{"app": "inference"},
+			},
+		},
+	}
+}
+
+func TestTailSamplingConfig(t *testing.T) {
+	config, err := tailSamplingConfig(newTestTraces().Sampling, "0.1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "10s", config["decision_wait"])
+
+	policies := config["policies"].([]any)
+	require.Len(t, policies, 4)
+
+	names := make([]any, 0, len(policies))
+	for _, p := range policies {
+		names = append(names, p.(map[string]any)["name"])
+	}
+	assert.Equal(t, []any{"errors", "latency", "service-checkout", "default"}, names)
+
+	assert.Equal(t, int64(2000), policies[1].(map[string]any)["latency"].(map[string]any)["threshold_ms"])
+
+	// the default ratio does not apply to the services with a custom ratio
+	subPolicies := policies[3].(map[string]any)["and"].(map[string]any)["and_sub_policy"].([]any)
+	require.Len(t, subPolicies, 2)
+	assert.Equal(t, map[string]any{
+		"key":          serviceNameAttribute,
+		"values":       []any{"checkout"},
+		"invert_match": true,
+	}, subPolicies[0].(map[string]any)["string_attribute"])
+	assert.InDelta(t, 10.0, subPolicies[1].(map[string]any)["probabilistic"].(map[string]any)["sampling_percentage"], 0.001)
+
+	t.Run("without services", func(t *testing.T) {
+		config, err := tailSamplingConfig(&serviceApi.TracesSampling{Errors: true}, "1.0")
+		require.NoError(t, err)
+
+		policies := config["policies"].([]any)
+		require.Len(t, policies, 2)
+		assert.Equal(t, "probabilistic", policies[1].(map[string]any)["type"])
+	})
+
+	t.Run("invalid ratio", func(t *testing.T) {
+		_, err := tailSamplingConfig(&serviceApi.TracesSampling{
+			Services: []serviceApi.ServiceSampling{{Service: "checkout", SampleRatio: "2"}},
+		}, "0.1")
+		require.ErrorContains(t, err, "checkout")
+	})
+}
+
+// TestRenderTraces renders the collector and the Instrumentation CRs, and checks that the collector samples the
+// traces while the instrumented workloads send all of them.
+func TestRenderTraces(t *testing.T) {
+	cl, err := fakeclient.New()
+	require.NoError(t, err)
+
+	rr := &odhtypes.ReconciliationRequest{
+		Client: cl,
+		Instance: &serviceApi.Monitoring{
+			ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+			Spec: serviceApi.MonitoringSpec{
+				MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+					Namespace: "test-namespace",
+					Traces:    newTestTraces(),
+				},
+			},
+		},
+		DSCI: &dsciv1.DSCInitialization{
+			Spec: dsciv1.DSCInitializationSpec{
+				ApplicationsNamespace: "test-app-namespace",
+			},
+		},
+		Templates: []odhtypes.TemplateInfo{
+			{FS: resourcesFS, Path: OpenTelemetryCollectorTemplate},
+			{FS: resourcesFS, Path: InstrumentationTemplate},
+		},
+	}
+
+	action := template.NewAction(template.WithCache(false), template.WithDataFn(getTemplateData))
+	require.NoError(t, action(t.Context(), rr))
+	require.Len(t, rr.Resources, 4)
+
+	collector := rr.Resources[0].Object
+
+	processors, _, err := unstructured.NestedStringSlice(collector, "spec", "config", "service", "pipelines", "traces", "processors")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"memory_limiter", "k8sattributes", "resourcedetection", "tail_sampling", "batch"}, processors)
+
+	policies, _, err := unstructured.NestedSlice(collector, "spec", "config", "processors", "tail_sampling", "policies")
+	require.NoError(t, err)
+	assert.Len(t, policies, 4)
+
+	namespaces := make([]string, 0, 3)
+	for _, instrumentation := range rr.Resources[1:] {
+		assert.Equal(t, gvk.Instrumentation.Kind, instrumentation.GetKind())
+		assert.Equal(t, instrumentationName, instrumentation.GetName())
+		namespaces = append(namespaces, instrumentation.GetNamespace())
+
+		sampler, _, err := unstructured.NestedMap(instrumentation.Object, "spec", "sampler")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"type": alwaysOnSampler}, sampler)
+	}
+	assert.Equal(t, []string{"test-namespace", "team-a", "team-b"}, namespaces)
+}
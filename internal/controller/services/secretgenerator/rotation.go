@@ -0,0 +1,156 @@
+package secretgenerator
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	annotation "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+// PreviousKey returns the key holding the previous value of a key of a rotated secret.
+func PreviousKey(key string) string {
+	return key + PreviousKeySuffix
+}
+
+// LastRotation returns the time of the last rotation of a generated secret, its creation time when it was never
+// rotated.
+func LastRotation(generated *corev1.Secret) time.Time {
+	if value, found := generated.GetAnnotations()[annotation.SecretLastRotationAnnotation]; found {
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			return t
+		}
+	}
+
+	return generated.CreationTimestamp.Time
+}
+
+// RotationDue returns true when the generated secret is to be rotated: the rotate annotation of the source secret
+// changed, the rotation interval elapsed, or the certificate of a tls secret entered its renewal window.
+func (s *Secret) RotationDue(source *corev1.Secret, generated *corev1.Secret, now time.Time) bool {
+	if source.GetAnnotations()[annotation.SecretRotateAnnotation] != generated.GetAnnotations()[annotation.SecretRotateAnnotation] {
+		return true
+	}
+
+	next := s.nextRotation(generated)
+
+	return !next.IsZero() && !now.Before(next)
+}
+
+// Rotate sets the values of the secret in the generated secret. The current values are kept under their previous
+// keys for the grace period, unless it is zero.
+func (s *Secret) Rotate(source *corev1.Secret, generated *corev1.Secret, now time.Time) {
+	data := map[string][]byte{}
+
+	if s.GracePeriod > 0 {
+		for k, v := range generated.Data {
+			if !strings.HasSuffix(k, PreviousKeySuffix) {
+				data[PreviousKey(k)] = v
+			}
+		}
+	}
+
+	for k, v := range s.Data {
+		data[k] = []byte(v)
+	}
+
+	generated.Data = data
+	generated.StringData = nil
+	s.SetRotationAnnotations(source, generated, now)
+}
+
+// SetRotationAnnotations records the time of the rotation, and the rotate annotation of the source secret it was
+// done for, in the annotations of the generated secret.
+func (s *Secret) SetRotationAnnotations(source *corev1.Secret, generated *corev1.Secret, now time.Time) {
+	if generated.Annotations == nil {
+		generated.Annotations = map[string]string{}
+	}
+
+	generated.Annotations[annotation.SecretLastRotationAnnotation] = now.UTC().Format(time.RFC3339)
+
+	if rotate, found := source.GetAnnotations()[annotation.SecretRotateAnnotation]; found {
+		generated.Annotations[annotation.SecretRotateAnnotation] = rotate
+	} else {
+		delete(generated.Annotations, annotation.SecretRotateAnnotation)
+	}
+}
+
+// ExpirePreviousValues removes the previous values of the generated secret once the grace period elapsed. It
+// returns true when values were removed.
+func (s *Secret) ExpirePreviousValues(generated *corev1.Secret, now time.Time) bool {
+	if !hasPreviousValues(generated) || now.Before(LastRotation(generated).Add(s.GracePeriod)) {
+		return false
+	}
+
+	for k := range generated.Data {
+		if strings.HasSuffix(k, PreviousKeySuffix) {
+			delete(generated.Data, k)
+		}
+	}
+
+	return true
+}
+
+// NextTransition returns the time of the next scheduled rotation of the generated secret, or of the expiry of its
+// previous values, the zero time when there is none.
+func (s *Secret) NextTransition(generated *corev1.Secret) time.Time {
+	next := s.nextRotation(generated)
+
+	if hasPreviousValues(generated) {
+		expiry := LastRotation(generated).Add(s.GracePeriod)
+		if next.IsZero() || expiry.Before(next) {
+			next = expiry
+		}
+	}
+
+	return next
+}
+
+// nextRotation returns the time of the next scheduled rotation of the generated secret, the zero time when it is
+// not rotated on a schedule.
+func (s *Secret) nextRotation(generated *corev1.Secret) time.Time {
+	next := time.Time{}
+
+	if s.RotationInterval > 0 {
+		next = LastRotation(generated).Add(s.RotationInterval)
+	}
+
+	if s.Type == TLSType {
+		// the certificates are renewed in the last third of their validity
+		if cert := parseCertificate(generated.Data[s.Name+".crt"]); cert != nil {
+			renewal := cert.NotAfter.Add(-cert.NotAfter.Sub(cert.NotBefore) / 3)
+			if next.IsZero() || renewal.Before(next) {
+				next = renewal
+			}
+		}
+	}
+
+	return next
+}
+
+func hasPreviousValues(generated *corev1.Secret) bool {
+	for k := range generated.Data {
+		if strings.HasSuffix(k, PreviousKeySuffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseCertificate(data []byte) *x509.Certificate {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil
+	}
+
+	return cert
+}
@@ -0,0 +1,65 @@
+package secretgenerator_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/secretgenerator"
+)
+
+func TestSecretRotation(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "example",
+			Annotations: map[string]string{
+				"secret-generator.opendatahub.io/name":              "password",
+				"secret-generator.opendatahub.io/type":              "random",
+				"secret-generator.opendatahub.io/rotation-interval": "720h",
+				"secret-generator.opendatahub.io/grace-period":      "1h",
+			},
+		},
+	}
+	generated := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "example-generated",
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Data: map[string][]byte{"password": []byte("old")},
+	}
+
+	secret, err := secretgenerator.ParseSecret(source.Annotations)
+	require.NoError(t, err)
+
+	// the first rotation is scheduled from the creation of the generated secret
+	assert.Equal(t, created.Add(720*time.Hour), secret.NextTransition(generated))
+	assert.False(t, secret.RotationDue(source, generated, created.Add(719*time.Hour)))
+
+	rotated := created.Add(720 * time.Hour)
+	require.True(t, secret.RotationDue(source, generated, rotated))
+	require.NoError(t, secret.Generate(nil))
+	secret.Rotate(source, generated, rotated)
+
+	assert.Equal(t, secret.Value, string(generated.Data["password"]))
+	assert.Equal(t, "old", string(generated.Data["password.previous"]))
+	assert.Equal(t, rotated, secretgenerator.LastRotation(generated))
+
+	// the previous value expires at the end of the grace period
+	assert.Equal(t, rotated.Add(time.Hour), secret.NextTransition(generated))
+	assert.False(t, secret.ExpirePreviousValues(generated, rotated.Add(30*time.Minute)))
+	assert.True(t, secret.ExpirePreviousValues(generated, rotated.Add(time.Hour)))
+	assert.NotContains(t, generated.Data, "password.previous")
+	assert.Equal(t, rotated.Add(720*time.Hour), secret.NextTransition(generated))
+
+	// a change of the rotate annotation rotates the secret at once
+	source.Annotations["secret-generator.opendatahub.io/rotate"] = "1"
+	assert.True(t, secret.RotationDue(source, generated, rotated.Add(time.Hour)))
+	secret.Rotate(source, generated, rotated.Add(time.Hour))
+	assert.False(t, secret.RotationDue(source, generated, rotated.Add(2*time.Hour)))
+}
@@ -1,11 +1,24 @@
 package secretgenerator
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/pem"
 	"errors"
+	"fmt"
 	"math/big"
 	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"sigs.k8s.io/yaml"
 
 	annotation "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
@@ -20,40 +33,142 @@ const (
 	errNameAnnotationNotFound = "name annotation not found in secret"
 	errTypeAnnotationNotFound = "type annotation not found in secret"
 	errUnsupportedType        = "secret type is not supported"
+	errUsernameNotFound       = "username annotation not found in secret"
+	errHostsNotFound          = "hosts annotation not found in secret"
+	errHtpasswdTooLong        = "htpasswd password exceeds the 72 bytes supported by bcrypt"
+	errNoCertificateIssuer    = "no certificate issuer to generate the secret"
 )
 
+// Types of the generated secrets.
+const (
+	RandomType   = "random"
+	OAuthType    = "oauth"
+	UUIDType     = "uuid"
+	RSAType      = "rsa"
+	ECDSAType    = "ecdsa"
+	HtpasswdType = "htpasswd"
+	JWTType      = "jwt"
+	TLSType      = "tls"
+)
+
+// Named character sets of the random values, any other charset annotation is used as the set of characters.
+const (
+	AlphanumericCharset          = "alphanumeric"
+	AlphabeticCharset            = "alphabetic"
+	NumericCharset               = "numeric"
+	LowercaseAlphanumericCharset = "lowercase-alphanumeric"
+	HexCharset                   = "hex"
+	SymbolsCharset               = "alphanumeric-symbols"
+)
+
+const (
+	// DefaultGracePeriod is the time the previous values of a rotated secret are kept for.
+	DefaultGracePeriod = 24 * time.Hour
+
+	// PreviousKeySuffix is appended to the keys holding the previous values of a rotated secret.
+	PreviousKeySuffix = ".previous"
+
+	minRSAKeySize = 2048
+	maxRSAKeySize = 8192
+	minJWTKeySize = 32
+
+	// maxHtpasswdLength is the maximum length of the password of the htpasswd entries, hashed with bcrypt.
+	maxHtpasswdLength = 72
+	// htpasswdBcryptPrefix is the prefix of the bcrypt hashes written by htpasswd, equivalent to the $2a$
+	// prefix of the hashes generated in Go.
+	htpasswdBcryptPrefix = "$2y$"
+)
+
+var charsets = map[string]string{
+	AlphanumericCharset:          letterRunes,
+	AlphabeticCharset:            "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz",
+	NumericCharset:               "0123456789",
+	LowercaseAlphanumericCharset: "0123456789abcdefghijklmnopqrstuvwxyz",
+	HexCharset:                   "0123456789abcdef",
+	SymbolsCharset:               letterRunes + "!#$%&*+-.:=?@^_~",
+}
+
+// CertificateIssuer issues the certificate of the hosts of a tls secret, returning the PEM encoded certificate,
+// private key and CA certificate.
+type CertificateIssuer func(hosts []string) ([]byte, []byte, []byte, error)
+
 type Secret struct {
 	Name             string
 	Type             string
 	Complexity       int
 	Value            string
 	OAuthClientRoute string
+	// Charset is the name, or the characters, of the character set of the random values.
+	Charset string
+	// Username is the user of the htpasswd entries.
+	Username string
+	// Hosts are the DNS names or IP addresses the tls certificates are issued for.
+	Hosts []string
+	// RotationInterval is the time after which the secret is rotated, zero when it is not rotated on a schedule.
+	RotationInterval time.Duration
+	// GracePeriod is the time the previous values are kept for after a rotation.
+	GracePeriod time.Duration
+	// Data holds the generated values by key, Value being the one of the Name key.
+	Data map[string]string
+}
+
+// SecretSpec is the configuration of a generated secret, held by the spec annotation.
+type SecretSpec struct {
+	Name             string   `json:"name,omitempty"`
+	Type             string   `json:"type,omitempty"`
+	Complexity       int      `json:"complexity,omitempty"`
+	Charset          string   `json:"charset,omitempty"`
+	Username         string   `json:"username,omitempty"`
+	Hosts            []string `json:"hosts,omitempty"`
+	OAuthClientRoute string   `json:"oauthClientRoute,omitempty"`
+	RotationInterval string   `json:"rotationInterval,omitempty"`
+	GracePeriod      string   `json:"gracePeriod,omitempty"`
 }
 
 func NewSecretFrom(annotations map[string]string) (*Secret, error) {
+	secret, err := ParseSecret(annotations)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := secret.Generate(nil); err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
+// ParseSecret returns the configuration of the secret generated for the annotations of a Secret, without
+// generating its values.
+func ParseSecret(annotations map[string]string) (*Secret, error) {
 	// Check if annotations is not empty
 	if len(annotations) == 0 {
 		return nil, errors.New(errEmptyAnnotation)
 	}
 
+	values, err := withSpec(annotations)
+	if err != nil {
+		return nil, err
+	}
+
 	var secret Secret
 
 	// Get name from annotation
-	if secretName, found := annotations[annotation.SecretNameAnnotation]; found {
+	if secretName, found := values[annotation.SecretNameAnnotation]; found {
 		secret.Name = secretName
 	} else {
 		return nil, errors.New(errNameAnnotationNotFound)
 	}
 
 	// Get type from annotation
-	if secretType, found := annotations[annotation.SecretTypeAnnotation]; found {
+	if secretType, found := values[annotation.SecretTypeAnnotation]; found {
 		secret.Type = secretType
 	} else {
 		return nil, errors.New(errTypeAnnotationNotFound)
 	}
 
 	// Get complexity from annotation
-	if secretComplexity, found := annotations[annotation.SecretLengthAnnotation]; found {
+	if secretComplexity, found := values[annotation.SecretLengthAnnotation]; found {
 		secretComplexity, err := strconv.Atoi(secretComplexity)
 		if err != nil {
 			return nil, err
@@ -63,51 +178,284 @@ func NewSecretFrom(annotations map[string]string) (*Secret, error) {
 		secret.Complexity = SECRET_DEFAULT_COMPLEXITY
 	}
 
-	if secretOAuthClientRoute, found := annotations[annotation.SecretOauthClientAnnotation]; found {
+	if secretOAuthClientRoute, found := values[annotation.SecretOauthClientAnnotation]; found {
 		secret.OAuthClientRoute = secretOAuthClientRoute
 	}
 
-	if err := generateSecretValue(&secret); err != nil {
+	secret.Charset = AlphanumericCharset
+	if charset, found := values[annotation.SecretCharsetAnnotation]; found {
+		if _, err := charsetRunes(charset); err != nil {
+			return nil, err
+		}
+		secret.Charset = charset
+	}
+
+	secret.Username = values[annotation.SecretUsernameAnnotation]
+
+	for _, h := range strings.Split(values[annotation.SecretHostsAnnotation], ",") {
+		if h = strings.TrimSpace(h); h != "" {
+			secret.Hosts = append(secret.Hosts, h)
+		}
+	}
+
+	if secret.RotationInterval, err = parseDuration(values, annotation.SecretRotationIntervalAnnotation, 0); err != nil {
+		return nil, err
+	}
+	if secret.GracePeriod, err = parseDuration(values, annotation.SecretGracePeriodAnnotation, DefaultGracePeriod); err != nil {
 		return nil, err
 	}
 
+	switch secret.Type {
+	case RandomType, OAuthType, UUIDType, RSAType, ECDSAType, JWTType:
+	case HtpasswdType:
+		if secret.Username == "" {
+			return nil, errors.New(errUsernameNotFound)
+		}
+		if secret.Complexity > maxHtpasswdLength {
+			return nil, errors.New(errHtpasswdTooLong)
+		}
+	case TLSType:
+		if len(secret.Hosts) == 0 {
+			return nil, errors.New(errHostsNotFound)
+		}
+	default:
+		return nil, errors.New(errUnsupportedType)
+	}
+
 	return &secret, nil
 }
 
 func NewSecret(name, secretType string, complexity int) (*Secret, error) {
 	secret := &Secret{
-		Name:       name,
-		Type:       secretType,
-		Complexity: complexity,
+		Name:        name,
+		Type:        secretType,
+		Complexity:  complexity,
+		Charset:     AlphanumericCharset,
+		GracePeriod: DefaultGracePeriod,
 	}
 
-	err := generateSecretValue(secret)
+	err := secret.Generate(nil)
 
 	return secret, err
 }
 
-func generateSecretValue(secret *Secret) error {
+// Generate generates new values of the secret. The issuer is only used by the tls secrets.
+func (s *Secret) Generate(issuer CertificateIssuer) error {
+	return generateSecretValue(s, issuer)
+}
+
+// withSpec returns the annotations with the fields of the spec annotation, if any, the annotations taking
+// precedence.
+func withSpec(annotations map[string]string) (map[string]string, error) {
+	data, found := annotations[annotation.SecretSpecAnnotation]
+	if !found {
+		return annotations, nil
+	}
+
+	spec := SecretSpec{}
+	if err := yaml.UnmarshalStrict([]byte(data), &spec); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %w", annotation.SecretSpecAnnotation, err)
+	}
+
+	values := map[string]string{}
+	set := func(key string, value string) {
+		if value != "" {
+			values[key] = value
+		}
+	}
+
+	set(annotation.SecretNameAnnotation, spec.Name)
+	set(annotation.SecretTypeAnnotation, spec.Type)
+	if spec.Complexity != 0 {
+		set(annotation.SecretLengthAnnotation, strconv.Itoa(spec.Complexity))
+	}
+	set(annotation.SecretCharsetAnnotation, spec.Charset)
+	set(annotation.SecretUsernameAnnotation, spec.Username)
+	set(annotation.SecretHostsAnnotation, strings.Join(spec.Hosts, ","))
+	set(annotation.SecretOauthClientAnnotation, spec.OAuthClientRoute)
+	set(annotation.SecretRotationIntervalAnnotation, spec.RotationInterval)
+	set(annotation.SecretGracePeriodAnnotation, spec.GracePeriod)
+
+	for k, v := range annotations {
+		values[k] = v
+	}
+
+	return values, nil
+}
+
+func parseDuration(values map[string]string, key string, defaultValue time.Duration) (time.Duration, error) {
+	value, found := values[key]
+	if !found {
+		return defaultValue, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s annotation: %w", key, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("invalid %s annotation: negative duration %s", key, value)
+	}
+
+	return d, nil
+}
+
+// charsetRunes returns the characters of a named character set, or the distinct characters of a custom one, which
+// must hold at least two printable ASCII characters.
+func charsetRunes(charset string) (string, error) {
+	if runes, found := charsets[charset]; found {
+		return runes, nil
+	}
+
+	var sb strings.Builder
+
+	for _, c := range charset {
+		if c <= ' ' || c > '~' {
+			return "", fmt.Errorf("invalid charset %q: only printable ASCII characters are supported", charset)
+		}
+		if !strings.ContainsRune(sb.String(), c) {
+			sb.WriteRune(c)
+		}
+	}
+
+	if sb.Len() < 2 {
+		return "", fmt.Errorf("invalid charset %q: at least two distinct characters are required", charset)
+	}
+
+	return sb.String(), nil
+}
+
+func randomString(length int, charset string) (string, error) {
+	runes, err := charsetRunes(charset)
+	if err != nil {
+		return "", err
+	}
+
+	randomValue := make([]byte, length)
+	for i := range length {
+		num, err := rand.Int(rand.Reader, big.NewInt(int64(len(runes))))
+		if err != nil {
+			return "", err
+		}
+		randomValue[i] = runes[num.Int64()]
+	}
+
+	return string(randomValue), nil
+}
+
+func generateSecretValue(secret *Secret, issuer CertificateIssuer) error {
+	values := map[string]string{}
+
 	switch secret.Type {
-	case "random":
-		randomValue := make([]byte, secret.Complexity)
-		for i := range secret.Complexity {
-			num, err := rand.Int(rand.Reader, big.NewInt(int64(len(letterRunes))))
-			if err != nil {
-				return err
-			}
-			randomValue[i] = letterRunes[num.Int64()]
-		}
-		secret.Value = string(randomValue)
-	case "oauth":
+	case RandomType:
+		value, err := randomString(secret.Complexity, secret.Charset)
+		if err != nil {
+			return err
+		}
+		values[secret.Name] = value
+	case OAuthType:
 		randomValue := make([]byte, secret.Complexity)
 		if _, err := rand.Read(randomValue); err != nil {
 			return err
 		}
-		secret.Value = base64.StdEncoding.EncodeToString(
+		values[secret.Name] = base64.StdEncoding.EncodeToString(
 			[]byte(base64.StdEncoding.EncodeToString(randomValue)))
+	case UUIDType:
+		id, err := uuid.NewRandom()
+		if err != nil {
+			return err
+		}
+		values[secret.Name] = id.String()
+	case RSAType:
+		bits := max(secret.Complexity, minRSAKeySize)
+		if bits > maxRSAKeySize {
+			return fmt.Errorf("unsupported RSA key size %d, the maximum is %d", bits, maxRSAKeySize)
+		}
+		key, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			return err
+		}
+		if err := setKeyPair(values, secret.Name, key); err != nil {
+			return err
+		}
+	case ECDSAType:
+		curve, err := ecdsaCurve(secret.Complexity)
+		if err != nil {
+			return err
+		}
+		key, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			return err
+		}
+		if err := setKeyPair(values, secret.Name, key); err != nil {
+			return err
+		}
+	case HtpasswdType:
+		password, err := randomString(secret.Complexity, secret.Charset)
+		if err != nil {
+			return err
+		}
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			return err
+		}
+		values[secret.Name] = secret.Username + ":" + htpasswdBcryptPrefix + strings.TrimPrefix(string(hash), "$2a$")
+		values[secret.Name+".password"] = password
+	case JWTType:
+		key := make([]byte, max(secret.Complexity, minJWTKeySize))
+		if _, err := rand.Read(key); err != nil {
+			return err
+		}
+		values[secret.Name] = base64.RawURLEncoding.EncodeToString(key)
+	case TLSType:
+		if issuer == nil {
+			return errors.New(errNoCertificateIssuer)
+		}
+		certPEM, keyPEM, caPEM, err := issuer(secret.Hosts)
+		if err != nil {
+			return err
+		}
+		values[secret.Name+".crt"] = string(certPEM)
+		values[secret.Name+".key"] = string(keyPEM)
+		values["ca.crt"] = string(caPEM)
 	default:
 		return errors.New(errUnsupportedType)
 	}
 
+	secret.Data = values
+	secret.Value = values[secret.Name]
+
+	return nil
+}
+
+// setKeyPair sets the PKCS #8 private key under the name key, and the PKIX public key under the name.pub key.
+func setKeyPair(values map[string]string, name string, key crypto.Signer) error {
+	privateDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	publicDER, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		return err
+	}
+
+	values[name] = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}))
+	values[name+".pub"] = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}))
+
 	return nil
 }
+
+// ecdsaCurve returns the curve of an ECDSA key size, P-256 by default.
+func ecdsaCurve(size int) (elliptic.Curve, error) {
+	switch {
+	case size <= 256:
+		return elliptic.P256(), nil
+	case size == 384:
+		return elliptic.P384(), nil
+	case size == 521:
+		return elliptic.P521(), nil
+	default:
+		return nil, fmt.Errorf("unsupported ECDSA key size %d, expected 256, 384 or 521", size)
+	}
+}
@@ -1,12 +1,19 @@
 package secretgenerator_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"errors"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/secretgenerator"
 )
@@ -198,3 +205,180 @@ func TestNewSecretFrom(t *testing.T) {
 		})
 	}
 }
+
+func TestNewSecretTypes(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		keys        []string
+		check       func(t *testing.T, secret *secretgenerator.Secret)
+	}{
+		{
+			name: "uuid",
+			annotations: map[string]string{
+				"secret-generator.opendatahub.io/name": "id",
+				"secret-generator.opendatahub.io/type": "uuid",
+			},
+			keys: []string{"id"},
+			check: func(t *testing.T, secret *secretgenerator.Secret) {
+				t.Helper()
+				assert.Regexp(t, "^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$", secret.Value)
+			},
+		},
+		{
+			name: "rsa keypair",
+			annotations: map[string]string{
+				"secret-generator.opendatahub.io/name": "signing",
+				"secret-generator.opendatahub.io/type": "rsa",
+			},
+			keys: []string{"signing", "signing.pub"},
+			check: func(t *testing.T, secret *secretgenerator.Secret) {
+				t.Helper()
+				block, _ := pem.Decode([]byte(secret.Value))
+				require.NotNil(t, block)
+				key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+				require.NoError(t, err)
+				rsaKey, ok := key.(*rsa.PrivateKey)
+				require.True(t, ok)
+				assert.Equal(t, 2048, rsaKey.N.BitLen())
+			},
+		},
+		{
+			name: "ecdsa keypair",
+			annotations: map[string]string{
+				"secret-generator.opendatahub.io/name":       "signing",
+				"secret-generator.opendatahub.io/type":       "ecdsa",
+				"secret-generator.opendatahub.io/complexity": "384",
+			},
+			keys: []string{"signing", "signing.pub"},
+			check: func(t *testing.T, secret *secretgenerator.Secret) {
+				t.Helper()
+				block, _ := pem.Decode([]byte(secret.Data["signing.pub"]))
+				require.NotNil(t, block)
+				key, err := x509.ParsePKIXPublicKey(block.Bytes)
+				require.NoError(t, err)
+				ecdsaKey, ok := key.(*ecdsa.PublicKey)
+				require.True(t, ok)
+				assert.Equal(t, "P-384", ecdsaKey.Curve.Params().Name)
+			},
+		},
+		{
+			name: "htpasswd",
+			annotations: map[string]string{
+				"secret-generator.opendatahub.io/name":     "auth",
+				"secret-generator.opendatahub.io/type":     "htpasswd",
+				"secret-generator.opendatahub.io/username": "admin",
+				"secret-generator.opendatahub.io/charset":  "numeric",
+			},
+			keys: []string{"auth", "auth.password"},
+			check: func(t *testing.T, secret *secretgenerator.Secret) {
+				t.Helper()
+				assert.Regexp(t, "^[0-9]{16}$", secret.Data["auth.password"])
+				user, hash, found := strings.Cut(secret.Value, ":")
+				require.True(t, found)
+				assert.Equal(t, "admin", user)
+				assert.True(t, strings.HasPrefix(hash, "$2y$"))
+				require.NoError(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte(secret.Data["auth.password"])))
+			},
+		},
+		{
+			name: "jwt signing key",
+			annotations: map[string]string{
+				"secret-generator.opendatahub.io/name": "jwt",
+				"secret-generator.opendatahub.io/type": "jwt",
+			},
+			keys: []string{"jwt"},
+			check: func(t *testing.T, secret *secretgenerator.Secret) {
+				t.Helper()
+				key, err := base64.RawURLEncoding.DecodeString(secret.Value)
+				require.NoError(t, err)
+				assert.Len(t, key, 32)
+			},
+		},
+		{
+			name: "random with custom charset from spec",
+			annotations: map[string]string{
+				"secret-generator.opendatahub.io/spec":       `{"name": "password", "type": "random", "charset": "ab"}`,
+				"secret-generator.opendatahub.io/complexity": "64",
+			},
+			keys: []string{"password"},
+			check: func(t *testing.T, secret *secretgenerator.Secret) {
+				t.Helper()
+				assert.Regexp(t, "^[ab]{64}$", secret.Value)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret, err := secretgenerator.NewSecretFrom(tt.annotations)
+			require.NoError(t, err)
+
+			keys := make([]string, 0, len(secret.Data))
+			for k := range secret.Data {
+				keys = append(keys, k)
+			}
+			assert.ElementsMatch(t, tt.keys, keys)
+
+			tt.check(t, secret)
+		})
+	}
+}
+
+func TestParseSecretErrors(t *testing.T) {
+	tests := map[string]map[string]string{
+		"htpasswd without username": {
+			"secret-generator.opendatahub.io/name": "auth",
+			"secret-generator.opendatahub.io/type": "htpasswd",
+		},
+		"htpasswd password longer than bcrypt supports": {
+			"secret-generator.opendatahub.io/name":       "auth",
+			"secret-generator.opendatahub.io/type":       "htpasswd",
+			"secret-generator.opendatahub.io/username":   "admin",
+			"secret-generator.opendatahub.io/complexity": "73",
+		},
+		"tls without hosts": {
+			"secret-generator.opendatahub.io/name": "tls",
+			"secret-generator.opendatahub.io/type": "tls",
+		},
+		"charset with a single character": {
+			"secret-generator.opendatahub.io/name":    "password",
+			"secret-generator.opendatahub.io/type":    "random",
+			"secret-generator.opendatahub.io/charset": "aaaa",
+		},
+		"invalid rotation interval": {
+			"secret-generator.opendatahub.io/name":              "password",
+			"secret-generator.opendatahub.io/type":              "random",
+			"secret-generator.opendatahub.io/rotation-interval": "monthly",
+		},
+		"unknown spec field": {
+			"secret-generator.opendatahub.io/spec": `{"name": "password", "type": "random", "length": 12}`,
+		},
+	}
+
+	for name, annotations := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := secretgenerator.ParseSecret(annotations)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestGenerateTLSSecret(t *testing.T) {
+	secret, err := secretgenerator.ParseSecret(map[string]string{
+		"secret-generator.opendatahub.io/name":  "tls",
+		"secret-generator.opendatahub.io/type":  "tls",
+		"secret-generator.opendatahub.io/hosts": "svc.ns.svc, svc.ns.svc.cluster.local",
+	})
+	require.NoError(t, err)
+
+	// the certificates are only issued by the controller
+	require.Error(t, secret.Generate(nil))
+
+	err = secret.Generate(func(hosts []string) ([]byte, []byte, []byte, error) {
+		assert.Equal(t, []string{"svc.ns.svc", "svc.ns.svc.cluster.local"}, hosts)
+		return []byte("cert"), []byte("key"), []byte("ca"), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"tls.crt": "cert", "tls.key": "key", "ca.crt": "ca"}, secret.Data)
+}
@@ -38,7 +38,8 @@ func (h *serviceHandler) GetManagementState(_ common.Platform, _ *dsciv1.DSCInit
 
 func (h *serviceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) error {
 	rec := &SecretGeneratorReconciler{
-		Client: mgr.GetClient(),
+		Client:   mgr.GetClient(),
+		Recorder: mgr.GetEventRecorderFor("secret-generator-controller"),
 	}
 
 	if err := rec.SetupWithManager(ctx, mgr); err != nil {
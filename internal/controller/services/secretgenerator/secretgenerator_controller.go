@@ -21,15 +21,20 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"time"
 
 	oauthv1 "github.com/openshift/api/oauth/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,30 +44,31 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	annotation "github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
 const (
 	resourceRetryInterval = 2 * time.Second
+	minRequeueInterval    = time.Second
 )
 
 // SecretGeneratorReconciler holds the controller configuration.
 type SecretGeneratorReconciler struct {
-	Client client.Client
+	Client   client.Client
+	Recorder record.EventRecorder
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *SecretGeneratorReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
 	logf.FromContext(ctx).Info("Adding controller for Secret Generation.")
 
-	// Watch only new secrets with the corresponding annotation
+	// Watch only secrets with the corresponding annotations
 	predicates := predicate.Funcs{
 		CreateFunc: func(e event.CreateEvent) bool {
-			if _, found := e.Object.GetAnnotations()[annotation.SecretNameAnnotation]; found {
-				return true
-			}
-
-			return false
+			return isGeneratorSecret(e.Object)
 		},
 		GenericFunc: func(e event.GenericEvent) bool {
 			return false
@@ -70,14 +76,11 @@ func (r *SecretGeneratorReconciler) SetupWithManager(ctx context.Context, mgr ct
 		// this only watch for secret deletion if has with annotation
 		// e.g. dashboard-oauth-client but not dashboard-oauth-client-generated
 		DeleteFunc: func(e event.DeleteEvent) bool {
-			if _, found := e.Object.GetAnnotations()[annotation.SecretNameAnnotation]; found {
-				return true
-			}
-
-			return false
+			return isGeneratorSecret(e.Object)
 		},
+		// the annotations are updated to configure, or rotate, the generated secret
 		UpdateFunc: func(e event.UpdateEvent) bool {
-			return false
+			return isGeneratorSecret(e.ObjectNew) && !maps.Equal(e.ObjectOld.GetAnnotations(), e.ObjectNew.GetAnnotations())
 		},
 	}
 
@@ -100,6 +103,8 @@ func (r *SecretGeneratorReconciler) SetupWithManager(ctx context.Context, mgr ct
 // Reconcile will generate new secret with random data for the annotated secret
 // based on the specified type and complexity. This will avoid possible race
 // conditions when a deployment mounts the secret before it is reconciled.
+// The generated secret is then rotated on schedule, or when the rotate
+// annotation changes.
 func (r *SecretGeneratorReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
 	foundSecret := &corev1.Secret{}
 	err := r.Client.Get(ctx, request.NamespacedName, foundSecret)
@@ -117,6 +122,12 @@ func (r *SecretGeneratorReconciler) Reconcile(ctx context.Context, request ctrl.
 		return ctrl.Result{}, nil
 	}
 
+	secret, err := ParseSecret(foundSecret.GetAnnotations())
+	if err != nil {
+		r.event(foundSecret, corev1.EventTypeWarning, "InvalidSecretGenerator", "Invalid secret generator annotations: %v", err)
+		return ctrl.Result{}, err
+	}
+
 	// Generate the secret if it does not previously exist
 	generatedSecret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -126,20 +137,27 @@ func (r *SecretGeneratorReconciler) Reconcile(ctx context.Context, request ctrl.
 	}
 
 	err = r.Client.Get(ctx, client.ObjectKeyFromObject(generatedSecret), generatedSecret)
-	if err == nil || !k8serr.IsNotFound(err) {
-		return ctrl.Result{}, err
+	switch {
+	case k8serr.IsNotFound(err):
+		err = r.generateSecret(ctx, foundSecret, generatedSecret, secret)
+	case err == nil:
+		err = r.rotateSecret(ctx, foundSecret, generatedSecret, secret)
 	}
 
-	err = r.generateSecret(ctx, foundSecret, generatedSecret)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	// Don't requeue if secret is created successfully
-	return ctrl.Result{}, nil
+	// Requeue for the next rotation, or expiry of the previous values, if any
+	next := secret.NextTransition(generatedSecret)
+	if next.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	return ctrl.Result{RequeueAfter: max(time.Until(next), minRequeueInterval)}, nil
 }
 
-func (r *SecretGeneratorReconciler) generateSecret(ctx context.Context, foundSecret *corev1.Secret, generatedSecret *corev1.Secret) error {
+func (r *SecretGeneratorReconciler) generateSecret(ctx context.Context, foundSecret *corev1.Secret, generatedSecret *corev1.Secret, secret *Secret) error {
 	log := logf.FromContext(ctx).WithName("SecretGenerator")
 
 	// Generate secret random value
@@ -152,39 +170,95 @@ func (r *SecretGeneratorReconciler) generateSecret(ctx context.Context, foundSec
 		*metav1.NewControllerRef(foundSecret, foundSecret.GroupVersionKind()),
 	}
 
-	secret, err := NewSecretFrom(foundSecret.GetAnnotations())
-	if err != nil {
+	if err := secret.Generate(r.certificateIssuer(ctx)); err != nil {
 		log.Error(err, "error creating secret %s in %s", generatedSecret.Name, generatedSecret.Namespace)
+		r.event(foundSecret, corev1.EventTypeWarning, "SecretGenerationFailed", "Failed to generate secret %s: %v", generatedSecret.Name, err)
 		return err
 	}
 
-	generatedSecret.StringData = map[string]string{
-		secret.Name: secret.Value,
-	}
+	generatedSecret.StringData = secret.Data
+	secret.SetRotationAnnotations(foundSecret, generatedSecret, time.Now())
 
-	err = r.Client.Create(ctx, generatedSecret)
+	err := r.Client.Create(ctx, generatedSecret)
 	if err != nil {
 		return err
 	}
 
 	log.Info("Done generating secret in namespace",
 		"secret", generatedSecret.Name, "namespace", generatedSecret.Namespace)
+	r.event(foundSecret, corev1.EventTypeNormal, "SecretGenerated", "Generated secret %s", generatedSecret.Name)
+
+	return r.reconcileOAuthClient(ctx, foundSecret, secret, nil)
+}
+
+// rotateSecret rotates the generated secret when due, and removes its previous values once the grace period
+// elapsed.
+func (r *SecretGeneratorReconciler) rotateSecret(ctx context.Context, foundSecret *corev1.Secret, generatedSecret *corev1.Secret, secret *Secret) error {
+	log := logf.FromContext(ctx).WithName("SecretGenerator")
+	now := time.Now()
+
+	switch {
+	case secret.RotationDue(foundSecret, generatedSecret, now):
+		log.Info("Rotating secret in namespace", "secret", generatedSecret.Name, "namespace", generatedSecret.Namespace)
+
+		if err := secret.Generate(r.certificateIssuer(ctx)); err != nil {
+			r.event(foundSecret, corev1.EventTypeWarning, "SecretRotationFailed", "Failed to rotate secret %s: %v", generatedSecret.Name, err)
+			return err
+		}
+
+		secret.Rotate(foundSecret, generatedSecret, now)
+
+		if err := r.Client.Update(ctx, generatedSecret); err != nil {
+			return fmt.Errorf("failed to update secret %s: %w", generatedSecret.Name, err)
+		}
+
+		r.event(foundSecret, corev1.EventTypeNormal, "SecretRotated", "Rotated secret %s", generatedSecret.Name)
+	case secret.ExpirePreviousValues(generatedSecret, now):
+		log.Info("Removing previous values of secret in namespace", "secret", generatedSecret.Name, "namespace", generatedSecret.Namespace)
+
+		if err := r.Client.Update(ctx, generatedSecret); err != nil {
+			return fmt.Errorf("failed to update secret %s: %w", generatedSecret.Name, err)
+		}
+
+		secret.Value = string(generatedSecret.Data[secret.Name])
+	default:
+		return nil
+	}
+
+	// the OAuth client accepts the previous value during the grace period
+	var additionalSecrets []string
+	if previous, found := generatedSecret.Data[PreviousKey(secret.Name)]; found {
+		additionalSecrets = []string{string(previous)}
+	}
+
+	return r.reconcileOAuthClient(ctx, foundSecret, secret, additionalSecrets)
+}
+
+// reconcileOAuthClient creates, or updates, the OAuthClient of the secret, when it has an OAuth client route.
+func (r *SecretGeneratorReconciler) reconcileOAuthClient(ctx context.Context, foundSecret *corev1.Secret, secret *Secret, additionalSecrets []string) error {
+	log := logf.FromContext(ctx).WithName("SecretGenerator")
 
 	// check if annotation oauth-client-route exists
 	if secret.OAuthClientRoute == "" {
 		return nil
 	}
 
-	// Get OauthClient Route
-	oauthClientRoute, err := r.getRoute(ctx, secret.OAuthClientRoute, foundSecret.Namespace)
+	// OAuthClients are served by the OpenShift OAuth server only
+	if cluster.IsKubernetes() {
+		log.Info("Skipping OAuthClient, not supported on Kubernetes", "secret-name", foundSecret.Name)
+		return nil
+	}
+
+	// Get OauthClient Route host
+	host, err := r.getRouteHost(ctx, secret.OAuthClientRoute, foundSecret.Namespace)
 	if err != nil {
 		log.Error(err, "Unable to retrieve route from OAuthClient", "route-name", secret.OAuthClientRoute)
 		return err
 	}
 
 	// Generate OAuthClient for the generated secret
-	log.Info("Generating an OAuthClient CR for route", "route-name", oauthClientRoute.Name)
-	err = r.createOAuthClient(ctx, foundSecret.Name, secret.Value, oauthClientRoute.Spec.Host)
+	log.Info("Generating an OAuthClient CR for route", "route-name", secret.OAuthClientRoute)
+	err = r.createOAuthClient(ctx, foundSecret.Name, secret.Value, additionalSecrets, host)
 	if err != nil {
 		log.Error(err, "error creating oauth client resource. Recreate the Secret", "secret-name",
 			foundSecret.Name)
@@ -195,9 +269,47 @@ func (r *SecretGeneratorReconciler) generateSecret(ctx context.Context, foundSec
 	return nil
 }
 
-// getRoute returns an OpenShift route object. It waits until the .spec.host value exists to avoid possible race conditions, fails otherwise.
-func (r *SecretGeneratorReconciler) getRoute(ctx context.Context, name string, namespace string) (*routev1.Route, error) {
-	route := &routev1.Route{}
+// certificateIssuer returns the issuer of the certificates of the tls secrets, signing them with the internal CA of
+// the operator, with the options of the certificates configured in the DSCInitialization.
+func (r *SecretGeneratorReconciler) certificateIssuer(ctx context.Context) CertificateIssuer {
+	return func(hosts []string) ([]byte, []byte, []byte, error) {
+		opts := cluster.NewCertificateOptions(nil)
+
+		dsci, err := cluster.GetDSCI(ctx, r.Client)
+		switch {
+		case k8serr.IsNotFound(err):
+		case err != nil:
+			return nil, nil, nil, err
+		default:
+			opts = cluster.NewCertificateOptions(dsci.Spec.Certificates)
+		}
+
+		return cluster.IssueCertificate(ctx, r.Client, hosts, opts)
+	}
+}
+
+func (r *SecretGeneratorReconciler) event(obj runtime.Object, eventType string, reason string, messageFmt string, args ...any) {
+	if r.Recorder == nil {
+		return
+	}
+
+	r.Recorder.Eventf(obj, eventType, reason, messageFmt, args...)
+}
+
+// isGeneratorSecret returns true when the Secret has the annotations of the secret generator.
+func isGeneratorSecret(obj client.Object) bool {
+	a := obj.GetAnnotations()
+	_, hasName := a[annotation.SecretNameAnnotation]
+	_, hasSpec := a[annotation.SecretSpecAnnotation]
+
+	return hasName || hasSpec
+}
+
+// getRouteHost returns the host of an OpenShift route or, when the route has been replaced by an HTTPRoute of the
+// same name attached to the platform Gateway, the host of the HTTPRoute. It waits until the host exists, and the
+// HTTPRoute is accepted by the Gateway, to avoid possible race conditions, fails otherwise.
+func (r *SecretGeneratorReconciler) getRouteHost(ctx context.Context, name string, namespace string) (string, error) {
+	host := ""
 	// Get spec.host from route
 	backoff := wait.Backoff{
 		Duration: resourceRetryInterval,
@@ -206,26 +318,51 @@ func (r *SecretGeneratorReconciler) getRoute(ctx context.Context, name string, n
 	}
 	// 1 minute timeout
 	err := wait.ExponentialBackoffWithContext(ctx, backoff, func(ctx context.Context) (bool, error) {
+		route := &routev1.Route{}
 		err := r.Client.Get(ctx, client.ObjectKey{
 			Name:      name,
 			Namespace: namespace,
 		}, route)
-		if err != nil {
-			return false, client.IgnoreNotFound(err)
+		switch {
+		case err == nil:
+			host = route.Spec.Host
+			return host != "", nil
+		case !k8serr.IsNotFound(err) && !meta.IsNoMatchError(err):
+			return false, err
 		}
-		if route.Spec.Host == "" {
+
+		hr := unstructured.Unstructured{}
+		hr.SetGroupVersionKind(gvk.HTTPRoute)
+		err = r.Client.Get(ctx, client.ObjectKey{
+			Name:      name,
+			Namespace: namespace,
+		}, &hr)
+		switch {
+		case k8serr.IsNotFound(err) || meta.IsNoMatchError(err):
 			return false, nil
+		case err != nil:
+			return false, err
+		case !resources.HTTPRouteAccepted(hr):
+			return false, nil
+		}
+
+		hostnames, _, err := unstructured.NestedStringSlice(hr.Object, "spec", "hostnames")
+		if err != nil || len(hostnames) == 0 {
+			return false, err
 		}
+
+		host = hostnames[0]
+
 		return true, nil
 	})
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	return route, err
+	return host, nil
 }
 
-func (r *SecretGeneratorReconciler) createOAuthClient(ctx context.Context, name string, secretName string, uri string) error {
+func (r *SecretGeneratorReconciler) createOAuthClient(ctx context.Context, name string, secretName string, additionalSecrets []string, uri string) error {
 	log := logf.FromContext(ctx)
 	// Create OAuthClient resource
 	oauthClient := &oauthv1.OAuthClient{
@@ -236,9 +373,10 @@ func (r *SecretGeneratorReconciler) createOAuthClient(ctx context.Context, name
 		ObjectMeta: metav1.ObjectMeta{
 			Name: name,
 		},
-		Secret:       secretName,
-		RedirectURIs: []string{"https://" + uri},
-		GrantMethod:  oauthv1.GrantHandlerAuto,
+		Secret:            secretName,
+		AdditionalSecrets: additionalSecrets,
+		RedirectURIs:      []string{"https://" + uri},
+		GrantMethod:       oauthv1.GrantHandlerAuto,
 	}
 
 	err := r.Client.Create(ctx, oauthClient)
@@ -261,6 +399,10 @@ func (r *SecretGeneratorReconciler) createOAuthClient(ctx context.Context, name
 }
 
 func (r *SecretGeneratorReconciler) deleteOAuthClient(ctx context.Context, secretNamespacedName types.NamespacedName) error {
+	if cluster.IsKubernetes() {
+		return nil
+	}
+
 	oauthClient := &oauthv1.OAuthClient{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      secretNamespacedName.Name,
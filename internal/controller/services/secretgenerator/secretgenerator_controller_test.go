@@ -0,0 +1,69 @@
+//nolint:testpackage
+package secretgenerator
+
+import (
+	"testing"
+
+	routev1 "github.com/openshift/api/route/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGetRouteHost(t *testing.T) {
+	t.Run("route", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli, err := fakeclient.New(fakeclient.WithObjects(&routev1.Route{
+			ObjectMeta: metav1.ObjectMeta{Name: "odh-dashboard", Namespace: "opendatahub"},
+			Spec:       routev1.RouteSpec{Host: "odh-dashboard-opendatahub.apps.example.com"},
+		}))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		r := SecretGeneratorReconciler{Client: cli}
+
+		host, err := r.getRouteHost(t.Context(), "odh-dashboard", "opendatahub")
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(host).Should(Equal("odh-dashboard-opendatahub.apps.example.com"))
+	})
+
+	t.Run("httproute replacing the route", func(t *testing.T) {
+		g := NewWithT(t)
+
+		s, err := scheme.New()
+		g.Expect(err).ShouldNot(HaveOccurred())
+		s.AddKnownTypeWithName(gvk.HTTPRoute, &unstructured.Unstructured{})
+
+		hr := &unstructured.Unstructured{Object: map[string]any{
+			"spec": map[string]any{
+				"hostnames": []any{"odh-dashboard-opendatahub.apps.example.com"},
+			},
+			"status": map[string]any{
+				"parents": []any{
+					map[string]any{
+						"conditions": []any{
+							map[string]any{"type": "Accepted", "status": "True"},
+						},
+					},
+				},
+			},
+		}}
+		hr.SetGroupVersionKind(gvk.HTTPRoute)
+		hr.SetName("odh-dashboard")
+		hr.SetNamespace("opendatahub")
+
+		cli, err := fakeclient.New(fakeclient.WithScheme(s), fakeclient.WithObjects(hr))
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		r := SecretGeneratorReconciler{Client: cli}
+
+		host, err := r.getRouteHost(t.Context(), "odh-dashboard", "opendatahub")
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(host).Should(Equal("odh-dashboard-opendatahub.apps.example.com"))
+	})
+}
@@ -35,9 +35,19 @@ const (
 	authorinoDir   = "authorino"
 	metricsDir     = "metrics-collection"
 	serviceMeshDir = "servicemesh"
+	sailDir        = "sail"
+	authConfigDir  = "authconfig"
 
 	authorinoOperatorName   = "authorino-operator"
 	serviceMeshOperatorName = "servicemeshoperator"
+
+	// the Sail operator requires its IstioCNI and ZTunnel resources to be named default.
+	sailDefaultName   = "default"
+	istioCNINamespace = "istio-cni"
+	ztunnelNamespace  = "ztunnel"
+
+	ingressGatewayName    = "istio-ingressgateway"
+	ingressGatewaySDSName = "istio-ingressgateway-sds"
 )
 
 var (
@@ -49,4 +59,29 @@ var (
 	serviceMonitorTemplate = path.Join(baseDir, metricsDir, "pilot-metrics-collection.tmpl.yaml")
 
 	serviceMeshControlPlaneTemplate = path.Join(baseDir, serviceMeshDir, "create-smcp.tmpl.yaml")
+
+	istioTemplate          = path.Join(baseDir, sailDir, "istio.tmpl.yaml")
+	istioCNITemplate       = path.Join(baseDir, sailDir, "istio-cni.tmpl.yaml")
+	ztunnelTemplate        = path.Join(baseDir, sailDir, "ztunnel.tmpl.yaml")
+	ingressGatewayTemplate = path.Join(baseDir, sailDir, "ingress-gateway.tmpl.yaml")
+
+	authorinoAuthorizationPolicyTemplate = path.Join(baseDir, sailDir, "authorino-authorizationpolicy.tmpl.yaml")
+
+	// authConfigTemplates are the templates of the AuthConfigs of the InferenceServices, by auth mode.
+	authConfigTemplates = map[string]string{
+		authModeKubernetes: path.Join(baseDir, authConfigDir, "kubernetes.tmpl.yaml"),
+		authModeAPIKey:     path.Join(baseDir, authConfigDir, "api-key.tmpl.yaml"),
+		authModeOIDC:       path.Join(baseDir, authConfigDir, "oidc.tmpl.yaml"),
+	}
+
+	// requestAuthenticationTemplate is the template of the RequestAuthentications of the InferenceServices in the
+	// OIDC auth mode.
+	requestAuthenticationTemplate = path.Join(baseDir, authConfigDir, "oidc-requestauthentication.tmpl.yaml")
+)
+
+// auth modes of the InferenceServices, set in their security.opendatahub.io/auth-mode annotation.
+const (
+	authModeKubernetes = "kubernetes"
+	authModeAPIKey     = "api-key"
+	authModeOIDC       = "oidc"
 )
@@ -0,0 +1,496 @@
+package servicemesh
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"text/template"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+const (
+	authConfigFieldOwner = resources.PlatformFieldOwner + "/authconfig"
+
+	// predictorAuthorizationPolicyName is the name of the AuthorizationPolicy deployed by KServe in the control plane
+	// namespace, delegating the authorization of the requests to the predictors to Authorino.
+	predictorAuthorizationPolicyName = "kserve-predictor"
+)
+
+// authPolicy is the effective auth policy of an InferenceService. It is set in the auth-policy annotation of the
+// InferenceService, its status being owned by KServe.
+type authPolicy struct {
+	Mode       string   `json:"mode"`
+	AuthConfig string   `json:"authConfig,omitempty"`
+	Hosts      []string `json:"hosts,omitempty"`
+	Ready      bool     `json:"ready"`
+	Message    string   `json:"message,omitempty"`
+}
+
+// InferenceServiceAuthPredicate triggers a reconciliation when the auth configuration, or the URLs, of an
+// InferenceService with an auth mode change.
+type InferenceServiceAuthPredicate struct {
+	predicate.Funcs
+}
+
+func (InferenceServiceAuthPredicate) Create(e event.CreateEvent) bool {
+	return hasAuthAnnotations(e.Object)
+}
+
+// Delete ignores the deletion of the InferenceServices, their AuthConfigs being garbage collected with them.
+func (InferenceServiceAuthPredicate) Delete(_ event.DeleteEvent) bool {
+	return false
+}
+
+func (InferenceServiceAuthPredicate) Generic(_ event.GenericEvent) bool {
+	return false
+}
+
+func (InferenceServiceAuthPredicate) Update(e event.UpdateEvent) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		return false
+	}
+
+	if !hasAuthAnnotations(e.ObjectOld) && !hasAuthAnnotations(e.ObjectNew) {
+		return false
+	}
+
+	for _, k := range []string{annotations.AuthMode, annotations.AuthOIDCIssuerURL} {
+		if resources.GetAnnotation(e.ObjectOld, k) != resources.GetAnnotation(e.ObjectNew, k) {
+			return true
+		}
+	}
+
+	oldObj, ok := e.ObjectOld.(*unstructured.Unstructured)
+	if !ok {
+		return false
+	}
+	newObj, ok := e.ObjectNew.(*unstructured.Unstructured)
+	if !ok {
+		return false
+	}
+
+	return !slices.Equal(inferenceServiceHosts(oldObj), inferenceServiceHosts(newObj))
+}
+
+func NewInferenceServiceAuthPredicate() *InferenceServiceAuthPredicate {
+	return &InferenceServiceAuthPredicate{}
+}
+
+func hasAuthAnnotations(obj client.Object) bool {
+	return resources.GetAnnotation(obj, annotations.AuthMode) != "" || resources.HasAnnotation(obj, annotations.AuthPolicy)
+}
+
+// reconcileAuthConfigs protects the endpoints of the InferenceServices annotated with an auth mode with an
+// AuthConfig rendered from the template of the mode, along with a RequestAuthentication in the OIDC mode, and
+// deletes the AuthConfigs and RequestAuthentications of the InferenceServices no longer annotated.
+func reconcileAuthConfigs(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	sm, ok := rr.Instance.(*serviceApi.ServiceMesh)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a serviceApi.ServiceMesh)", rr.Instance)
+	}
+
+	// Only proceed if ServiceMesh is in Managed state
+	if sm.Spec.ManagementState != operatorv1.Managed {
+		return nil
+	}
+
+	for _, k := range []schema.GroupVersionKind{gvk.InferenceServices, gvk.AuthConfig} {
+		found, err := cluster.HasCRD(ctx, rr.Client, k)
+		if err != nil {
+			return fmt.Errorf("failed to check if %s CRD exists: %w", k.Kind, err)
+		}
+		if !found {
+			return nil
+		}
+	}
+
+	// the RequestAuthentications are an additional validation of the OIDC tokens, skipped when not served
+	hasRequestAuthentication, err := cluster.HasCRD(ctx, rr.Client, gvk.RequestAuthentication)
+	if err != nil {
+		return fmt.Errorf("failed to check if %s CRD exists: %w", gvk.RequestAuthentication.Kind, err)
+	}
+
+	isvcs := unstructured.UnstructuredList{}
+	isvcs.SetGroupVersionKind(gvk.InferenceServices.GroupVersion().WithKind(gvk.InferenceServices.Kind + "List"))
+	if err := rr.Client.List(ctx, &isvcs); err != nil {
+		return fmt.Errorf("failed to list InferenceServices: %w", err)
+	}
+
+	decoder := serializer.NewCodecFactory(rr.Client.Scheme()).UniversalDeserializer()
+	desired := sets.New[string]()
+	desiredRequestAuthentications := sets.New[string]()
+
+	enforcement, err := authEnforcementMessage(ctx, rr, sm)
+	if err != nil {
+		return err
+	}
+
+	// the InferenceServices are reconciled independently, an error on one of them does not block the others
+	var errs []error
+
+	for i := range isvcs.Items {
+		isvc := &isvcs.Items[i]
+
+		mode := resources.GetAnnotation(isvc, annotations.AuthMode)
+		if mode == "" {
+			errs = append(errs, setAuthPolicy(ctx, rr.Client, isvc, nil))
+			continue
+		}
+
+		// the resources of an InferenceService with an invalid configuration, or failing to be applied, are kept as is
+		desired.Insert(isvc.GetNamespace() + "/" + authConfigName(isvc))
+		if mode == authModeOIDC && hasRequestAuthentication {
+			desiredRequestAuthentications.Insert(isvc.GetNamespace() + "/" + authConfigName(isvc))
+		}
+
+		errs = append(errs, reconcileInferenceServiceAuth(ctx, rr.Client, decoder, isvc, sm.Spec.Auth.Audiences, enforcement, hasRequestAuthentication))
+	}
+
+	errs = append(errs, deleteAuthResources(ctx, rr.Client, gvk.AuthConfig, desired))
+
+	if hasRequestAuthentication {
+		errs = append(errs, deleteAuthResources(ctx, rr.Client, gvk.RequestAuthentication, desiredRequestAuthentications))
+	}
+
+	return errors.Join(errs...)
+}
+
+// reconcileInferenceServiceAuth applies the AuthConfig, and the RequestAuthentication in the OIDC mode, of an
+// InferenceService annotated with an auth mode, and reports the effective policy in its auth-policy annotation.
+func reconcileInferenceServiceAuth(
+	ctx context.Context,
+	cli client.Client,
+	decoder runtime.Decoder,
+	isvc *unstructured.Unstructured,
+	audiences []string,
+	enforcement string,
+	hasRequestAuthentication bool,
+) error {
+	policy, err := applyAuthConfig(ctx, cli, decoder, isvc, audiences)
+	if err != nil {
+		return err
+	}
+
+	if policy.Mode == authModeOIDC && hasRequestAuthentication {
+		if err := applyRequestAuthentication(ctx, cli, decoder, isvc); err != nil {
+			return err
+		}
+	}
+
+	// a ready AuthConfig only protects the InferenceService once the requests are delegated to Authorino
+	if policy.Ready && enforcement != "" {
+		policy.Ready = false
+		policy.Message = enforcement
+	}
+
+	return setAuthPolicy(ctx, cli, isvc, policy)
+}
+
+// authEnforcementMessage returns a message explaining why the requests to the InferenceServices are not delegated
+// to Authorino, empty when they are: Authorino must be ready, and the AuthorizationPolicy deployed by KServe must
+// delegate the requests to the predictors to it.
+func authEnforcementMessage(ctx context.Context, rr *odhtypes.ReconciliationRequest, sm *serviceApi.ServiceMesh) (string, error) {
+	if c := rr.Conditions.GetCondition(status.CapabilityServiceMeshAuthorization); c == nil || c.Status != metav1.ConditionTrue {
+		return "Authorino is not ready, the AuthConfig is not enforced", nil
+	}
+
+	ap := &unstructured.Unstructured{}
+	ap.SetGroupVersionKind(gvk.AuthorizationPolicy)
+
+	err := rr.Client.Get(ctx, client.ObjectKey{Namespace: sm.Spec.ControlPlane.Namespace, Name: predictorAuthorizationPolicyName}, ap)
+	switch {
+	case k8serr.IsNotFound(err), meta.IsNoMatchError(err):
+		return fmt.Sprintf("AuthorizationPolicy %s/%s delegating the requests to Authorino not found, the AuthConfig is not enforced",
+			sm.Spec.ControlPlane.Namespace, predictorAuthorizationPolicyName), nil
+	case err != nil:
+		return "", fmt.Errorf("failed to get AuthorizationPolicy %s: %w", predictorAuthorizationPolicyName, err)
+	}
+
+	return "", nil
+}
+
+// applyAuthConfig applies the AuthConfig of the InferenceService, returning the effective policy. The invalid
+// configurations are reported in the message of the policy.
+func applyAuthConfig(
+	ctx context.Context,
+	cli client.Client,
+	decoder runtime.Decoder,
+	isvc *unstructured.Unstructured,
+	audiences []string,
+) (*authPolicy, error) {
+	policy := authPolicy{
+		Mode:  resources.GetAnnotation(isvc, annotations.AuthMode),
+		Hosts: inferenceServiceHosts(isvc),
+	}
+
+	if len(policy.Hosts) == 0 {
+		policy.Message = "waiting for the URL of the InferenceService"
+		return &policy, nil
+	}
+
+	ac, err := renderAuthConfig(decoder, isvc, policy.Mode, audiences, policy.Hosts)
+	if err != nil {
+		policy.Message = err.Error()
+		return &policy, nil
+	}
+
+	if err := controllerutil.SetOwnerReference(isvc, ac, cli.Scheme()); err != nil {
+		return nil, fmt.Errorf("failed to set the owner of AuthConfig %s: %w", resources.FormatUnstructuredName(ac), err)
+	}
+
+	err = resources.Apply(ctx, cli, ac, client.FieldOwner(authConfigFieldOwner), client.ForceOwnership)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply AuthConfig %s: %w", resources.FormatUnstructuredName(ac), err)
+	}
+
+	policy.AuthConfig = ac.GetName()
+	policy.Ready, policy.Message = isAuthConfigReady(ac)
+
+	return &policy, nil
+}
+
+// applyRequestAuthentication applies the RequestAuthentication validating the tokens of the issuer of an InferenceService
+// in the OIDC auth mode. An invalid issuer is reported in the auth policy by the AuthConfig.
+func applyRequestAuthentication(ctx context.Context, cli client.Client, decoder runtime.Decoder, isvc *unstructured.Unstructured) error {
+	issuerURL := resources.GetAnnotation(isvc, annotations.AuthOIDCIssuerURL)
+	if validateIssuerURL(issuerURL) != nil {
+		return nil
+	}
+
+	ra, err := renderAuthTemplate(decoder, isvc, requestAuthenticationTemplate, map[string]any{
+		"Name":             authConfigName(isvc),
+		"Namespace":        isvc.GetNamespace(),
+		"InferenceService": isvc.GetName(),
+		"IssuerURL":        issuerURL,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := controllerutil.SetOwnerReference(isvc, ra, cli.Scheme()); err != nil {
+		return fmt.Errorf("failed to set the owner of RequestAuthentication %s: %w", resources.FormatUnstructuredName(ra), err)
+	}
+
+	err = resources.Apply(ctx, cli, ra, client.FieldOwner(authConfigFieldOwner), client.ForceOwnership)
+	if err != nil {
+		return fmt.Errorf("failed to apply RequestAuthentication %s: %w", resources.FormatUnstructuredName(ra), err)
+	}
+
+	return nil
+}
+
+// renderAuthConfig renders the AuthConfig of the InferenceService from the template of the auth mode.
+func renderAuthConfig(
+	decoder runtime.Decoder,
+	isvc *unstructured.Unstructured,
+	mode string,
+	audiences []string,
+	hosts []string,
+) (*unstructured.Unstructured, error) {
+	tp, ok := authConfigTemplates[mode]
+	if !ok {
+		return nil, fmt.Errorf("unsupported auth mode %q, must be one of %s, %s or %s",
+			mode, authModeKubernetes, authModeAPIKey, authModeOIDC)
+	}
+
+	issuerURL := resources.GetAnnotation(isvc, annotations.AuthOIDCIssuerURL)
+	if mode == authModeOIDC {
+		if err := validateIssuerURL(issuerURL); err != nil {
+			return nil, err
+		}
+	}
+
+	return renderAuthTemplate(decoder, isvc, tp, map[string]any{
+		"Name":             authConfigName(isvc),
+		"Namespace":        isvc.GetNamespace(),
+		"InferenceService": isvc.GetName(),
+		"Hosts":            hosts,
+		"Audiences":        audiences,
+		"APIKeyLabel":      labels.AuthAPIKey,
+		"IssuerURL":        issuerURL,
+	})
+}
+
+// renderAuthTemplate renders a template holding a single resource protecting the InferenceService, labeled to be
+// selected by Authorino and to be cleaned up by the operator.
+func renderAuthTemplate(decoder runtime.Decoder, isvc *unstructured.Unstructured, tp string, data map[string]any) (*unstructured.Unstructured, error) {
+	tmpl, err := template.ParseFS(resourcesFS, tp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template %s: %w", tp, err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tmpl.Option("missingkey=error").Execute(&buffer, data); err != nil {
+		return nil, fmt.Errorf("failed to execute template %s: %w", tp, err)
+	}
+
+	u, err := resources.Decode(decoder, buffer.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode template %s: %w", tp, err)
+	}
+	if len(u) != 1 {
+		return nil, fmt.Errorf("template %s must hold a single resource, found %d resources", tp, len(u))
+	}
+
+	// the AuthConfigs must match the label selector of the Authorino instance
+	groupKey, groupValue, _ := strings.Cut(authorinoLabel, "=")
+
+	resources.SetLabels(&u[0], map[string]string{
+		groupKey:                groupValue,
+		labels.PlatformPartOf:   ServiceName,
+		labels.InferenceService: isvc.GetName(),
+	})
+
+	return &u[0], nil
+}
+
+// validateIssuerURL returns an error when the issuer URL of the OIDC auth mode is not an absolute https URL.
+func validateIssuerURL(value string) error {
+	if value == "" {
+		return fmt.Errorf("the %s annotation is required in the %s auth mode", annotations.AuthOIDCIssuerURL, authModeOIDC)
+	}
+
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme != "https" || u.Host == "" || strings.ContainsAny(value, " \t\n\"'\\") {
+		return fmt.Errorf("invalid issuer URL %q, must be an absolute https URL", value)
+	}
+
+	return nil
+}
+
+func authConfigName(isvc *unstructured.Unstructured) string {
+	return isvc.GetName() + "-auth"
+}
+
+// inferenceServiceHosts returns the sorted hosts of the external and the cluster local URLs of the InferenceService.
+func inferenceServiceHosts(isvc *unstructured.Unstructured) []string {
+	hosts := sets.New[string]()
+
+	for _, fields := range [][]string{{"status", "url"}, {"status", "address", "url"}} {
+		value, _, _ := unstructured.NestedString(isvc.Object, fields...)
+		if value == "" {
+			continue
+		}
+
+		u, err := url.Parse(value)
+		if err != nil || u.Hostname() == "" {
+			continue
+		}
+
+		hosts.Insert(u.Hostname())
+	}
+
+	return sets.List(hosts)
+}
+
+// isAuthConfigReady returns true when Authorino has reconciled the AuthConfig, with a message explaining why it is not.
+func isAuthConfigReady(ac *unstructured.Unstructured) (bool, string) {
+	conditions, _, err := unstructured.NestedSlice(ac.Object, "status", "conditions")
+	if err != nil {
+		return false, fmt.Sprintf("error checking AuthConfig conditions: %v", err)
+	}
+
+	for _, condition := range conditions {
+		conditionMap, ok := condition.(map[string]interface{})
+		if !ok || conditionMap["type"] != "Ready" {
+			continue
+		}
+
+		if conditionMap["status"] == "True" {
+			return true, ""
+		}
+
+		return false, fmt.Sprintf("AuthConfig not ready: %v", conditionMap["message"])
+	}
+
+	return false, "AuthConfig Ready condition not found, Authorino may not have reconciled it yet"
+}
+
+// setAuthPolicy sets the auth-policy annotation of the InferenceService to the policy, removing it when nil.
+func setAuthPolicy(ctx context.Context, cli client.Client, isvc *unstructured.Unstructured, policy *authPolicy) error {
+	value := ""
+	if policy != nil {
+		data, err := json.Marshal(policy)
+		if err != nil {
+			return fmt.Errorf("failed to marshal auth policy: %w", err)
+		}
+
+		value = string(data)
+	}
+
+	current, found := isvc.GetAnnotations()[annotations.AuthPolicy]
+	if (policy == nil && !found) || (policy != nil && found && current == value) {
+		return nil
+	}
+
+	patch := client.MergeFrom(isvc.DeepCopy())
+
+	if policy == nil {
+		resources.RemoveAnnotation(isvc, annotations.AuthPolicy)
+	} else {
+		resources.SetAnnotation(isvc, annotations.AuthPolicy, value)
+	}
+
+	if err := cli.Patch(ctx, isvc, patch); err != nil && !k8serr.IsNotFound(err) {
+		return fmt.Errorf("failed to set the auth policy of InferenceService %s: %w", resources.FormatUnstructuredName(isvc), err)
+	}
+
+	return nil
+}
+
+// deleteAuthResources deletes the resources of the kind generated by the operator which are not desired, as
+// namespace/name.
+func deleteAuthResources(ctx context.Context, cli client.Client, kind schema.GroupVersionKind, desired sets.Set[string]) error {
+	log := logf.FromContext(ctx)
+
+	items := unstructured.UnstructuredList{}
+	items.SetGroupVersionKind(kind.GroupVersion().WithKind(kind.Kind + "List"))
+	if err := cli.List(ctx, &items, client.MatchingLabels{labels.PlatformPartOf: ServiceName}); err != nil {
+		return fmt.Errorf("failed to list %ss: %w", kind.Kind, err)
+	}
+
+	var errs []error
+
+	for i := range items.Items {
+		obj := &items.Items[i]
+		if desired.Has(obj.GetNamespace() + "/" + obj.GetName()) {
+			continue
+		}
+
+		log.Info("deleting "+kind.Kind+" of InferenceService no longer requiring it", "name", obj.GetName(), "namespace", obj.GetNamespace())
+
+		if err := cli.Delete(ctx, obj); err != nil && !k8serr.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to delete %s %s: %w", kind.Kind, resources.FormatUnstructuredName(obj), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,293 @@
+//nolint:testpackage
+package servicemesh
+
+import (
+	"encoding/json"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+func newInferenceService(mode string, urls ...string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]any{}}
+	u.SetGroupVersionKind(gvk.InferenceServices)
+	u.SetName("my-model")
+	u.SetNamespace("my-project")
+
+	if mode != "" {
+		u.SetAnnotations(map[string]string{annotations.AuthMode: mode})
+	}
+	if len(urls) > 0 {
+		_ = unstructured.SetNestedField(u.Object, urls[0], "status", "url")
+	}
+	if len(urls) > 1 {
+		_ = unstructured.SetNestedField(u.Object, urls[1], "status", "address", "url")
+	}
+
+	return u
+}
+
+func toJSON(t *testing.T, u *unstructured.Unstructured) string {
+	t.Helper()
+
+	data, err := json.Marshal(u.Object)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(data)
+}
+
+func TestInferenceServiceHosts(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(inferenceServiceHosts(newInferenceService(""))).Should(BeEmpty())
+	g.Expect(inferenceServiceHosts(newInferenceService("",
+		"https://my-model-my-project.apps.example.com",
+		"http://my-model-predictor.my-project.svc.cluster.local",
+	))).Should(Equal([]string{
+		"my-model-my-project.apps.example.com",
+		"my-model-predictor.my-project.svc.cluster.local",
+	}))
+}
+
+func TestRenderAuthConfig(t *testing.T) {
+	decoder := serializer.NewCodecFactory(runtime.NewScheme()).UniversalDeserializer()
+	hosts := []string{"my-model-my-project.apps.example.com"}
+
+	t.Run("kubernetes", func(t *testing.T) {
+		g := NewWithT(t)
+
+		ac, err := renderAuthConfig(decoder, newInferenceService(authModeKubernetes), authModeKubernetes, []string{"https://kubernetes.default.svc"}, hosts)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(toJSON(t, ac)).Should(And(
+			jq.Match(`.kind == "%s"`, gvk.AuthConfig.Kind),
+			jq.Match(`.metadata.name == "my-model-auth"`),
+			jq.Match(`.metadata.namespace == "my-project"`),
+			jq.Match(`.metadata.labels["security.opendatahub.io/authorization-group"] == "default"`),
+			jq.Match(`.metadata.labels["%s"] == "%s"`, labels.PlatformPartOf, ServiceName),
+			jq.Match(`.metadata.labels["%s"] == "my-model"`, labels.InferenceService),
+			jq.Match(`.spec.hosts == ["my-model-my-project.apps.example.com"]`),
+			jq.Match(`.spec.authentication["kubernetes-user"].kubernetesTokenReview.audiences == ["https://kubernetes.default.svc"]`),
+			jq.Match(`.spec.authorization["kubernetes-rbac"].kubernetesSubjectAccessReview.resourceAttributes.name.value == "my-model"`),
+			jq.Match(`.spec.authorization["kubernetes-rbac"].kubernetesSubjectAccessReview.authorizationGroups.selector == "auth.identity.user.groups"`),
+		))
+	})
+
+	t.Run("api-key", func(t *testing.T) {
+		g := NewWithT(t)
+
+		ac, err := renderAuthConfig(decoder, newInferenceService(authModeAPIKey), authModeAPIKey, nil, hosts)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(toJSON(t, ac)).Should(And(
+			jq.Match(`.spec.authentication["api-key"].apiKey.selector.matchLabels["%s"] == "my-model"`, labels.AuthAPIKey),
+			jq.Match(`.spec.authorization == null`),
+		))
+	})
+
+	t.Run("oidc", func(t *testing.T) {
+		g := NewWithT(t)
+
+		isvc := newInferenceService(authModeOIDC)
+		isvc.SetAnnotations(map[string]string{
+			annotations.AuthMode:          authModeOIDC,
+			annotations.AuthOIDCIssuerURL: "https://keycloak.example.com/realms/ai",
+		})
+
+		ac, err := renderAuthConfig(decoder, isvc, authModeOIDC, nil, hosts)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(toJSON(t, ac)).Should(
+			jq.Match(`.spec.authentication.oidc.jwt.issuerUrl == "https://keycloak.example.com/realms/ai"`),
+		)
+	})
+
+	t.Run("oidc without issuer", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := renderAuthConfig(decoder, newInferenceService(authModeOIDC), authModeOIDC, nil, hosts)
+		g.Expect(err).Should(MatchError(ContainSubstring(annotations.AuthOIDCIssuerURL)))
+	})
+
+	t.Run("unsupported mode", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := renderAuthConfig(decoder, newInferenceService("basic"), "basic", nil, hosts)
+		g.Expect(err).Should(MatchError(ContainSubstring(`unsupported auth mode "basic"`)))
+	})
+}
+
+func TestRenderRequestAuthentication(t *testing.T) {
+	g := NewWithT(t)
+	decoder := serializer.NewCodecFactory(runtime.NewScheme()).UniversalDeserializer()
+
+	ra, err := renderAuthTemplate(decoder, newInferenceService(authModeOIDC), requestAuthenticationTemplate, map[string]any{
+		"Name":             "my-model",
+		"Namespace":        "my-project",
+		"InferenceService": "my-model",
+		"IssuerURL":        "https://keycloak.example.com/realms/ai",
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(ra.GroupVersionKind()).Should(Equal(gvk.RequestAuthentication))
+	g.Expect(toJSON(t, ra)).Should(And(
+		jq.Match(`.metadata.labels."%s" == "%s"`, labels.PlatformPartOf, ServiceName),
+		jq.Match(`.spec.selector.matchLabels."serving.kserve.io/inferenceservice" == "my-model"`),
+		jq.Match(`.spec.jwtRules[0].issuer == "https://keycloak.example.com/realms/ai"`),
+		jq.Match(`.spec.jwtRules[0].forwardOriginalToken == true`),
+	))
+}
+
+func TestAuthEnforcementMessage(t *testing.T) {
+	newPolicy := func() *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: map[string]any{}}
+		u.SetGroupVersionKind(gvk.AuthorizationPolicy)
+		u.SetName(predictorAuthorizationPolicyName)
+		u.SetNamespace("istio-system")
+
+		return u
+	}
+
+	tests := []struct {
+		name           string
+		authorinoReady bool
+		objects        []client.Object
+		message        string
+	}{
+		{
+			name:           "enforced",
+			authorinoReady: true,
+			objects:        []client.Object{newPolicy()},
+		},
+		{
+			name:    "authorino not ready",
+			objects: []client.Object{newPolicy()},
+			message: "Authorino is not ready",
+		},
+		{
+			name:           "no authorization policy",
+			authorinoReady: true,
+			message:        "AuthorizationPolicy istio-system/kserve-predictor delegating the requests to Authorino not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			s, err := scheme.New()
+			g.Expect(err).ShouldNot(HaveOccurred())
+			s.AddKnownTypeWithName(gvk.AuthorizationPolicy, &unstructured.Unstructured{})
+
+			cli, err := fakeclient.New(fakeclient.WithScheme(s), fakeclient.WithObjects(tt.objects...))
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			sm := newServiceMesh(infrav1.SailProvider, infrav1.SidecarDataPlaneMode)
+			rr := &odhtypes.ReconciliationRequest{
+				Client:     cli,
+				Instance:   sm,
+				Conditions: conditions.NewManager(sm, status.ConditionTypeReady, status.CapabilityServiceMeshAuthorization),
+			}
+			if tt.authorinoReady {
+				rr.Conditions.MarkTrue(status.CapabilityServiceMeshAuthorization)
+			}
+
+			message, err := authEnforcementMessage(t.Context(), rr, sm)
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			if tt.message == "" {
+				g.Expect(message).Should(BeEmpty())
+			} else {
+				g.Expect(message).Should(ContainSubstring(tt.message))
+			}
+		})
+	}
+}
+
+func TestValidateIssuerURL(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(validateIssuerURL("https://keycloak.example.com/realms/ai")).Should(Succeed())
+	g.Expect(validateIssuerURL("http://keycloak.example.com")).ShouldNot(Succeed())
+	g.Expect(validateIssuerURL("/realms/ai")).ShouldNot(Succeed())
+	g.Expect(validateIssuerURL("https://keycloak.example.com\"\n  hosts: [other]")).ShouldNot(Succeed())
+}
+
+func TestIsAuthConfigReady(t *testing.T) {
+	g := NewWithT(t)
+
+	ac := &unstructured.Unstructured{Object: map[string]any{}}
+	ready, message := isAuthConfigReady(ac)
+	g.Expect(ready).Should(BeFalse())
+	g.Expect(message).Should(ContainSubstring("not found"))
+
+	_ = unstructured.SetNestedSlice(ac.Object, []any{
+		map[string]any{"type": "Ready", "status": "False", "message": "hosts already taken"},
+	}, "status", "conditions")
+	ready, message = isAuthConfigReady(ac)
+	g.Expect(ready).Should(BeFalse())
+	g.Expect(message).Should(ContainSubstring("hosts already taken"))
+
+	_ = unstructured.SetNestedSlice(ac.Object, []any{
+		map[string]any{"type": "Ready", "status": "True"},
+	}, "status", "conditions")
+	ready, _ = isAuthConfigReady(ac)
+	g.Expect(ready).Should(BeTrue())
+}
+
+func TestInferenceServiceAuthPredicate(t *testing.T) {
+	p := NewInferenceServiceAuthPredicate()
+
+	t.Run("create", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(p.Create(event.CreateEvent{Object: newInferenceService(authModeKubernetes)})).Should(BeTrue())
+		g.Expect(p.Create(event.CreateEvent{Object: newInferenceService("")})).Should(BeFalse())
+	})
+
+	t.Run("update", func(t *testing.T) {
+		g := NewWithT(t)
+
+		// URL assigned to an InferenceService with an auth mode
+		g.Expect(p.Update(event.UpdateEvent{
+			ObjectOld: newInferenceService(authModeKubernetes),
+			ObjectNew: newInferenceService(authModeKubernetes, "https://my-model.apps.example.com"),
+		})).Should(BeTrue())
+
+		// auth mode removed
+		g.Expect(p.Update(event.UpdateEvent{
+			ObjectOld: newInferenceService(authModeKubernetes),
+			ObjectNew: newInferenceService(""),
+		})).Should(BeTrue())
+
+		// URL assigned to an InferenceService without auth mode
+		g.Expect(p.Update(event.UpdateEvent{
+			ObjectOld: newInferenceService(""),
+			ObjectNew: newInferenceService("", "https://my-model.apps.example.com"),
+		})).Should(BeFalse())
+
+		// auth policy set by the operator
+		isvc := newInferenceService(authModeKubernetes)
+		withPolicy := isvc.DeepCopy()
+		withPolicy.SetAnnotations(map[string]string{
+			annotations.AuthMode:   authModeKubernetes,
+			annotations.AuthPolicy: `{"mode":"kubernetes","ready":false}`,
+		})
+		g.Expect(p.Update(event.UpdateEvent{ObjectOld: isvc, ObjectNew: withPolicy})).Should(BeFalse())
+	})
+}
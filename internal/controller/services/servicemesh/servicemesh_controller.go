@@ -5,8 +5,11 @@ import (
 	"fmt"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
@@ -16,10 +19,21 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/template"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/component"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/dependent"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/resources"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 )
 
+// controlPlanePredicate triggers a reconciliation when the readiness of the resources of the Sail operator changes.
+var controlPlanePredicate = dependent.Predicate{
+	WatchDelete: true,
+	WatchUpdate: true,
+	WatchStatus: true,
+}
+
 //nolint:gochecknoinits
 func init() {
 	sr.Add(&serviceHandler{})
@@ -67,6 +81,52 @@ func (h *serviceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) er
 			reconciler.Dynamic(actions.IfGVKInstalled(gvk.ServiceMeshControlPlane)),
 			reconciler.WithPredicates(NewSMCPReadyPredicate()),
 		).
+		// Sail-related resources
+		OwnsGVK(gvk.Istio,
+			reconciler.Dynamic(actions.IfGVKInstalled(gvk.Istio)),
+			reconciler.WithPredicates(controlPlanePredicate)).
+		OwnsGVK(gvk.IstioCNI,
+			reconciler.Dynamic(actions.IfGVKInstalled(gvk.IstioCNI)),
+			reconciler.WithPredicates(controlPlanePredicate)).
+		OwnsGVK(gvk.ZTunnel,
+			reconciler.Dynamic(actions.IfGVKInstalled(gvk.ZTunnel)),
+			reconciler.WithPredicates(controlPlanePredicate)).
+		// InferenceServices protected by an AuthConfig
+		WatchesGVK(gvk.InferenceServices,
+			reconciler.Dynamic(actions.IfGVKInstalled(gvk.InferenceServices)),
+			reconciler.WithEventHandler(handlers.ToNamed(serviceApi.ServiceMeshInstanceName)),
+			reconciler.WithPredicates(NewInferenceServiceAuthPredicate()),
+		).
+		WatchesGVK(gvk.AuthConfig,
+			reconciler.Dynamic(actions.IfGVKInstalled(gvk.AuthConfig)),
+			reconciler.WithEventHandler(handlers.ToNamed(serviceApi.ServiceMeshInstanceName)),
+			reconciler.WithPredicates(predicate.And(
+				component.ForLabel(labels.PlatformPartOf, ServiceName),
+				dependent.New(dependent.WithWatchStatus(true)),
+			)),
+		).
+		WatchesGVK(gvk.RequestAuthentication,
+			reconciler.Dynamic(actions.IfGVKInstalled(gvk.RequestAuthentication)),
+			reconciler.WithEventHandler(handlers.ToNamed(serviceApi.ServiceMeshInstanceName)),
+			reconciler.WithPredicates(predicate.And(
+				component.ForLabel(labels.PlatformPartOf, ServiceName),
+				dependent.New(),
+			)),
+		).
+		// the AuthorizationPolicy of Authorino with the Sail provider
+		OwnsGVK(gvk.AuthorizationPolicy,
+			reconciler.Dynamic(actions.IfGVKInstalled(gvk.AuthorizationPolicy))).
+		// the AuthorizationPolicy of KServe delegating the requests to Authorino, enforcing the AuthConfigs
+		WatchesGVK(gvk.AuthorizationPolicy,
+			reconciler.Dynamic(actions.IfGVKInstalled(gvk.AuthorizationPolicy)),
+			reconciler.WithEventHandler(handlers.ToNamed(serviceApi.ServiceMeshInstanceName)),
+			reconciler.WithPredicates(resources.CreatedOrUpdatedOrDeletedNamed(predictorAuthorizationPolicyName)),
+		).
+		Owns(&corev1.ServiceAccount{}).
+		Owns(&corev1.Service{}).
+		Owns(&appsv1.Deployment{}).
+		Owns(&rbacv1.Role{}).
+		Owns(&rbacv1.RoleBinding{}).
 		WithAction(checkPreconditions).
 		WithAction(createControlPlaneNamespace).
 		WithAction(initializeServiceMesh).
@@ -82,11 +142,13 @@ func (h *serviceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) er
 		)).
 		WithAction(patchAuthorinoDeployment).
 		WithAction(deleteFeatureTrackers).
-		WithAction(checkSMCPReadiness).
+		WithAction(deleteStaleResources).
+		WithAction(checkControlPlaneReadiness).
 		WithAction(checkAuthorinoReadiness).
+		WithAction(reconcileAuthConfigs).
 		// can't own SMCP directly due to conflicts with ServiceMesh v2 operator
 		// but SMCP created by ODH operator will be cleaned up via this finalizer
-		WithFinalizer(cleanupSMCP).
+		WithFinalizer(cleanupControlPlane).
 		WithConditions(conditionTypes...).
 		Build(ctx)
 
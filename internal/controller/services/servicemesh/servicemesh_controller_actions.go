@@ -54,13 +54,15 @@ func checkPreconditions(ctx context.Context, rr *odhtypes.ReconciliationRequest)
 		return nil
 	}
 
-	// ensure ServiceMesh v2 operator is installed as pre-requisite
-	if err := checkServiceMeshOperator(ctx, rr); err != nil {
+	// ensure the operator of the mesh provider is installed as pre-requisite
+	provider := newMeshProvider(sm)
+	if err := provider.checkOperator(ctx, rr); err != nil {
 		rr.Conditions.MarkFalse(
 			status.CapabilityServiceMesh,
 			conditions.WithReason(status.MissingOperatorReason),
 			conditions.WithMessage(
-				"OpenShift ServiceMesh v2 operator not found / not setup properly on the cluster, cannot setup ServiceMesh",
+				"%s not found / not setup properly on the cluster, cannot setup ServiceMesh",
+				provider.operatorDescription(),
 			),
 			conditions.WithSeverity(common.ConditionSeverityInfo),
 		)
@@ -68,12 +70,13 @@ func checkPreconditions(ctx context.Context, rr *odhtypes.ReconciliationRequest)
 			status.CapabilityServiceMeshAuthorization,
 			conditions.WithReason(status.MissingOperatorReason),
 			conditions.WithMessage(
-				"OpenShift ServiceMesh v2 operator not found / not setup properly on the cluster, cannot setup ServiceMesh Authorization",
+				"%s not found / not setup properly on the cluster, cannot setup ServiceMesh Authorization",
+				provider.operatorDescription(),
 			),
 			conditions.WithSeverity(common.ConditionSeverityInfo),
 		)
 
-		return fmt.Errorf("ServiceMesh v2 Operator pre-condition check failed: %w", err)
+		return fmt.Errorf("%s pre-condition check failed: %w", provider.operatorDescription(), err)
 	}
 
 	return nil
@@ -90,9 +93,11 @@ func createControlPlaneNamespace(ctx context.Context, rr *odhtypes.Reconciliatio
 		return nil
 	}
 
-	// ensure SMCP namespace exists
-	if _, err := cluster.CreateNamespace(ctx, rr.Client, sm.Spec.ControlPlane.Namespace); err != nil {
-		return fmt.Errorf("failed to create SMCP namespace %s: %w", sm.Spec.ControlPlane.Namespace, err)
+	// ensure control plane namespaces exist
+	for _, ns := range newMeshProvider(sm).namespaces(sm) {
+		if _, err := cluster.CreateNamespace(ctx, rr.Client, ns); err != nil {
+			return fmt.Errorf("failed to create control plane namespace %s: %w", ns, err)
+		}
 	}
 
 	return nil
@@ -109,13 +114,7 @@ func initializeServiceMesh(_ context.Context, rr *odhtypes.ReconciliationRequest
 		return nil
 	}
 
-	rr.Templates = append(
-		rr.Templates,
-		odhtypes.TemplateInfo{
-			FS:   resourcesFS,
-			Path: serviceMeshControlPlaneTemplate,
-		},
-	)
+	rr.Templates = append(rr.Templates, newMeshProvider(sm).controlPlaneTemplates(sm)...)
 
 	return nil
 }
@@ -215,12 +214,13 @@ func initializeAuthorino(ctx context.Context, rr *odhtypes.ReconciliationRequest
 	if err != nil {
 		return fmt.Errorf("failed to obtain Authorino namespace from ServiceMesh CR: %w", err)
 	}
+	provider := newMeshProvider(sm)
 	if _, err := cluster.CreateNamespace(
 		ctx,
 		rr.Client,
 		authorinoNamespace,
 		cluster.OwnedBy(sm, rr.Client.Scheme()),
-		cluster.WithLabels(labels.ODH.OwnedNamespace, "true"),
+		cluster.WithLabels(append([]string{labels.ODH.OwnedNamespace, "true"}, provider.memberLabels(sm)...)...),
 	); err != nil {
 		return fmt.Errorf("failed to create Authorino namespace %s: %w", authorinoNamespace, err)
 	}
@@ -231,15 +231,8 @@ func initializeAuthorino(ctx context.Context, rr *odhtypes.ReconciliationRequest
 			FS:   resourcesFS,
 			Path: authorinoTemplate,
 		},
-		odhtypes.TemplateInfo{
-			FS:   resourcesFS,
-			Path: authorinoServiceMeshMemberTemplate,
-		},
-		odhtypes.TemplateInfo{
-			FS:   resourcesFS,
-			Path: authorinoServiceMeshControlPlaneTemplate,
-		},
 	)
+	rr.Templates = append(rr.Templates, provider.authorizationTemplates(sm)...)
 
 	return nil
 }
@@ -258,6 +251,7 @@ func updateMeshRefsConfigMap(ctx context.Context, rr *odhtypes.ReconciliationReq
 	data := map[string]string{
 		"CONTROL_PLANE_NAME": sm.Spec.ControlPlane.Name,
 		"MESH_NAMESPACE":     sm.Spec.ControlPlane.Namespace,
+		"MESH_PROVIDER":      string(getMeshProvider(sm)),
 	}
 
 	meshRefsConfigMap := &corev1.ConfigMap{
@@ -346,51 +340,83 @@ func deleteFeatureTrackers(ctx context.Context, rr *odhtypes.ReconciliationReque
 	return nil
 }
 
-func checkSMCPReadiness(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+// deleteStaleResources deletes the resources created by the operator for a provider which are not part of the
+// current configuration of the mesh, e.g. the control plane of the previous provider when it is switched.
+func deleteStaleResources(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	sm, ok := rr.Instance.(*serviceApi.ServiceMesh)
 	if !ok {
 		return fmt.Errorf("resource instance %v is not a serviceApi.ServiceMesh)", rr.Instance)
 	}
 
-	// Only proceed if ServiceMesh is in Managed state
-	if sm.Spec.ManagementState != operatorv1.Managed {
+	authorinoNamespace, err := getAuthorinoNamespace(rr)
+	if err != nil {
+		return fmt.Errorf("error obtaining Authorino namespace from ServiceMesh CR: %w", err)
+	}
+
+	for _, p := range meshProviders {
+		for _, res := range p.staleResources(sm, authorinoNamespace) {
+			if err := deleteMeshResource(ctx, rr.Client, res); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// deleteMeshResource deletes a resource if it exists and has been created by the ServiceMesh controller.
+func deleteMeshResource(ctx context.Context, cli client.Client, res meshResource) error {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(res.gvk)
+
+	err := cli.Get(ctx, client.ObjectKey{Namespace: res.namespace, Name: res.name}, obj)
+	switch {
+	case k8serr.IsNotFound(err), meta.IsNoMatchError(err):
 		return nil
+	case err != nil:
+		return fmt.Errorf("failed to get %s %s: %w", res.gvk.Kind, res.name, err)
 	}
 
-	smcp := &unstructured.Unstructured{}
-	smcp.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
-	err := rr.Client.Get(ctx, client.ObjectKey{
-		Name:      sm.Spec.ControlPlane.Name,
-		Namespace: sm.Spec.ControlPlane.Namespace,
-	}, smcp)
+	if !resources.HasLabel(obj, labels.PlatformPartOf, serviceApi.ServiceMeshServiceName) {
+		return nil
+	}
 
-	if err != nil && !k8serr.IsNotFound(err) {
-		return fmt.Errorf("failed to get ServiceMeshControlPlane: %w", err)
+	if err := cli.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationForeground)); err != nil && !k8serr.IsNotFound(err) {
+		return fmt.Errorf("failed to delete %s %s: %w", res.gvk.Kind, res.name, err)
 	}
 
-	if k8serr.IsNotFound(err) {
-		rr.Conditions.MarkFalse(
-			status.CapabilityServiceMesh,
-			conditions.WithReason(status.NotReadyReason),
-			conditions.WithMessage("ServiceMeshControlPlane not found, SMCP may be initializing: %v", err),
-			conditions.WithSeverity(common.ConditionSeverityInfo),
-		)
+	return nil
+}
+
+func checkControlPlaneReadiness(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	sm, ok := rr.Instance.(*serviceApi.ServiceMesh)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a serviceApi.ServiceMesh)", rr.Instance)
+	}
+
+	// Only proceed if ServiceMesh is in Managed state
+	if sm.Spec.ManagementState != operatorv1.Managed {
 		return nil
 	}
 
-	ready, message := isSMCPReady(smcp)
+	provider := newMeshProvider(sm)
+	ready, message, err := provider.controlPlaneReady(ctx, rr.Client, sm)
+	if err != nil {
+		return err
+	}
+
 	if ready {
 		rr.Conditions.MarkTrue(
 			status.CapabilityServiceMesh,
 			conditions.WithReason(status.ReadyReason),
-			conditions.WithMessage("ServiceMeshControlPlane is ready"),
+			conditions.WithMessage("%s is ready", provider.controlPlaneKind()),
 			conditions.WithSeverity(common.ConditionSeverityInfo),
 		)
 	} else {
 		rr.Conditions.MarkFalse(
 			status.CapabilityServiceMesh,
 			conditions.WithReason(status.NotReadyReason),
-			conditions.WithMessage("ServiceMeshControlPlane is not ready: %s", message),
+			conditions.WithMessage("%s is not ready: %s", provider.controlPlaneKind(), message),
 			conditions.WithSeverity(common.ConditionSeverityInfo),
 		)
 	}
@@ -476,8 +502,8 @@ func patchAuthorinoDeployment(ctx context.Context, rr *odhtypes.ReconciliationRe
 		return fmt.Errorf("resource instance %v is not a serviceApi.ServiceMesh)", rr.Instance)
 	}
 
-	// Only proceed if ServiceMesh is in Managed state
-	if sm.Spec.ManagementState != operatorv1.Managed {
+	// Only proceed if ServiceMesh is in Managed state, and the pods of the mesh get a proxy sidecar
+	if sm.Spec.ManagementState != operatorv1.Managed || !newMeshProvider(sm).injectsSidecar(sm) {
 		return nil
 	}
 
@@ -503,34 +529,11 @@ func patchAuthorinoDeployment(ctx context.Context, rr *odhtypes.ReconciliationRe
 	return nil
 }
 
-func cleanupSMCP(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+func cleanupControlPlane(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	sm, ok := rr.Instance.(*serviceApi.ServiceMesh)
 	if !ok {
 		return fmt.Errorf("resource instance %v is not a serviceApi.ServiceMesh)", rr.Instance)
 	}
 
-	smcp := &unstructured.Unstructured{}
-	smcp.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
-	err := rr.Client.Get(ctx, client.ObjectKey{
-		Name:      sm.Spec.ControlPlane.Name,
-		Namespace: sm.Spec.ControlPlane.Namespace,
-	}, smcp)
-
-	if k8serr.IsNotFound(err) {
-		// SMCP not found, skipping deletion
-		return nil
-	}
-	if err != nil {
-		return fmt.Errorf("failed to get ServiceMeshControlPlane: %w", err)
-	}
-
-	// ensure that the SMCP instance being deleted was created by ODH operator (ServiceMesh controller)
-	// this is determined based on the presence of platform label
-	if resources.HasLabel(smcp, labels.PlatformPartOf, serviceApi.ServiceMeshServiceName) {
-		if err := rr.Client.Delete(ctx, smcp); err != nil && !k8serr.IsNotFound(err) {
-			return fmt.Errorf("failed to delete ServiceMeshControlPlane: %w", err)
-		}
-	}
-
-	return nil
+	return newMeshProvider(sm).deleteControlPlane(ctx, rr.Client, sm)
 }
@@ -0,0 +1,126 @@
+package servicemesh
+
+import (
+	"context"
+	"fmt"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// maistraProvider sets up OpenShift Service Mesh 2 with a ServiceMeshControlPlane, the namespaces joining the mesh
+// through ServiceMeshMembers.
+type maistraProvider struct{}
+
+func (p *maistraProvider) operatorDescription() string {
+	return "OpenShift ServiceMesh v2 operator"
+}
+
+func (p *maistraProvider) controlPlaneKind() string {
+	return gvk.ServiceMeshControlPlane.Kind
+}
+
+func (p *maistraProvider) checkOperator(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	return checkServiceMeshOperator(ctx, rr)
+}
+
+func (p *maistraProvider) namespaces(sm *serviceApi.ServiceMesh) []string {
+	return []string{sm.Spec.ControlPlane.Namespace}
+}
+
+func (p *maistraProvider) controlPlaneTemplates(_ *serviceApi.ServiceMesh) []odhtypes.TemplateInfo {
+	return []odhtypes.TemplateInfo{
+		{
+			FS:   resourcesFS,
+			Path: serviceMeshControlPlaneTemplate,
+		},
+	}
+}
+
+func (p *maistraProvider) authorizationTemplates(_ *serviceApi.ServiceMesh) []odhtypes.TemplateInfo {
+	return []odhtypes.TemplateInfo{
+		{
+			FS:   resourcesFS,
+			Path: authorinoServiceMeshMemberTemplate,
+		},
+		{
+			FS:   resourcesFS,
+			Path: authorinoServiceMeshControlPlaneTemplate,
+		},
+	}
+}
+
+func (p *maistraProvider) memberLabels(_ *serviceApi.ServiceMesh) []string {
+	return nil
+}
+
+func (p *maistraProvider) injectsSidecar(_ *serviceApi.ServiceMesh) bool {
+	return true
+}
+
+func (p *maistraProvider) controlPlaneReady(ctx context.Context, cli client.Client, sm *serviceApi.ServiceMesh) (bool, string, error) {
+	smcp := &unstructured.Unstructured{}
+	smcp.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
+	err := cli.Get(ctx, client.ObjectKey{
+		Name:      sm.Spec.ControlPlane.Name,
+		Namespace: sm.Spec.ControlPlane.Namespace,
+	}, smcp)
+
+	if k8serr.IsNotFound(err) {
+		return false, fmt.Sprintf("ServiceMeshControlPlane not found, SMCP may be initializing: %v", err), nil
+	}
+	if err != nil {
+		return false, "", fmt.Errorf("failed to get ServiceMeshControlPlane: %w", err)
+	}
+
+	ready, message := isSMCPReady(smcp)
+
+	return ready, message, nil
+}
+
+func (p *maistraProvider) deleteControlPlane(ctx context.Context, cli client.Client, sm *serviceApi.ServiceMesh) error {
+	smcp := &unstructured.Unstructured{}
+	smcp.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
+	err := cli.Get(ctx, client.ObjectKey{
+		Name:      sm.Spec.ControlPlane.Name,
+		Namespace: sm.Spec.ControlPlane.Namespace,
+	}, smcp)
+
+	if k8serr.IsNotFound(err) {
+		// SMCP not found, skipping deletion
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get ServiceMeshControlPlane: %w", err)
+	}
+
+	// ensure that the SMCP instance being deleted was created by ODH operator (ServiceMesh controller)
+	// this is determined based on the presence of platform label
+	if resources.HasLabel(smcp, labels.PlatformPartOf, serviceApi.ServiceMeshServiceName) {
+		if err := cli.Delete(ctx, smcp); err != nil && !k8serr.IsNotFound(err) {
+			return fmt.Errorf("failed to delete ServiceMeshControlPlane: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// staleResources returns the ServiceMeshControlPlane and the ServiceMeshMember of Authorino when Sail is the provider.
+func (p *maistraProvider) staleResources(sm *serviceApi.ServiceMesh, authNamespace string) []meshResource {
+	if sm.Spec.Provider != infrav1.SailProvider {
+		return nil
+	}
+
+	return []meshResource{
+		{gvk: gvk.ServiceMeshControlPlane, namespace: sm.Spec.ControlPlane.Namespace, name: sm.Spec.ControlPlane.Name},
+		{gvk: gvk.ServiceMeshMember, namespace: authNamespace, name: "default"},
+	}
+}
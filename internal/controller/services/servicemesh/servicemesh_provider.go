@@ -0,0 +1,60 @@
+package servicemesh
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+// meshProvider sets up the service mesh with one of the supported implementations of Istio.
+type meshProvider interface {
+	// operatorDescription returns the name of the operator installing the mesh, as shown in the conditions.
+	operatorDescription() string
+	// controlPlaneKind returns the kind of the resource holding the control plane.
+	controlPlaneKind() string
+	// checkOperator returns an error when the operator installing the mesh is not installed or not running.
+	checkOperator(ctx context.Context, rr *odhtypes.ReconciliationRequest) error
+	// namespaces returns the namespaces the control plane is deployed to.
+	namespaces(sm *serviceApi.ServiceMesh) []string
+	// controlPlaneTemplates returns the templates of the control plane.
+	controlPlaneTemplates(sm *serviceApi.ServiceMesh) []odhtypes.TemplateInfo
+	// authorizationTemplates returns the templates adding Authorino to the mesh, and registering it as the
+	// external authorization provider of the control plane.
+	authorizationTemplates(sm *serviceApi.ServiceMesh) []odhtypes.TemplateInfo
+	// memberLabels returns the labels adding a namespace to the mesh, as key/value pairs.
+	memberLabels(sm *serviceApi.ServiceMesh) []string
+	// injectsSidecar returns true when the pods of the namespaces added to the mesh get a proxy sidecar.
+	injectsSidecar(sm *serviceApi.ServiceMesh) bool
+	// controlPlaneReady returns true when the control plane is ready, with a message explaining why it is not.
+	controlPlaneReady(ctx context.Context, cli client.Client, sm *serviceApi.ServiceMesh) (bool, string, error)
+	// deleteControlPlane deletes the control plane resources created by the operator which are not garbage
+	// collected with the ServiceMesh.
+	deleteControlPlane(ctx context.Context, cli client.Client, sm *serviceApi.ServiceMesh) error
+	// staleResources returns the resources created by the operator for the provider which are not part of the
+	// current configuration of the mesh, all of them when the provider is not the configured one.
+	staleResources(sm *serviceApi.ServiceMesh, authNamespace string) []meshResource
+}
+
+// meshProviders lists all the providers, to clean up the resources of the ones not configured.
+var meshProviders = []meshProvider{&maistraProvider{}, &sailProvider{}}
+
+// meshResource identifies a resource created by the operator for a provider, the namespace is empty for cluster
+// scoped resources.
+type meshResource struct {
+	gvk       schema.GroupVersionKind
+	namespace string
+	name      string
+}
+
+func newMeshProvider(sm *serviceApi.ServiceMesh) meshProvider {
+	if sm.Spec.Provider == infrav1.SailProvider {
+		return &sailProvider{}
+	}
+
+	return &maistraProvider{}
+}
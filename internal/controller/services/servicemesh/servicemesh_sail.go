@@ -0,0 +1,201 @@
+package servicemesh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+// sailProvider sets up OpenShift Service Mesh 3, or upstream Istio, with the resources of the Sail operator, the
+// namespaces joining the mesh through their labels, in sidecar or ambient mode.
+type sailProvider struct{}
+
+func (p *sailProvider) operatorDescription() string {
+	return "OpenShift ServiceMesh v3 / Sail operator"
+}
+
+func (p *sailProvider) controlPlaneKind() string {
+	return gvk.Istio.Kind
+}
+
+func (p *sailProvider) checkOperator(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	found, err := cluster.SailOperatorExists(ctx, rr.Client)
+	if err != nil {
+		return fmt.Errorf("failed to look up the Sail operator subscription: %w", err)
+	}
+	if !found {
+		return errors.New("failed to find the pre-requisite operator subscription, please ensure OpenShift Service Mesh 3 or the Sail operator is installed")
+	}
+
+	if err := cluster.CustomResourceDefinitionExists(ctx, rr.Client, gvk.Istio.GroupKind()); err != nil {
+		return fmt.Errorf("failed to find the Istio CRD, please ensure the Sail operator is installed. %w", err)
+	}
+
+	return nil
+}
+
+func (p *sailProvider) namespaces(sm *serviceApi.ServiceMesh) []string {
+	namespaces := []string{sm.Spec.ControlPlane.Namespace, istioCNINamespace}
+	if isAmbient(sm) {
+		namespaces = append(namespaces, ztunnelNamespace)
+	}
+
+	return namespaces
+}
+
+func (p *sailProvider) controlPlaneTemplates(sm *serviceApi.ServiceMesh) []odhtypes.TemplateInfo {
+	templates := []odhtypes.TemplateInfo{
+		{
+			FS:   resourcesFS,
+			Path: istioTemplate,
+		},
+		{
+			FS:   resourcesFS,
+			Path: istioCNITemplate,
+		},
+		{
+			FS:   resourcesFS,
+			Path: ingressGatewayTemplate,
+		},
+	}
+
+	if isAmbient(sm) {
+		templates = append(templates, odhtypes.TemplateInfo{
+			FS:   resourcesFS,
+			Path: ztunnelTemplate,
+		})
+	}
+
+	return templates
+}
+
+// authorizationTemplates returns the AuthorizationPolicy restricting the external authorization service of Authorino
+// to the workloads of the mesh: the extension provider of Authorino is part of the Istio resource, and the Authorino
+// namespace joins the mesh through its labels.
+func (p *sailProvider) authorizationTemplates(_ *serviceApi.ServiceMesh) []odhtypes.TemplateInfo {
+	return []odhtypes.TemplateInfo{
+		{
+			FS:   resourcesFS,
+			Path: authorinoAuthorizationPolicyTemplate,
+		},
+	}
+}
+
+func (p *sailProvider) memberLabels(sm *serviceApi.ServiceMesh) []string {
+	return cluster.MeshMemberLabels(sm.Spec.ControlPlane.Name, sm.Spec.ControlPlane.DataPlaneMode)
+}
+
+func (p *sailProvider) injectsSidecar(sm *serviceApi.ServiceMesh) bool {
+	return !isAmbient(sm)
+}
+
+func (p *sailProvider) controlPlaneReady(ctx context.Context, cli client.Client, sm *serviceApi.ServiceMesh) (bool, string, error) {
+	resources := []meshResource{
+		{gvk: gvk.Istio, name: sm.Spec.ControlPlane.Name},
+		{gvk: gvk.IstioCNI, name: sailDefaultName},
+	}
+
+	if isAmbient(sm) {
+		resources = append(resources, meshResource{gvk: gvk.ZTunnel, name: sailDefaultName})
+	}
+
+	for _, res := range resources {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(res.gvk)
+
+		err := cli.Get(ctx, client.ObjectKey{Name: res.name}, obj)
+		if k8serr.IsNotFound(err) {
+			return false, fmt.Sprintf("%s %s not found, it may be initializing", res.gvk.Kind, res.name), nil
+		}
+		if err != nil {
+			return false, "", fmt.Errorf("failed to get %s %s: %w", res.gvk.Kind, res.name, err)
+		}
+
+		if ready, message := isSailResourceReady(obj); !ready {
+			return false, message, nil
+		}
+	}
+
+	return true, "", nil
+}
+
+// deleteControlPlane has nothing to delete: the resources of the Sail operator are owned by the ServiceMesh.
+func (p *sailProvider) deleteControlPlane(_ context.Context, _ client.Client, _ *serviceApi.ServiceMesh) error {
+	return nil
+}
+
+// staleResources returns the whole Sail control plane when Maistra is the provider, and the ZTunnel when the data
+// plane has been switched from ambient to sidecar mode.
+func (p *sailProvider) staleResources(sm *serviceApi.ServiceMesh, authNamespace string) []meshResource {
+	if sm.Spec.Provider == infrav1.SailProvider {
+		if isAmbient(sm) {
+			return nil
+		}
+
+		return []meshResource{{gvk: gvk.ZTunnel, name: sailDefaultName}}
+	}
+
+	ns := sm.Spec.ControlPlane.Namespace
+
+	return []meshResource{
+		{gvk: gvk.Istio, name: sm.Spec.ControlPlane.Name},
+		{gvk: gvk.IstioCNI, name: sailDefaultName},
+		{gvk: gvk.ZTunnel, name: sailDefaultName},
+		{gvk: gvk.Deployment, namespace: ns, name: ingressGatewayName},
+		{gvk: gvk.Service, namespace: ns, name: ingressGatewayName},
+		{gvk: gvk.ServiceAccount, namespace: ns, name: ingressGatewayName},
+		{gvk: gvk.RoleBinding, namespace: ns, name: ingressGatewaySDSName},
+		{gvk: gvk.Role, namespace: ns, name: ingressGatewaySDSName},
+		{gvk: gvk.AuthorizationPolicy, namespace: authNamespace, name: authProviderName + "-authorization"},
+	}
+}
+
+func isAmbient(sm *serviceApi.ServiceMesh) bool {
+	return sm.Spec.ControlPlane.DataPlaneMode == infrav1.AmbientDataPlaneMode
+}
+
+// isSailResourceReady returns true when the Ready condition of a resource of the Sail operator is True, with a
+// message explaining why it is not.
+func isSailResourceReady(obj *unstructured.Unstructured) (bool, string) {
+	conditions, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil {
+		return false, fmt.Sprintf("error checking %s conditions: %v", obj.GetKind(), err)
+	}
+
+	if !found {
+		return false, fmt.Sprintf("no %s conditions found, %s may be starting up", obj.GetKind(), obj.GetName())
+	}
+
+	for _, condition := range conditions {
+		conditionMap, ok := condition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if conditionMap["type"] != "Ready" {
+			continue
+		}
+
+		if conditionMap["status"] == "True" {
+			return true, ""
+		}
+
+		if message, found := conditionMap["message"]; found {
+			return false, fmt.Sprintf("%s %s Ready condition is false: %v", obj.GetKind(), obj.GetName(), message)
+		}
+
+		return false, fmt.Sprintf("%s %s Ready condition is false", obj.GetKind(), obj.GetName())
+	}
+
+	return false, fmt.Sprintf("%s %s Ready condition not found, it may be initializing", obj.GetKind(), obj.GetName())
+}
@@ -0,0 +1,218 @@
+//nolint:testpackage
+package servicemesh
+
+import (
+	"testing"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+func newServiceMesh(provider infrav1.ServiceMeshProvider, dataPlaneMode string) *serviceApi.ServiceMesh {
+	sm := &serviceApi.ServiceMesh{}
+	sm.Spec.Provider = provider
+	sm.Spec.ControlPlane.Name = "data-science-smcp"
+	sm.Spec.ControlPlane.Namespace = "istio-system"
+	sm.Spec.ControlPlane.DataPlaneMode = dataPlaneMode
+
+	return sm
+}
+
+func TestNewMeshProvider(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(newMeshProvider(newServiceMesh("", ""))).Should(BeAssignableToTypeOf(&maistraProvider{}))
+	g.Expect(newMeshProvider(newServiceMesh(infrav1.MaistraProvider, ""))).Should(BeAssignableToTypeOf(&maistraProvider{}))
+	g.Expect(newMeshProvider(newServiceMesh(infrav1.SailProvider, ""))).Should(BeAssignableToTypeOf(&sailProvider{}))
+}
+
+func TestSailProvider(t *testing.T) {
+	p := &sailProvider{}
+
+	t.Run("sidecar", func(t *testing.T) {
+		g := NewWithT(t)
+		sm := newServiceMesh(infrav1.SailProvider, infrav1.SidecarDataPlaneMode)
+
+		g.Expect(p.namespaces(sm)).Should(Equal([]string{"istio-system", istioCNINamespace}))
+		g.Expect(p.memberLabels(sm)).Should(Equal([]string{labels.IstioRevision, "data-science-smcp"}))
+		g.Expect(p.injectsSidecar(sm)).Should(BeTrue())
+		g.Expect(p.controlPlaneTemplates(sm)).ShouldNot(ContainElement(HaveField("Path", ztunnelTemplate)))
+		g.Expect(p.authorizationTemplates(sm)).Should(ContainElement(HaveField("Path", authorinoAuthorizationPolicyTemplate)))
+	})
+
+	t.Run("ambient", func(t *testing.T) {
+		g := NewWithT(t)
+		sm := newServiceMesh(infrav1.SailProvider, infrav1.AmbientDataPlaneMode)
+
+		g.Expect(p.namespaces(sm)).Should(Equal([]string{"istio-system", istioCNINamespace, ztunnelNamespace}))
+		g.Expect(p.memberLabels(sm)).Should(Equal([]string{labels.IstioDataPlaneMode, "ambient"}))
+		g.Expect(p.injectsSidecar(sm)).Should(BeFalse())
+		g.Expect(p.controlPlaneTemplates(sm)).Should(ContainElement(HaveField("Path", ztunnelTemplate)))
+	})
+}
+
+func TestIsSailResourceReady(t *testing.T) {
+	newIstio := func(conditions ...any) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: map[string]any{}}
+		u.SetGroupVersionKind(gvk.Istio)
+		u.SetName("data-science-smcp")
+
+		if len(conditions) > 0 {
+			_ = unstructured.SetNestedSlice(u.Object, conditions, "status", "conditions")
+		}
+
+		return u
+	}
+
+	tests := []struct {
+		name    string
+		obj     *unstructured.Unstructured
+		ready   bool
+		message string
+	}{
+		{
+			name:    "no conditions",
+			obj:     newIstio(),
+			message: "no Istio conditions found",
+		},
+		{
+			name:    "ready",
+			obj:     newIstio(map[string]any{"type": "Ready", "status": "True"}),
+			ready:   true,
+			message: "",
+		},
+		{
+			name:    "not ready",
+			obj:     newIstio(map[string]any{"type": "Ready", "status": "False", "message": "istiod is not ready"}),
+			message: "Istio data-science-smcp Ready condition is false: istiod is not ready",
+		},
+		{
+			name:    "no ready condition",
+			obj:     newIstio(map[string]any{"type": "Reconciled", "status": "True"}),
+			message: "Ready condition not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ready, message := isSailResourceReady(tt.obj)
+			g.Expect(ready).Should(Equal(tt.ready))
+			g.Expect(message).Should(ContainSubstring(tt.message))
+		})
+	}
+}
+
+func TestStaleResources(t *testing.T) {
+	ztunnel := meshResource{gvk: gvk.ZTunnel, name: sailDefaultName}
+	smcp := meshResource{gvk: gvk.ServiceMeshControlPlane, namespace: "istio-system", name: "data-science-smcp"}
+
+	staleResources := func(sm *serviceApi.ServiceMesh) []meshResource {
+		var stale []meshResource
+		for _, p := range meshProviders {
+			stale = append(stale, p.staleResources(sm, "opendatahub-auth-provider")...)
+		}
+
+		return stale
+	}
+
+	t.Run("maistra", func(t *testing.T) {
+		g := NewWithT(t)
+
+		stale := staleResources(newServiceMesh(infrav1.MaistraProvider, ""))
+		g.Expect(stale).Should(ContainElements(
+			meshResource{gvk: gvk.Istio, name: "data-science-smcp"},
+			ztunnel,
+			meshResource{gvk: gvk.Deployment, namespace: "istio-system", name: ingressGatewayName},
+			meshResource{gvk: gvk.AuthorizationPolicy, namespace: "opendatahub-auth-provider", name: "authorino-authorization"},
+		))
+		g.Expect(stale).ShouldNot(ContainElement(smcp))
+	})
+
+	t.Run("sail sidecar", func(t *testing.T) {
+		g := NewWithT(t)
+
+		stale := staleResources(newServiceMesh(infrav1.SailProvider, infrav1.SidecarDataPlaneMode))
+		g.Expect(stale).Should(ConsistOf(
+			smcp,
+			meshResource{gvk: gvk.ServiceMeshMember, namespace: "opendatahub-auth-provider", name: "default"},
+			ztunnel,
+		))
+	})
+
+	t.Run("sail ambient", func(t *testing.T) {
+		g := NewWithT(t)
+
+		stale := staleResources(newServiceMesh(infrav1.SailProvider, infrav1.AmbientDataPlaneMode))
+		g.Expect(stale).ShouldNot(ContainElement(ztunnel))
+		g.Expect(stale).Should(ContainElement(smcp))
+	})
+}
+
+func TestDeleteMeshResource(t *testing.T) {
+	newZTunnel := func(lbls map[string]string) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: map[string]any{}}
+		u.SetGroupVersionKind(gvk.ZTunnel)
+		u.SetName(sailDefaultName)
+		u.SetLabels(lbls)
+
+		return u
+	}
+
+	tests := []struct {
+		name    string
+		obj     *unstructured.Unstructured
+		deleted bool
+	}{
+		{
+			name:    "created by the operator",
+			obj:     newZTunnel(map[string]string{labels.PlatformPartOf: serviceApi.ServiceMeshServiceName}),
+			deleted: true,
+		},
+		{
+			name:    "created by the user",
+			obj:     newZTunnel(nil),
+			deleted: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			s, err := scheme.New()
+			g.Expect(err).ShouldNot(HaveOccurred())
+			s.AddKnownTypeWithName(gvk.ZTunnel, &unstructured.Unstructured{})
+
+			cli, err := fakeclient.New(fakeclient.WithScheme(s), fakeclient.WithObjects(tt.obj))
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			err = deleteMeshResource(t.Context(), cli, meshResource{gvk: gvk.ZTunnel, name: sailDefaultName})
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			err = cli.Get(t.Context(), client.ObjectKeyFromObject(tt.obj), newZTunnel(nil))
+			g.Expect(k8serr.IsNotFound(err)).Should(Equal(tt.deleted))
+		})
+	}
+
+	t.Run("kind not installed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		cli, err := fakeclient.New()
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		err = deleteMeshResource(t.Context(), cli, meshResource{gvk: gvk.ZTunnel, name: sailDefaultName})
+		g.Expect(err).ShouldNot(HaveOccurred())
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
 	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
@@ -126,6 +127,15 @@ func getAuthorinoNamespace(rr *odhtypes.ReconciliationRequest) (string, error) {
 	return sm.Spec.Auth.Namespace, nil
 }
 
+// getMeshProvider returns the provider of the service mesh, Maistra when not set.
+func getMeshProvider(sm *serviceApi.ServiceMesh) infrav1.ServiceMeshProvider {
+	if sm.Spec.Provider == "" {
+		return infrav1.MaistraProvider
+	}
+
+	return sm.Spec.Provider
+}
+
 func getTemplateData(_ context.Context, rr *odhtypes.ReconciliationRequest) (map[string]any, error) {
 	sm, ok := rr.Instance.(*serviceApi.ServiceMesh)
 	if !ok {
@@ -142,6 +152,10 @@ func getTemplateData(_ context.Context, rr *odhtypes.ReconciliationRequest) (map
 		"AuthNamespace":     authorinoNamespace,
 		"AuthProviderName":  authProviderName,
 		"ControlPlane":      sm.Spec.ControlPlane,
+		"Ambient":           isAmbient(sm),
+		"SailDefaultName":   sailDefaultName,
+		"IstioCNINamespace": istioCNINamespace,
+		"ZTunnelNamespace":  ztunnelNamespace,
 	}, nil
 }
 
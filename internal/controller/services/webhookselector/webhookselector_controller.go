@@ -0,0 +1,130 @@
+// Package webhookselector contains the logic to scope the workload admission webhooks of the operator
+// to the namespaces and objects configured in the DSCInitialization
+package webhookselector
+
+import (
+	"context"
+	"fmt"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
+)
+
+// WebhookSelectorReconciler holds the controller configuration.
+type WebhookSelectorReconciler struct {
+	Client client.Client
+}
+
+// NewWithManager sets up the controller with the Manager.
+func NewWithManager(_ context.Context, mgr ctrl.Manager) error {
+	r := WebhookSelectorReconciler{
+		Client: mgr.GetClient(),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("webhook-selector-controller").
+		For(
+			&dsciv1.DSCInitialization{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		// webhook configurations are watched to revert selectors reset by a re-install of the operator
+		Watches(
+			&admissionregistrationv1.MutatingWebhookConfiguration{},
+			handlers.ToNamed(ServiceName),
+			builder.WithPredicates(hasWorkloadWebhook()),
+		).
+		Watches(
+			&admissionregistrationv1.ValidatingWebhookConfiguration{},
+			handlers.ToNamed(ServiceName),
+			builder.WithPredicates(hasWorkloadWebhook()),
+		).
+		Complete(&r)
+}
+
+// Reconcile sets the namespace and object selectors of the workload webhooks found in the mutating and
+// validating webhook configurations of the cluster according to the webhooks section of the DSCInitialization.
+// The webhook configurations installed by OLM hold one webhook each and are patched alike, OLM resetting the
+// selectors when it reinstalls them triggers a new reconciliation.
+func (r *WebhookSelectorReconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
+	l := logf.FromContext(ctx)
+
+	// the default selectors apply until the DSCInitialization is created, e.g. to the webhooks installed by OLM
+	var spec *dsciv1.WebhooksSpec
+
+	dsci, err := cluster.GetDSCI(ctx, r.Client)
+	switch {
+	case k8serr.IsNotFound(err):
+	case err != nil:
+		return ctrl.Result{}, fmt.Errorf("failed to get DSCInitialization: %w", err)
+	default:
+		spec = dsci.Spec.Webhooks
+	}
+
+	sel, err := newSelectors(spec)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("invalid webhooks configuration in DSCInitialization %s: %w", dsci.Name, err)
+	}
+
+	mutating := admissionregistrationv1.MutatingWebhookConfigurationList{}
+	if err := r.Client.List(ctx, &mutating); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list mutating webhook configurations: %w", err)
+	}
+
+	for i := range mutating.Items {
+		cfg := &mutating.Items[i]
+		orig := cfg.DeepCopy()
+
+		changed := false
+		for j := range cfg.Webhooks {
+			w := &cfg.Webhooks[j]
+			changed = sel.apply(w.Name, &w.NamespaceSelector, &w.ObjectSelector) || changed
+		}
+
+		if !changed {
+			continue
+		}
+
+		l.Info("Updating workload webhook selectors", "kind", "MutatingWebhookConfiguration", "name", cfg.Name)
+
+		if err := r.Client.Patch(ctx, cfg, client.MergeFromWithOptions(orig, client.MergeFromWithOptimisticLock{})); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to patch mutating webhook configuration %s: %w", cfg.Name, err)
+		}
+	}
+
+	validating := admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	if err := r.Client.List(ctx, &validating); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list validating webhook configurations: %w", err)
+	}
+
+	for i := range validating.Items {
+		cfg := &validating.Items[i]
+		orig := cfg.DeepCopy()
+
+		changed := false
+		for j := range cfg.Webhooks {
+			w := &cfg.Webhooks[j]
+			changed = sel.apply(w.Name, &w.NamespaceSelector, &w.ObjectSelector) || changed
+		}
+
+		if !changed {
+			continue
+		}
+
+		l.Info("Updating workload webhook selectors", "kind", "ValidatingWebhookConfiguration", "name", cfg.Name)
+
+		if err := r.Client.Patch(ctx, cfg, client.MergeFromWithOptions(orig, client.MergeFromWithOptimisticLock{})); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to patch validating webhook configuration %s: %w", cfg.Name, err)
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,236 @@
+package webhookselector_test
+
+import (
+	"testing"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/webhookselector"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+const (
+	mutatingName   = "mutating-webhook-configuration"
+	validatingName = "validating-webhook-configuration"
+)
+
+func newDSCI(spec *dsciv1.WebhooksSpec) *dsciv1.DSCInitialization {
+	return &dsciv1.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"},
+		Spec: dsciv1.DSCInitializationSpec{
+			ApplicationsNamespace: "opendatahub",
+			Webhooks:              spec,
+		},
+	}
+}
+
+func newMutating() *admissionregistrationv1.MutatingWebhookConfiguration {
+	return &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: mutatingName},
+		Webhooks: []admissionregistrationv1.MutatingWebhook{
+			{Name: "datasciencecluster-defaulter.opendatahub.io"},
+			{Name: "hardwareprofile-notebook-injector.opendatahub.io"},
+		},
+	}
+}
+
+func newValidating() *admissionregistrationv1.ValidatingWebhookConfiguration {
+	return &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: validatingName},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{
+			{Name: "dscinitialization-validator.opendatahub.io"},
+			{Name: "kubeflow-kueuelabels-validator.opendatahub.io"},
+		},
+	}
+}
+
+func reconcile(t *testing.T, cli client.Client) error {
+	t.Helper()
+
+	r := webhookselector.WebhookSelectorReconciler{Client: cli}
+	_, err := r.Reconcile(t.Context(), ctrl.Request{})
+
+	return err
+}
+
+func TestWebhookSelectorReconciler(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	sch, err := scheme.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(
+		newDSCI(&dsciv1.WebhooksSpec{
+			NamespaceOptInLabel: "example.com/webhooks",
+			ExcludedNamespaces:  []string{"tenant-a", metav1.NamespaceSystem},
+		}),
+		newMutating(),
+		newValidating(),
+	).Build()
+
+	g.Expect(reconcile(t, cli)).Should(Succeed())
+
+	mutating := admissionregistrationv1.MutatingWebhookConfiguration{}
+	g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: mutatingName}, &mutating)).Should(Succeed())
+
+	validating := admissionregistrationv1.ValidatingWebhookConfiguration{}
+	g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: validatingName}, &validating)).Should(Succeed())
+
+	expectedNamespaceSelector := PointTo(MatchFields(IgnoreExtras, Fields{
+		"MatchExpressions": ConsistOf(
+			metav1.LabelSelectorRequirement{
+				Key:      corev1.LabelMetadataName,
+				Operator: metav1.LabelSelectorOpNotIn,
+				Values:   []string{corev1.NamespaceNodeLease, metav1.NamespacePublic, metav1.NamespaceSystem, "tenant-a"},
+			},
+			metav1.LabelSelectorRequirement{
+				Key:      labels.WebhooksOptOut,
+				Operator: metav1.LabelSelectorOpNotIn,
+				Values:   []string{labels.True},
+			},
+			metav1.LabelSelectorRequirement{
+				Key:      "example.com/webhooks",
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   []string{labels.True},
+			},
+		),
+	}))
+
+	expectedObjectSelector := PointTo(MatchFields(IgnoreExtras, Fields{
+		"MatchExpressions": ConsistOf(
+			metav1.LabelSelectorRequirement{
+				Key:      labels.WebhooksOptOut,
+				Operator: metav1.LabelSelectorOpNotIn,
+				Values:   []string{labels.True},
+			},
+		),
+	}))
+
+	// webhooks not admitting workloads are left untouched
+	g.Expect(mutating.Webhooks[0].NamespaceSelector).Should(BeNil())
+	g.Expect(mutating.Webhooks[0].ObjectSelector).Should(BeNil())
+	g.Expect(validating.Webhooks[0].NamespaceSelector).Should(BeNil())
+	g.Expect(validating.Webhooks[0].ObjectSelector).Should(BeNil())
+
+	g.Expect(mutating.Webhooks[1].NamespaceSelector).Should(expectedNamespaceSelector)
+	g.Expect(mutating.Webhooks[1].ObjectSelector).Should(expectedObjectSelector)
+
+	// workloads can not opt out of policy enforcing validating webhooks
+	g.Expect(validating.Webhooks[1].NamespaceSelector).Should(expectedNamespaceSelector)
+	g.Expect(validating.Webhooks[1].ObjectSelector).Should(BeNil())
+
+	// a second run is a no-op
+	rv := mutating.ResourceVersion
+	g.Expect(reconcile(t, cli)).Should(Succeed())
+	g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: mutatingName}, &mutating)).Should(Succeed())
+	g.Expect(mutating.ResourceVersion).Should(Equal(rv))
+}
+
+func TestWebhookSelectorReconciler_Defaults(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	sch, err := scheme.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(
+		newDSCI(nil),
+		newMutating(),
+	).Build()
+
+	g.Expect(reconcile(t, cli)).Should(Succeed())
+
+	mutating := admissionregistrationv1.MutatingWebhookConfiguration{}
+	g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: mutatingName}, &mutating)).Should(Succeed())
+
+	g.Expect(mutating.Webhooks[1].NamespaceSelector.MatchExpressions).Should(ConsistOf(
+		metav1.LabelSelectorRequirement{
+			Key:      corev1.LabelMetadataName,
+			Operator: metav1.LabelSelectorOpNotIn,
+			Values:   []string{corev1.NamespaceNodeLease, metav1.NamespacePublic, metav1.NamespaceSystem},
+		},
+		metav1.LabelSelectorRequirement{
+			Key:      labels.WebhooksOptOut,
+			Operator: metav1.LabelSelectorOpNotIn,
+			Values:   []string{labels.True},
+		},
+	))
+}
+
+func TestWebhookSelectorReconciler_InvalidLabel(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	sch, err := scheme.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(
+		newDSCI(&dsciv1.WebhooksSpec{OptOutLabel: "not a label"}),
+		newMutating(),
+	).Build()
+
+	g.Expect(reconcile(t, cli)).Should(MatchError(ContainSubstring("invalid webhooks configuration")))
+
+	mutating := admissionregistrationv1.MutatingWebhookConfiguration{}
+	g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: mutatingName}, &mutating)).Should(Succeed())
+	g.Expect(mutating.Webhooks[1].NamespaceSelector).Should(BeNil())
+}
+
+func TestWebhookSelectorReconciler_OLM(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	sch, err := scheme.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	const olmName = "kubeflow-kueuelabels-validator.opendatahub.io-4fk9x"
+	const operatorGroupLabel = "olm.operatorgroup.uid/0b8e1a3c"
+
+	// OLM installs a configuration per webhook, scoped to the target namespaces of the OperatorGroup
+	cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(
+		&admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: olmName},
+			Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+				Name:              "kubeflow-kueuelabels-validator.opendatahub.io",
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{operatorGroupLabel: ""}},
+			}},
+		},
+	).Build()
+
+	// the default selectors apply before the DSCInitialization is created
+	g.Expect(reconcile(t, cli)).Should(Succeed())
+
+	validating := admissionregistrationv1.ValidatingWebhookConfiguration{}
+	g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: olmName}, &validating)).Should(Succeed())
+
+	g.Expect(validating.Webhooks[0].NamespaceSelector.MatchLabels).Should(Equal(map[string]string{operatorGroupLabel: ""}))
+	g.Expect(validating.Webhooks[0].NamespaceSelector.MatchExpressions).Should(ConsistOf(
+		metav1.LabelSelectorRequirement{
+			Key:      corev1.LabelMetadataName,
+			Operator: metav1.LabelSelectorOpNotIn,
+			Values:   []string{corev1.NamespaceNodeLease, metav1.NamespacePublic, metav1.NamespaceSystem},
+		},
+		metav1.LabelSelectorRequirement{
+			Key:      labels.WebhooksOptOut,
+			Operator: metav1.LabelSelectorOpNotIn,
+			Values:   []string{labels.True},
+		},
+	))
+
+	// a second run is a no-op
+	rv := validating.ResourceVersion
+	g.Expect(reconcile(t, cli)).Should(Succeed())
+	g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: olmName}, &validating)).Should(Succeed())
+	g.Expect(validating.ResourceVersion).Should(Equal(rv))
+}
@@ -0,0 +1,174 @@
+package webhookselector
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// workloadWebhooks maps the names of the operator webhooks admitting user workloads to whether
+// workloads can opt out of them with the opt-out label. Validating webhooks enforcing a policy
+// can only be opted out of at the namespace level, as workload labels are set by users.
+var workloadWebhooks = map[string]bool{
+	"connection-isvc.opendatahub.io":                   true,
+	"connection-notebook.opendatahub.io":               true,
+	"hardwareprofile-kserve-injector.opendatahub.io":   true,
+	"hardwareprofile-notebook-injector.opendatahub.io": true,
+	"kserve-kueuelabels-defaulter.opendatahub.io":      true,
+	"kubeflow-kueuelabels-defaulter.opendatahub.io":    true,
+	"ray-kueuelabels-defaulter.opendatahub.io":         true,
+	"kserve-kueuelabels-validator.opendatahub.io":      false,
+	"kubeflow-kueuelabels-validator.opendatahub.io":    false,
+	"ray-kueuelabels-validator.opendatahub.io":         false,
+}
+
+// olmOperatorGroupLabelPrefix prefixes the namespace label OLM selects the target namespaces of an OperatorGroup
+// with, in the namespaceSelector of the webhooks it installs from the ClusterServiceVersion.
+const olmOperatorGroupLabelPrefix = "olm.operatorgroup.uid/"
+
+// systemNamespaces are always excluded from the workload webhooks.
+var systemNamespaces = []string{
+	metav1.NamespaceSystem,
+	metav1.NamespacePublic,
+	corev1.NamespaceNodeLease,
+}
+
+// selectors holds the selectors to set on the workload webhooks.
+type selectors struct {
+	namespace *metav1.LabelSelector
+	object    *metav1.LabelSelector
+}
+
+// newSelectors computes the workload webhook selectors from the webhooks section of the DSCInitialization,
+// which may be nil, and checks they are valid.
+func newSelectors(spec *dsciv1.WebhooksSpec) (*selectors, error) {
+	if spec == nil {
+		spec = &dsciv1.WebhooksSpec{}
+	}
+
+	optOutLabel := spec.OptOutLabel
+	if optOutLabel == "" {
+		optOutLabel = labels.WebhooksOptOut
+	}
+
+	excluded := slices.Concat(systemNamespaces, spec.ExcludedNamespaces)
+	slices.Sort(excluded)
+	excluded = slices.Compact(excluded)
+
+	optOut := metav1.LabelSelectorRequirement{
+		Key:      optOutLabel,
+		Operator: metav1.LabelSelectorOpNotIn,
+		Values:   []string{labels.True},
+	}
+
+	ns := &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{
+				Key:      corev1.LabelMetadataName,
+				Operator: metav1.LabelSelectorOpNotIn,
+				Values:   excluded,
+			},
+			optOut,
+		},
+	}
+
+	if spec.NamespaceOptInLabel != "" {
+		ns.MatchExpressions = append(ns.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key:      spec.NamespaceOptInLabel,
+			Operator: metav1.LabelSelectorOpIn,
+			Values:   []string{labels.True},
+		})
+	}
+
+	obj := &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{optOut},
+	}
+
+	for _, s := range []*metav1.LabelSelector{ns, obj} {
+		if _, err := metav1.LabelSelectorAsSelector(s); err != nil {
+			return nil, err
+		}
+	}
+
+	return &selectors{namespace: ns, object: obj}, nil
+}
+
+// apply sets the selectors of the named webhook if it is a workload webhook, and reports whether
+// any of them changed.
+func (s *selectors) apply(name string, namespaceSelector **metav1.LabelSelector, objectSelector **metav1.LabelSelector) bool {
+	objectOptOut, ok := workloadWebhooks[name]
+	if !ok {
+		return false
+	}
+
+	changed := false
+
+	desired := s.namespaceSelectorFor(*namespaceSelector)
+	if !equality.Semantic.DeepEqual(*namespaceSelector, desired) {
+		*namespaceSelector = desired
+		changed = true
+	}
+
+	if objectOptOut && !equality.Semantic.DeepEqual(*objectSelector, s.object) {
+		*objectSelector = s.object.DeepCopy()
+		changed = true
+	}
+
+	return changed
+}
+
+// namespaceSelectorFor returns the namespace selector of a workload webhook, keeping the requirements OLM set on
+// the current one to scope the webhook to the target namespaces of the OperatorGroup of the operator.
+func (s *selectors) namespaceSelectorFor(current *metav1.LabelSelector) *metav1.LabelSelector {
+	desired := s.namespace.DeepCopy()
+	if current == nil {
+		return desired
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(current.MatchLabels)) {
+		if strings.HasPrefix(k, olmOperatorGroupLabelPrefix) {
+			if desired.MatchLabels == nil {
+				desired.MatchLabels = map[string]string{}
+			}
+			desired.MatchLabels[k] = current.MatchLabels[k]
+		}
+	}
+
+	for _, r := range current.MatchExpressions {
+		if strings.HasPrefix(r.Key, olmOperatorGroupLabelPrefix) {
+			desired.MatchExpressions = append(desired.MatchExpressions, *r.DeepCopy())
+		}
+	}
+
+	return desired
+}
+
+// hasWorkloadWebhook only lets through webhook configurations holding at least one workload webhook.
+func hasWorkloadWebhook() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		switch cfg := obj.(type) {
+		case *admissionregistrationv1.MutatingWebhookConfiguration:
+			return slices.ContainsFunc(cfg.Webhooks, func(w admissionregistrationv1.MutatingWebhook) bool {
+				_, ok := workloadWebhooks[w.Name]
+				return ok
+			})
+		case *admissionregistrationv1.ValidatingWebhookConfiguration:
+			return slices.ContainsFunc(cfg.Webhooks, func(w admissionregistrationv1.ValidatingWebhook) bool {
+				_, ok := workloadWebhooks[w.Name]
+				return ok
+			})
+		default:
+			return false
+		}
+	})
+}
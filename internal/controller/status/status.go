@@ -106,6 +106,12 @@ const (
 	ConditionOpenTelemetryCollectorAvailable = "OpenTelemetryCollectorAvailable"
 	ConditionInstrumentationAvailable        = "InstrumentationAvailable"
 	ConditionAlertingAvailable               = "AlertingAvailable"
+	ConditionLogsAvailable                   = "LogsAvailable"
+	ConditionStorageReconciled               = "StorageReconciled"
+	ConditionTrustedCABundleValid            = "TrustedCABundleValid"
+	ConditionTrustedCABundleDistributed      = "TrustedCABundleDistributed"
+	ConditionGatewayProgrammed               = "GatewayProgrammed"
+	ConditionRolesBound                      = "RolesBound"
 )
 
 const (
@@ -122,6 +128,8 @@ const (
 	CapabilityFailed          string = "CapabilityFailed"
 	ArgoWorkflowExist         string = "ArgoWorkflowExist"
 	NoManagedComponentsReason        = "NoManagedComponents"
+	UnsupportedPlatformReason        = "UnsupportedPlatform"
+	InvariantViolationReason         = "InvariantViolation"
 
 	DegradedReason  = "Degraded"
 	AvailableReason = "Available"
@@ -185,15 +193,48 @@ const (
 	MetricsNotConfiguredMessage = "Metrics not configured in DSCI CR"
 	TracesNotConfiguredReason   = "TracesNotConfigured"
 	TracesNotConfiguredMessage  = "Traces not configured in DSCI CR"
+	LogsNotConfiguredReason     = "LogsNotConfigured"
+	LogsNotConfiguredMessage    = "Logs not configured in DSCI CR"
+
+	InstrumentationNamespaceNotFoundReason = "InstrumentationNamespaceNotFound"
+
+	StorageNotConfiguredReason            = "StorageNotConfigured"
+	StorageNotConfiguredMessage           = "Neither metrics nor traces are configured in DSCI CR"
+	StorageMigrationNotAcknowledgedReason = "StorageMigrationNotAcknowledged"
+	VolumeExpansionNotSupportedReason     = "VolumeExpansionNotSupported"
+	VolumeShrinkNotSupportedReason        = "VolumeShrinkNotSupported"
+	VolumeResizeInProgressReason          = "VolumeResizeInProgress"
 
 	AlertingNotConfiguredReason  = "AlertingNotConfigured"
 	AlertingNotConfiguredMessage = "Alerting not configured in DSCI CR"
+	InvalidAlertingRulesReason   = "InvalidAlertingRules"
 
 	TempoOperatorMissingMessage                  = "Tempo operator must be installed for traces configuration"
 	COOMissingMessage                            = "ClusterObservability operator must be installed for metrics configuration"
 	OpenTelemetryCollectorOperatorMissingMessage = "OpenTelemetryCollector operator must be installed for OpenTelemetry configuration"
 )
 
+// For the trusted CA bundle checks.
+const (
+	InvalidCABundleReason        = "InvalidCABundle"
+	CACertificatesExpiredReason  = "CACertificatesExpired"
+	CACertificatesExpiringReason = "CACertificatesExpiring"
+	DistributionInProgressReason = "DistributionInProgress"
+	DistributionFailedReason     = "DistributionFailed"
+)
+
+// For the Gateway service checks.
+const (
+	GatewayAPIMissingCRDReason  = "GatewayAPICRDMissing"
+	GatewayAPIMissingCRDMessage = "Gateway API CRDs do not exist, please install the Gateway API or OpenShift Service Mesh 3"
+	GatewayNotProgrammedReason  = "GatewayNotProgrammed"
+)
+
+// For the Auth service checks.
+const (
+	RolesPartiallyBoundReason = "RolesPartiallyBound"
+)
+
 // setConditions is a helper function to set multiple conditions at once.
 func setConditions(wrapper *conditionsWrapper, conditions []common.Condition) {
 	for _, c := range conditions {
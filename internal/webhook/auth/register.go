@@ -0,0 +1,20 @@
+//go:build !nowebhook
+
+package auth
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// RegisterWebhooks registers the webhooks for Auth.
+func RegisterWebhooks(mgr ctrl.Manager) error {
+	if err := (&Validator{
+		Decoder: admission.NewDecoder(mgr.GetScheme()),
+		Name:    "auth-validating",
+	}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+
+	return nil
+}
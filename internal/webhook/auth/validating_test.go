@@ -0,0 +1,106 @@
+package auth_test
+
+import (
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/auth"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/envtestutil"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	// registers the KServe component and its capabilities
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/kserve"
+
+	. "github.com/onsi/gomega"
+)
+
+func newAuth(capabilities ...string) *serviceApi.Auth {
+	return &serviceApi.Auth{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gvk.Auth.GroupVersion().String(),
+			Kind:       gvk.Auth.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: serviceApi.AuthInstanceName,
+		},
+		Spec: serviceApi.AuthSpec{
+			Roles: []serviceApi.AuthRole{
+				{Name: "model-deployer", Groups: []string{"deployers"}, Capabilities: capabilities},
+			},
+		},
+	}
+}
+
+// TestAuth_ValidatingWebhook exercises the validating webhook logic for Auth resources.
+// It verifies that the capabilities granted by the roles must be contributed by the components.
+func TestAuth_ValidatingWebhook(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	ctx := t.Context()
+	sch, err := scheme.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	newRequest := func(op admissionv1.Operation, obj *serviceApi.Auth) admission.Request {
+		return envtestutil.NewAdmissionRequest(
+			t,
+			op,
+			obj,
+			gvk.Auth,
+			metav1.GroupVersionResource{
+				Group:    gvk.Auth.Group,
+				Version:  gvk.Auth.Version,
+				Resource: "auths",
+			},
+		)
+	}
+
+	withoutRoles := newAuth()
+	withoutRoles.Spec.Roles = nil
+
+	cases := []struct {
+		name    string
+		req     admission.Request
+		allowed bool
+	}{
+		{
+			name:    "Allows creation without roles",
+			req:     newRequest(admissionv1.Create, withoutRoles),
+			allowed: true,
+		},
+		{
+			name:    "Allows creation with a known capability",
+			req:     newRequest(admissionv1.Create, newAuth("kserve/deploy")),
+			allowed: true,
+		},
+		{
+			name:    "Denies creation with an unknown capability",
+			req:     newRequest(admissionv1.Create, newAuth("kserve/deploy", "kserve/train")),
+			allowed: false,
+		},
+		{
+			name:    "Denies update with an unknown capability",
+			req:     newRequest(admissionv1.Update, newAuth("datasciencepipelines/autor")),
+			allowed: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			validator := &auth.Validator{
+				Decoder: admission.NewDecoder(sch),
+				Name:    "test",
+			}
+			resp := validator.Handle(ctx, tc.req)
+			g.Expect(resp.Allowed).To(Equal(tc.allowed))
+			if !tc.allowed {
+				g.Expect(resp.Result.Message).To(ContainSubstring("unknown capability"))
+			}
+		})
+	}
+}
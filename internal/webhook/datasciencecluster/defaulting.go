@@ -42,8 +42,7 @@ var _ webhook.CustomDefaulter = &Defaulter{}
 //   - error: Always nil (for future extensibility).
 func (d *Defaulter) SetupWithManager(mgr ctrl.Manager) error {
 	mutateWebhook := admission.WithCustomDefaulter(mgr.GetScheme(), &dscv1.DataScienceCluster{}, d)
-	mutateWebhook.LogConstructor = webhookutils.NewWebhookLogConstructor(d.Name)
-	mgr.GetWebhookServer().Register("/mutate-datasciencecluster", mutateWebhook)
+	mgr.GetWebhookServer().Register("/mutate-datasciencecluster", webhookutils.NewAdmission(mgr, d.Name, mutateWebhook.Handler))
 	// No error to return currently, but return nil for future extensibility
 	return nil
 }
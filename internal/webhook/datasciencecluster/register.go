@@ -4,14 +4,17 @@ package datasciencecluster
 
 import (
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 // RegisterWebhooks registers the webhooks for DataScienceCluster.
 func RegisterWebhooks(mgr ctrl.Manager) error {
 	// Register the validating webhook
 	if err := (&Validator{
-		Client: mgr.GetAPIReader(),
-		Name:   "datasciencecluster-validating",
+		Client:        mgr.GetAPIReader(),
+		ClusterClient: mgr.GetClient(),
+		Decoder:       admission.NewDecoder(mgr.GetScheme()),
+		Name:          "datasciencecluster-validating",
 	}).SetupWithManager(mgr); err != nil {
 		return err
 	}
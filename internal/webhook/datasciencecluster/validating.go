@@ -4,27 +4,38 @@ package datasciencecluster
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
-	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/invariants"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	webhookutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/webhook"
 )
 
-//+kubebuilder:webhook:path=/validate-datasciencecluster,mutating=false,failurePolicy=fail,sideEffects=None,groups=datasciencecluster.opendatahub.io,resources=datascienceclusters,verbs=create,versions=v1,name=datasciencecluster-validator.opendatahub.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-datasciencecluster,mutating=false,failurePolicy=fail,sideEffects=None,groups=datasciencecluster.opendatahub.io,resources=datascienceclusters,verbs=create;update,versions=v1,name=datasciencecluster-validator.opendatahub.io,admissionReviewVersions=v1
 //nolint:lll
 
 // Validator implements webhook.AdmissionHandler for DataScienceCluster validation webhooks.
-// It enforces singleton creation rules for DataScienceCluster resources and always allows their deletion.
+// It enforces singleton creation rules and the platform invariants of the components configuration for
+// DataScienceCluster resources, and always allows their deletion.
 type Validator struct {
+	// Client is used for the singleton check, bypassing the cache.
 	Client client.Reader
-	Name   string
+	// ClusterClient is used to inspect the DSCInitialization and the cluster state the invariants depend on.
+	ClusterClient client.Client
+	Decoder       admission.Decoder
+	Name          string
 }
 
 // Assert that Validator implements admission.Handler interface.
@@ -39,15 +50,13 @@ var _ admission.Handler = &Validator{}
 //   - error: Always nil (for future extensibility).
 func (v *Validator) SetupWithManager(mgr ctrl.Manager) error {
 	hookServer := mgr.GetWebhookServer()
-	hookServer.Register("/validate-datasciencecluster", &webhook.Admission{
-		Handler:        v,
-		LogConstructor: webhookutils.NewWebhookLogConstructor(v.Name),
-	})
+	hookServer.Register("/validate-datasciencecluster", webhookutils.NewAdmission(mgr, v.Name, v))
 	return nil
 }
 
-// Handle processes admission requests for create operations on DataScienceCluster resources.
-// It enforces singleton rules, allowing other operations by default.
+// Handle processes admission requests for create and update operations on DataScienceCluster resources.
+// It enforces singleton rules on create and the platform invariants on create and on the updates of the spec,
+// allowing other operations by default.
 //
 // Parameters:
 //   - ctx: Context for the admission request (logger is extracted from here).
@@ -64,6 +73,11 @@ func (v *Validator) Handle(ctx context.Context, req admission.Request) admission
 	switch req.Operation {
 	case admissionv1.Create:
 		resp = webhookutils.ValidateSingletonCreation(ctx, v.Client, &req, gvk.DataScienceCluster.Kind)
+		if resp.Allowed {
+			resp = v.validateInvariants(ctx, &req)
+		}
+	case admissionv1.Update:
+		resp = v.validateInvariants(ctx, &req)
 	default:
 		resp.Allowed = true // initialize Allowed to be true in case Operation falls into "default" case
 	}
@@ -74,3 +88,68 @@ func (v *Validator) Handle(ctx context.Context, req admission.Request) admission
 
 	return admission.Allowed(fmt.Sprintf("Operation %s on %s allowed", req.Operation, req.Kind.Kind))
 }
+
+// validateInvariants denies the request if the components configuration of the DataScienceCluster violates
+// any of the platform invariants, given the DSCInitialization and the state of the cluster.
+//
+// Parameters:
+//   - ctx: Context for the admission request.
+//   - req: The admission.Request containing the DataScienceCluster.
+//
+// Returns:
+//   - admission.Response: Allowed if all the invariants hold, denied with the violations otherwise.
+func (v *Validator) validateInvariants(ctx context.Context, req *admission.Request) admission.Response {
+	log := logf.FromContext(ctx)
+
+	if v.Decoder == nil {
+		log.Error(nil, "Decoder is nil - webhook not properly initialized")
+		return admission.Errored(http.StatusInternalServerError, errors.New("webhook decoder not initialized"))
+	}
+
+	dsc := &dscv1.DataScienceCluster{}
+	if err := v.Decoder.Decode(*req, dsc); err != nil {
+		log.Error(err, "failed to decode object")
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to decode object: %w", err))
+	}
+
+	if req.Operation == admissionv1.Update {
+		// the invariants depend on the state of the cluster, they must not block the finalizers removal, or the
+		// metadata updates, of an instance which was valid when its spec was last changed
+		if dsc.GetDeletionTimestamp() != nil {
+			return admission.Allowed("DataScienceCluster is being deleted")
+		}
+
+		if len(req.OldObject.Raw) > 0 {
+			old := &dscv1.DataScienceCluster{}
+			if err := v.Decoder.DecodeRaw(req.OldObject, old); err != nil {
+				log.Error(err, "failed to decode old object")
+				return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to decode old object: %w", err))
+			}
+
+			if equality.Semantic.DeepEqual(old.Spec, dsc.Spec) {
+				return admission.Allowed("DataScienceCluster spec unchanged")
+			}
+		}
+	}
+
+	dsci, err := cluster.GetDSCI(ctx, v.ClusterClient)
+	switch {
+	case k8serr.IsNotFound(err):
+		dsci = nil
+	case err != nil:
+		log.Error(err, "failed to get DSCInitialization")
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to get DSCInitialization: %w", err))
+	}
+
+	violations, err := invariants.CheckDataScienceCluster(ctx, v.ClusterClient, dsc, dsci)
+	if err != nil {
+		log.Error(err, "failed to check platform invariants")
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to check platform invariants: %w", err))
+	}
+
+	if len(violations) > 0 {
+		return admission.Denied(fmt.Sprintf("DataScienceCluster %s violates platform invariants: %v", dsc.Name, errors.Join(violations...)))
+	}
+
+	return admission.Allowed("")
+}
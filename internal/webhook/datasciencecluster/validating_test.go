@@ -3,12 +3,17 @@ package datasciencecluster_test
 import (
 	"testing"
 
+	operatorv1 "github.com/openshift/api/operator/v1"
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/datasciencecluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/envtestutil"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
@@ -18,7 +23,7 @@ import (
 )
 
 // TestDataScienceCluster_ValidatingWebhook exercises the validating webhook logic for DataScienceCluster resources.
-// It verifies singleton enforcement and deletion rules using table-driven tests and a fake client.
+// It verifies singleton enforcement, platform invariants and deletion rules using table-driven tests and a fake client.
 func TestDataScienceCluster_ValidatingWebhook(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -31,6 +36,7 @@ func TestDataScienceCluster_ValidatingWebhook(t *testing.T) {
 	cases := []struct {
 		name         string
 		existingObjs []client.Object
+		dsciOpts     []func(*dsciv1.DSCInitialization)
 		req          admission.Request
 		allowed      bool
 	}{
@@ -68,6 +74,89 @@ func TestDataScienceCluster_ValidatingWebhook(t *testing.T) {
 			),
 			allowed: false,
 		},
+		{
+			name:         "Denies update with Serverless KServe and ServiceMesh Removed",
+			existingObjs: nil,
+			dsciOpts:     []func(*dsciv1.DSCInitialization){withServiceMesh(operatorv1.Removed)},
+			req: envtestutil.NewAdmissionRequest(
+				t,
+				admissionv1.Update,
+				envtestutil.NewDSC("test-update", ns, withKserve(componentApi.Serverless)),
+				gvk.DataScienceCluster,
+				metav1.GroupVersionResource{
+					Group:    gvk.DataScienceCluster.Group,
+					Version:  gvk.DataScienceCluster.Version,
+					Resource: "datascienceclusters",
+				},
+			),
+			allowed: false,
+		},
+		{
+			name:         "Allows metadata update of a DataScienceCluster violating the invariants",
+			existingObjs: nil,
+			dsciOpts:     []func(*dsciv1.DSCInitialization){withServiceMesh(operatorv1.Removed)},
+			req: envtestutil.WithOldObject(t, envtestutil.NewAdmissionRequest(
+				t,
+				admissionv1.Update,
+				envtestutil.NewDSC("test-update", ns, withKserve(componentApi.Serverless), withLabel("app", "test")),
+				gvk.DataScienceCluster,
+				metav1.GroupVersionResource{
+					Group:    gvk.DataScienceCluster.Group,
+					Version:  gvk.DataScienceCluster.Version,
+					Resource: "datascienceclusters",
+				},
+			), envtestutil.NewDSC("test-update", ns, withKserve(componentApi.Serverless))),
+			allowed: true,
+		},
+		{
+			name:         "Allows update of a DataScienceCluster being deleted",
+			existingObjs: nil,
+			dsciOpts:     []func(*dsciv1.DSCInitialization){withServiceMesh(operatorv1.Removed)},
+			req: envtestutil.NewAdmissionRequest(
+				t,
+				admissionv1.Update,
+				envtestutil.NewDSC("test-update", ns, withKserve(componentApi.Serverless), withDeletionTimestamp()),
+				gvk.DataScienceCluster,
+				metav1.GroupVersionResource{
+					Group:    gvk.DataScienceCluster.Group,
+					Version:  gvk.DataScienceCluster.Version,
+					Resource: "datascienceclusters",
+				},
+			),
+			allowed: true,
+		},
+		{
+			name:         "Allows update with Serverless KServe and no ServiceMesh configuration",
+			existingObjs: nil,
+			req: envtestutil.NewAdmissionRequest(
+				t,
+				admissionv1.Update,
+				envtestutil.NewDSC("test-update", ns, withKserve(componentApi.Serverless)),
+				gvk.DataScienceCluster,
+				metav1.GroupVersionResource{
+					Group:    gvk.DataScienceCluster.Group,
+					Version:  gvk.DataScienceCluster.Version,
+					Resource: "datascienceclusters",
+				},
+			),
+			allowed: true,
+		},
+		{
+			name:         "Allows update with RawDeployment KServe and no ServiceMesh",
+			existingObjs: nil,
+			req: envtestutil.NewAdmissionRequest(
+				t,
+				admissionv1.Update,
+				envtestutil.NewDSC("test-update", ns, withKserve(componentApi.RawDeployment)),
+				gvk.DataScienceCluster,
+				metav1.GroupVersionResource{
+					Group:    gvk.DataScienceCluster.Group,
+					Version:  gvk.DataScienceCluster.Version,
+					Resource: "datascienceclusters",
+				},
+			),
+			allowed: true,
+		},
 		{
 			name:         "Allows deletion always",
 			existingObjs: nil,
@@ -90,11 +179,13 @@ func TestDataScienceCluster_ValidatingWebhook(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			objs := append([]client.Object{}, tc.existingObjs...)
-			objs = append(objs, envtestutil.NewDSCI("dsci-for-dsc", ns))
+			objs = append(objs, envtestutil.NewDSCI("dsci-for-dsc", ns, tc.dsciOpts...))
 			cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(objs...).Build()
 			validator := &datasciencecluster.Validator{
-				Client: cli,
-				Name:   "test",
+				Client:        cli,
+				ClusterClient: cli,
+				Decoder:       admission.NewDecoder(sch),
+				Name:          "test",
 			}
 			resp := validator.Handle(ctx, tc.req)
 			t.Logf("Admission response: Allowed=%v, Result=%+v", resp.Allowed, resp.Result)
@@ -105,3 +196,34 @@ func TestDataScienceCluster_ValidatingWebhook(t *testing.T) {
 		})
 	}
 }
+
+// withServiceMesh sets the management state of the service mesh of the DSCInitialization.
+func withServiceMesh(state operatorv1.ManagementState) func(*dsciv1.DSCInitialization) {
+	return func(dsci *dsciv1.DSCInitialization) {
+		dsci.Spec.ServiceMesh = &infrav1.ServiceMeshSpec{ManagementState: state}
+	}
+}
+
+// withLabel sets a label on the DataScienceCluster.
+func withLabel(key string, value string) func(*dscv1.DataScienceCluster) {
+	return func(dsc *dscv1.DataScienceCluster) {
+		dsc.SetLabels(map[string]string{key: value})
+	}
+}
+
+// withDeletionTimestamp marks the DataScienceCluster as being deleted.
+func withDeletionTimestamp() func(*dscv1.DataScienceCluster) {
+	return func(dsc *dscv1.DataScienceCluster) {
+		now := metav1.Now()
+		dsc.SetDeletionTimestamp(&now)
+		dsc.SetFinalizers([]string{"test"})
+	}
+}
+
+// withKserve sets KServe as Managed in the DataScienceCluster, with the given default deployment mode.
+func withKserve(mode componentApi.DefaultDeploymentMode) func(*dscv1.DataScienceCluster) {
+	return func(dsc *dscv1.DataScienceCluster) {
+		dsc.Spec.Components.Kserve.ManagementState = operatorv1.Managed
+		dsc.Spec.Components.Kserve.DefaultDeploymentMode = mode
+	}
+}
@@ -10,7 +10,6 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
-	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
@@ -39,10 +38,7 @@ var _ admission.Handler = &Validator{}
 //   - error: Always nil (for future extensibility).
 func (v *Validator) SetupWithManager(mgr ctrl.Manager) error {
 	hookServer := mgr.GetWebhookServer()
-	hookServer.Register("/validate-dscinitialization", &webhook.Admission{
-		Handler:        v,
-		LogConstructor: webhookutils.NewWebhookLogConstructor(v.Name),
-	})
+	hookServer.Register("/validate-dscinitialization", webhookutils.NewAdmission(mgr, v.Name, v))
 	return nil
 }
 
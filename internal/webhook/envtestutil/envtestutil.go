@@ -217,6 +217,21 @@ func WithInferenceService() CRDSetupOption {
 	}
 }
 
+// WithKueueQueues enables LocalQueue and ClusterQueue CRD registration in the test environment.
+func WithKueueQueues() CRDSetupOption {
+	return func(ctx context.Context, t *testing.T, env *envt.EnvT) error {
+		t.Helper()
+
+		for _, crd := range []*apiextensionsv1.CustomResourceDefinition{MockLocalQueueCRD(), MockClusterQueueCRD()} {
+			if err := createAndWaitForCRD(ctx, env, crd); err != nil {
+				return fmt.Errorf("failed to create and wait for %s CRD: %w", crd.Spec.Names.Kind, err)
+			}
+		}
+
+		return nil
+	}
+}
+
 // =============================================================================
 // Object Creation Functions
 // =============================================================================
@@ -730,6 +745,20 @@ func NewAdmissionRequest(
 	}
 }
 
+// WithOldObject sets the object before the update of an admission request.
+func WithOldObject(t *testing.T, req admission.Request, old client.Object) admission.Request {
+	t.Helper()
+
+	raw, err := json.Marshal(old)
+	if err != nil {
+		t.Fatalf("failed to marshal old object: %v", err)
+	}
+
+	req.OldObject = runtime.RawExtension{Raw: raw}
+
+	return req
+}
+
 // =============================================================================
 // Mock CRD Functions
 // =============================================================================
@@ -796,3 +825,44 @@ func MockInferenceServiceCRD() *apiextensionsv1.CustomResourceDefinition {
 		},
 	}
 }
+
+// MockLocalQueueCRD creates a mock Kueue LocalQueue CRD for testing.
+func MockLocalQueueCRD() *apiextensionsv1.CustomResourceDefinition {
+	return mockKueueCRD("localqueues", "localqueue", gvk.LocalQueue.Kind, apiextensionsv1.NamespaceScoped)
+}
+
+// MockClusterQueueCRD creates a mock Kueue ClusterQueue CRD for testing.
+func MockClusterQueueCRD() *apiextensionsv1.CustomResourceDefinition {
+	return mockKueueCRD("clusterqueues", "clusterqueue", gvk.ClusterQueue.Kind, apiextensionsv1.ClusterScoped)
+}
+
+func mockKueueCRD(plural, singular, kind string, scope apiextensionsv1.ResourceScope) *apiextensionsv1.CustomResourceDefinition {
+	preserveUnknownFields := true
+
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: plural + "." + gvk.LocalQueue.Group,
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: gvk.LocalQueue.Group,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural:   plural,
+				Singular: singular,
+				Kind:     kind,
+			},
+			Scope: scope,
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{
+				Name:    gvk.LocalQueue.Version,
+				Served:  true,
+				Storage: true,
+				Schema: &apiextensionsv1.CustomResourceValidation{
+					OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+						Type: "object",
+						// This allows any structure
+						XPreserveUnknownFields: &preserveUnknownFields,
+					},
+				},
+			}},
+		},
+	}
+}
@@ -33,6 +33,15 @@ func RegisterWebhooks(mgr manager.Manager) error {
 		return err
 	}
 
+	kueueDefaulter := &kueuewebhook.Defaulter{
+		Client:  mgr.GetAPIReader(),
+		Decoder: admission.NewDecoder(mgr.GetScheme()),
+		Name:    "kueue-mutating",
+	}
+	if err := kueueDefaulter.SetupWithManager(mgr); err != nil {
+		return err
+	}
+
 	// Register Hardware Profile webhook
 	hardwareProfileInjector := &hardwareprofilewebhook.Injector{
 		Client:  mgr.GetAPIReader(),
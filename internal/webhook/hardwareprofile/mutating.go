@@ -20,7 +20,6 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
-	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	hwpv1alpha1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1alpha1"
@@ -82,10 +81,7 @@ func (i *Injector) SetupWithManager(mgr ctrl.Manager) error {
 	hookServer := mgr.GetWebhookServer()
 
 	// Register single webhook path for both Notebooks and InferenceServices
-	hookServer.Register("/mutate-hardware-profile", &webhook.Admission{
-		Handler:        i,
-		LogConstructor: webhookutils.NewWebhookLogConstructor(i.Name),
-	})
+	hookServer.Register("/mutate-hardware-profile", webhookutils.NewAdmission(mgr, i.Name, i))
 
 	return nil
 }
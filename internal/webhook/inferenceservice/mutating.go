@@ -16,7 +16,6 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
-	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	webhookutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/webhook"
@@ -63,10 +62,7 @@ var _ admission.Handler = &ConnectionWebhook{}
 
 func (w *ConnectionWebhook) SetupWithManager(mgr ctrl.Manager) error {
 	hookServer := mgr.GetWebhookServer()
-	hookServer.Register("/platform-connection-isvc", &webhook.Admission{
-		Handler:        w,
-		LogConstructor: webhookutils.NewWebhookLogConstructor(w.Name),
-	})
+	hookServer.Register("/platform-connection-isvc", webhookutils.NewAdmission(mgr, w.Name, w))
 	return nil
 }
 
@@ -77,6 +77,20 @@ func createTestSecret(name, namespace, connectionType string, data map[string][]
 	return secret
 }
 
+func validS3Data() map[string][]byte {
+	return map[string][]byte{
+		"AWS_S3_ENDPOINT":       []byte("https://s3.example.com"),
+		"AWS_ACCESS_KEY_ID":     []byte("id"),
+		"AWS_SECRET_ACCESS_KEY": []byte("secret"),
+	}
+}
+
+func validOCIData() map[string][]byte {
+	return map[string][]byte{
+		corev1.DockerConfigJsonKey: []byte(`{"auths":{}}`),
+	}
+}
+
 func createTestInferenceService(name, namespace string, annotations map[string]string, predictorSpec map[string]interface{}) (*unstructured.Unstructured, error) {
 	isvc := envtestutil.NewInferenceService(name, namespace)
 	unstructuredISVC, ok := isvc.(*unstructured.Unstructured)
@@ -302,6 +316,7 @@ func TestConnectionWebhook(t *testing.T) {
 			name:               "annotation as OCI type, ISVC creation allowed with injection done",
 			secretType:         inferenceservice.ConnectionTypeOCI.String(),
 			secretNamespace:    testNamespace,
+			secretData:         validOCIData(),
 			annotations:        map[string]string{annotations.Connection: testSecret},
 			operation:          admissionv1.Create,
 			expectedAllowed:    true,
@@ -322,6 +337,7 @@ func TestConnectionWebhook(t *testing.T) {
 			name:               "annotation as S3 type, ISVC creation allowed with injection done",
 			secretType:         inferenceservice.ConnectionTypeS3.String(),
 			secretNamespace:    testNamespace,
+			secretData:         validS3Data(),
 			annotations:        map[string]string{annotations.Connection: testSecret},
 			predictorSpec:      map[string]interface{}{"model": map[string]interface{}{}},
 			operation:          admissionv1.Create,
@@ -337,14 +353,36 @@ func TestConnectionWebhook(t *testing.T) {
 			predictorSpec:   map[string]interface{}{"model": map[string]interface{}{}},
 			operation:       admissionv1.Create,
 			expectedAllowed: false,
-			expectedMessage: "secret does not contain 'URI' data key",
+			expectedMessage: "missing required key(s): URI",
+		},
+		// schema cases
+		{
+			name:            "annotation as S3 type with missing keys, ISVC should not be allowed to create",
+			secretType:      inferenceservice.ConnectionTypeS3.String(),
+			secretNamespace: testNamespace,
+			secretData:      map[string][]byte{"AWS_ACCESS_KEY_ID": []byte("id")},
+			annotations:     map[string]string{annotations.Connection: testSecret},
+			predictorSpec:   map[string]interface{}{"model": map[string]interface{}{}},
+			operation:       admissionv1.Create,
+			expectedAllowed: false,
+			expectedMessage: "missing required key(s): AWS_S3_ENDPOINT, AWS_SECRET_ACCESS_KEY",
+		},
+		{
+			name:            "annotation as OCI type without docker config, ISVC should not be allowed to create",
+			secretType:      inferenceservice.ConnectionTypeOCI.String(),
+			secretNamespace: testNamespace,
+			secretData:      map[string][]byte{},
+			annotations:     map[string]string{annotations.Connection: testSecret},
+			operation:       admissionv1.Create,
+			expectedAllowed: false,
+			expectedMessage: "missing required key(s): .dockerconfigjson",
 		},
 		// type cases for update
 		{
 			name:               "annotation as S3 type with existing storageUri, ISVC update allowed with replacement",
 			secretType:         inferenceservice.ConnectionTypeS3.String(),
 			secretNamespace:    testNamespace,
-			secretData:         map[string][]byte{},
+			secretData:         validS3Data(),
 			annotations:        map[string]string{annotations.Connection: testSecret},
 			predictorSpec:      map[string]interface{}{"model": map[string]interface{}{"key": "existing-secret"}},
 			operation:          admissionv1.Update,
@@ -355,6 +393,7 @@ func TestConnectionWebhook(t *testing.T) {
 			name:               "annotation as OCI type, ISVC update allowed with replacement",
 			secretType:         inferenceservice.ConnectionTypeOCI.String(),
 			secretNamespace:    testNamespace,
+			secretData:         validOCIData(),
 			annotations:        map[string]string{annotations.Connection: testSecret},
 			predictorSpec:      map[string]interface{}{"model": map[string]interface{}{}},
 			operation:          admissionv1.Update,
@@ -365,6 +404,7 @@ func TestConnectionWebhook(t *testing.T) {
 			name:            "annotation as S3 type without model set, ISVC should not be allowed to create",
 			secretType:      inferenceservice.ConnectionTypeS3.String(),
 			secretNamespace: testNamespace,
+			secretData:      validS3Data(),
 			annotations:     map[string]string{annotations.Connection: testSecret},
 			operation:       admissionv1.Create,
 			expectedAllowed: false,
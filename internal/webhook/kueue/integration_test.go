@@ -9,12 +9,16 @@ import (
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 	"github.com/rs/xid"
+	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	dscwebhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/datasciencecluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/envtestutil"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/envt"
 
 	. "github.com/onsi/gomega"
@@ -25,7 +29,9 @@ var (
 	localQueueName             = "default"
 	kueueManagedLabelKey       = cluster.KueueManagedLabelKey
 	kueueLegacyManagedLabelKey = cluster.KueueLegacyManagedLabelKey
+	clusterQueueName           = "cluster-queue"
 	missingLabelError          = `Kueue label validation failed: missing required label "` + kueueQueueNameLabelKey + `"`
+	missingQueueError          = `Kueue queue validation failed: LocalQueue does not exist`
 )
 
 func TestKueueWebhook_Integration(t *testing.T) {
@@ -35,7 +41,10 @@ func TestKueueWebhook_Integration(t *testing.T) {
 		name              string
 		kueueState        operatorv1.ManagementState
 		nsLabels          map[string]string
+		nsAnnotations     map[string]string
+		createQueues      bool
 		workloadLabels    map[string]string
+		expectQueueName   string
 		expectAllowed     bool
 		expectDeniedError string
 	}{
@@ -58,9 +67,28 @@ func TestKueueWebhook_Integration(t *testing.T) {
 			name:           "Kueue enabled, ns enabled, valid workload label - should allow",
 			kueueState:     operatorv1.Managed,
 			nsLabels:       map[string]string{kueueManagedLabelKey: "true"},
+			createQueues:   true,
 			workloadLabels: map[string]string{kueueQueueNameLabelKey: localQueueName},
 			expectAllowed:  true,
 		},
+		{
+			name:              "Kueue enabled, ns enabled, workload label referencing missing queue - should deny",
+			kueueState:        operatorv1.Managed,
+			nsLabels:          map[string]string{kueueManagedLabelKey: "true"},
+			workloadLabels:    map[string]string{kueueQueueNameLabelKey: localQueueName},
+			expectAllowed:     false,
+			expectDeniedError: missingQueueError,
+		},
+		{
+			name:            "Kueue enabled, ns enabled with default queue, missing workload label - should assign default queue",
+			kueueState:      operatorv1.Managed,
+			nsLabels:        map[string]string{kueueManagedLabelKey: "true"},
+			nsAnnotations:   map[string]string{cluster.KueueDefaultQueueNameAnnotation: localQueueName},
+			createQueues:    true,
+			workloadLabels:  map[string]string{},
+			expectAllowed:   true,
+			expectQueueName: localQueueName,
+		},
 		{
 			name:           "Kueue enabled, ns not labeled - should allow",
 			kueueState:     operatorv1.Managed,
@@ -72,6 +100,7 @@ func TestKueueWebhook_Integration(t *testing.T) {
 			name:           "Kueue enabled, ns enabled with legacy label, valid workload label - should allow",
 			kueueState:     operatorv1.Managed,
 			nsLabels:       map[string]string{kueueLegacyManagedLabelKey: "true"},
+			createQueues:   true,
 			workloadLabels: map[string]string{kueueQueueNameLabelKey: localQueueName},
 			expectAllowed:  true,
 		},
@@ -90,6 +119,7 @@ func TestKueueWebhook_Integration(t *testing.T) {
 				},
 				20*time.Second,
 				envtestutil.WithNotebook(),
+				envtestutil.WithKueueQueues(),
 			)
 
 			t.Cleanup(teardown)
@@ -109,13 +139,33 @@ func TestKueueWebhook_Integration(t *testing.T) {
 			}
 			g.Expect(k8sClient.Status().Update(ctx, dsc)).To(Succeed())
 
-			g.Expect(k8sClient.Create(ctx, envtestutil.NewNamespace(ns, tc.nsLabels))).To(Succeed())
+			g.Expect(k8sClient.Create(ctx, envtestutil.NewNamespace(ns, tc.nsLabels, func(n *corev1.Namespace) {
+				n.Annotations = tc.nsAnnotations
+			}))).To(Succeed())
+
+			if tc.createQueues {
+				cq := resources.GvkToUnstructured(gvk.ClusterQueue)
+				cq.SetName(clusterQueueName + "-" + ns)
+				g.Expect(unstructured.SetNestedSlice(cq.Object, []interface{}{
+					map[string]interface{}{"type": "Active", "status": "True"},
+				}, "status", "conditions")).To(Succeed())
+				g.Expect(k8sClient.Create(ctx, cq)).To(Succeed())
+
+				lq := resources.GvkToUnstructured(gvk.LocalQueue)
+				lq.SetName(localQueueName)
+				lq.SetNamespace(ns)
+				g.Expect(unstructured.SetNestedField(lq.Object, cq.GetName(), "spec", "clusterQueue")).To(Succeed())
+				g.Expect(k8sClient.Create(ctx, lq)).To(Succeed())
+			}
 
 			workload := envtestutil.NewNotebook("test-notebook", ns, envtestutil.WithLabels(tc.workloadLabels))
 			err := k8sClient.Create(ctx, workload)
 
 			if tc.expectAllowed {
 				g.Expect(err).To(Succeed(), fmt.Sprintf("Expected creation to be allowed but got: %v", err))
+				if tc.expectQueueName != "" {
+					g.Expect(workload.GetLabels()).To(HaveKeyWithValue(kueueQueueNameLabelKey, tc.expectQueueName))
+				}
 			} else {
 				g.Expect(err).To(HaveOccurred(), "Expected creation to be denied but it was allowed.")
 				statusErr := &k8serr.StatusError{}
@@ -0,0 +1,187 @@
+//go:build !nowebhook
+
+package kueue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	webhookutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/webhook"
+)
+
+// Webhooks for Kueue default queue assignment, on the same resources as the validating webhooks.
+// Mutating webhooks run before validating ones, so workloads missing the queue name label get the
+// namespace default queue assigned instead of being denied.
+
+//+kubebuilder:webhook:path=/mutate-kueue,mutating=true,failurePolicy=fail,sideEffects=None,groups=kubeflow.org,resources=pytorchjobs;notebooks,verbs=create;update,versions=v1,name=kubeflow-kueuelabels-defaulter.opendatahub.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/mutate-kueue,mutating=true,failurePolicy=fail,sideEffects=None,groups=ray.io,resources=rayjobs;rayclusters,verbs=create;update,versions=v1;v1alpha1,name=ray-kueuelabels-defaulter.opendatahub.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/mutate-kueue,mutating=true,failurePolicy=fail,sideEffects=None,groups=serving.kserve.io,resources=inferenceservices,verbs=create;update,versions=v1beta1,name=kserve-kueuelabels-defaulter.opendatahub.io,admissionReviewVersions=v1
+//nolint:lll
+
+// Defaulter implements webhook.AdmissionHandler for Kueue default queue assignment webhooks.
+type Defaulter struct {
+	Client  client.Reader
+	Decoder admission.Decoder
+	Name    string
+}
+
+// Assert that Defaulter implements admission.Handler interface.
+var _ admission.Handler = &Defaulter{}
+
+// SetupWithManager registers the mutating webhook with the provided controller-runtime manager.
+//
+// Parameters:
+//   - mgr: The controller-runtime manager to register the webhook with.
+//
+// Returns:
+//   - error: Always nil (for future extensibility).
+func (d *Defaulter) SetupWithManager(mgr ctrl.Manager) error {
+	hookServer := mgr.GetWebhookServer()
+	hookServer.Register("/mutate-kueue", webhookutils.NewAdmission(mgr, d.Name, d))
+	return nil
+}
+
+// Handle processes admission requests for create and update operations on kueue workload-related resources,
+// assigning the namespace default LocalQueue to workloads missing the Kueue queue name label.
+//
+// Parameters:
+//   - ctx: Context for the admission request (logger is extracted from here).
+//   - req: The admission.Request containing the operation and object details.
+//
+// Returns:
+//   - admission.Response: A patch response if a default queue was assigned, an allowed response otherwise.
+func (d *Defaulter) Handle(ctx context.Context, req admission.Request) admission.Response {
+	log := logf.FromContext(ctx)
+
+	// Check if decoder is properly injected
+	if d.Decoder == nil {
+		log.Error(nil, "Decoder is nil - webhook not properly initialized")
+		return admission.Errored(http.StatusInternalServerError, errors.New("webhook decoder not initialized"))
+	}
+
+	// Validate that we're processing an expected resource kind
+	if !isExpectedKind(req.Kind) {
+		err := fmt.Errorf("unexpected kind: %s", req.Kind.Kind)
+		log.Error(err, "got wrong kind", "group", req.Kind.Group, "version", req.Kind.Version, "kind", req.Kind.Kind)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	obj, err := webhookutils.DecodeUnstructured(d.Decoder, req)
+	if err != nil {
+		log.Error(err, "failed to decode object")
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	// Skip processing if object is marked for deletion
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return admission.Allowed("Object marked for deletion, skipping Kueue default queue assignment")
+	}
+
+	switch req.Operation {
+	case admissionv1.Create, admissionv1.Update:
+		return d.performDefaultQueueAssignment(ctx, &req, obj)
+	default:
+		return admission.Allowed(fmt.Sprintf("Operation %s on %s allowed", req.Operation, req.Kind.Kind))
+	}
+}
+
+// performDefaultQueueAssignment sets the Kueue queue name label to the namespace default queue if it is missing,
+// on create or when the label is removed by an update, so that existing workloads are not moved to a queue.
+// Workloads with an explicit, even if empty, queue name label are left untouched for the validating webhook to check.
+//
+// Parameters:
+//   - ctx: Context for the admission request
+//   - req: The admission.Request containing the operation and object details
+//   - obj: The decoded workload
+//
+// Returns:
+//   - admission.Response: A patch response if the label was set, an allowed response otherwise
+func (d *Defaulter) performDefaultQueueAssignment(ctx context.Context, req *admission.Request, obj *unstructured.Unstructured) admission.Response {
+	log := logf.FromContext(ctx)
+	namespace := req.Namespace
+
+	if _, found := obj.GetLabels()[cluster.KueueQueueNameLabel]; found {
+		return admission.Allowed(fmt.Sprintf("Label %q already set, skipping Kueue default queue assignment", cluster.KueueQueueNameLabel))
+	}
+
+	changed, err := queueNameLabelChanged(d.Decoder, req, obj)
+	if err != nil {
+		log.Error(err, "failed to decode old object")
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to decode old object: %w", err))
+	}
+	if !changed {
+		return admission.Allowed(fmt.Sprintf("Label %q unchanged, skipping Kueue default queue assignment", cluster.KueueQueueNameLabel))
+	}
+
+	ns, resp := checkKueueAdmission(ctx, d.Client, namespace, "Kueue default queue assignment")
+	if resp != nil {
+		return *resp
+	}
+
+	queueName, err := resolveDefaultQueueName(ctx, d.Client, ns)
+	if err != nil {
+		log.Error(err, "failed to resolve default Kueue queue", "namespace", namespace)
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to resolve default Kueue queue: %w", err))
+	}
+
+	if queueName == "" {
+		// Nothing to assign, let the validating webhook deny the request
+		return admission.Allowed(fmt.Sprintf("No default Kueue queue configured for namespace %q", namespace))
+	}
+
+	log.V(1).Info("assigning default Kueue queue", "queue", queueName)
+	resources.SetLabel(obj, cluster.KueueQueueNameLabel, queueName)
+
+	marshaledObj, err := json.Marshal(obj)
+	if err != nil {
+		log.Error(err, "failed to marshal modified object")
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledObj)
+}
+
+// resolveDefaultQueueName returns the LocalQueue workloads of the namespace should be assigned to by default:
+// the value of the namespace default queue annotation if set, or the default LocalQueue the Kueue component
+// creates in every managed namespace otherwise.
+//
+// Parameters:
+//   - ctx: Context for the API call
+//   - cli: The controller-runtime client to use for reading the Kueue component
+//   - ns: The namespace metadata
+//
+// Returns:
+//   - string: The default queue name, empty if none could be determined
+//   - error: Any error encountered while reading the Kueue component
+func resolveDefaultQueueName(ctx context.Context, cli client.Reader, ns *metav1.PartialObjectMetadata) (string, error) {
+	if queueName := resources.GetAnnotation(ns, cluster.KueueDefaultQueueNameAnnotation); queueName != "" {
+		return queueName, nil
+	}
+
+	kueue := componentApi.Kueue{}
+	err := cli.Get(ctx, types.NamespacedName{Name: componentApi.KueueInstanceName}, &kueue)
+	switch {
+	case k8serr.IsNotFound(err):
+		return "", nil
+	case err != nil:
+		return "", err
+	}
+
+	return kueue.Spec.DefaultLocalQueueName, nil
+}
@@ -0,0 +1,175 @@
+package kueue_test
+
+import (
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/envtestutil"
+	kueuewebhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/kueue"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+// newKueueWithDefaultQueue creates the Kueue component CR with the given default LocalQueue name.
+func newKueueWithDefaultQueue(queueName string) *componentApi.Kueue {
+	return &componentApi.Kueue{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: componentApi.KueueInstanceName,
+		},
+		Spec: componentApi.KueueSpec{
+			KueueDefaultQueueSpec: componentApi.KueueDefaultQueueSpec{
+				DefaultLocalQueueName: queueName,
+			},
+		},
+	}
+}
+
+// TestKueueWebhook_MutatingWebhook exercises the mutating webhook logic for Kueue default queue assignment.
+// It verifies that the default queue is only assigned to workloads missing the queue name label in Kueue
+// managed namespaces, and that the namespace annotation takes precedence over the Kueue component default.
+func TestKueueWebhook_MutatingWebhook(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	ctx := t.Context()
+	sch, err := scheme.New()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	notebookResource := metav1.GroupVersionResource{
+		Group:    gvk.Notebook.Group,
+		Version:  gvk.Notebook.Version,
+		Resource: "notebooks",
+	}
+
+	withDefaultQueueAnnotation := func(queueName string) func(*corev1.Namespace) {
+		return func(ns *corev1.Namespace) {
+			ns.Annotations = map[string]string{cluster.KueueDefaultQueueNameAnnotation: queueName}
+		}
+	}
+
+	cases := []struct {
+		name          string
+		existingObjs  []client.Object
+		workload      client.Object
+		oldWorkload   client.Object
+		expectedQueue string
+	}{
+		{
+			name: "Default queue from namespace annotation",
+			existingObjs: []client.Object{
+				envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}, withDefaultQueueAnnotation("team-queue")),
+				createDSCWithKueueState(operatorv1.Managed),
+				newKueueWithDefaultQueue("default"),
+			},
+			workload:      envtestutil.NewNotebook("test-notebook", testNamespace),
+			expectedQueue: "team-queue",
+		},
+		{
+			name: "Default queue from Kueue component",
+			existingObjs: []client.Object{
+				envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}),
+				createDSCWithKueueState(operatorv1.Managed),
+				newKueueWithDefaultQueue("default"),
+			},
+			workload:      envtestutil.NewNotebook("test-notebook", testNamespace),
+			expectedQueue: "default",
+		},
+		{
+			name: "Existing queue name label is left untouched",
+			existingObjs: []client.Object{
+				envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}, withDefaultQueueAnnotation("team-queue")),
+				createDSCWithKueueState(operatorv1.Managed),
+			},
+			workload: envtestutil.NewNotebook("test-notebook", testNamespace, func(obj client.Object) {
+				obj.SetLabels(map[string]string{objLabelQueueName: validQueueName})
+			}),
+		},
+		{
+			name: "Namespace not labeled, skip assignment",
+			existingObjs: []client.Object{
+				envtestutil.NewNamespace(testNamespace, map[string]string{}, withDefaultQueueAnnotation("team-queue")),
+				createDSCWithKueueState(operatorv1.Managed),
+			},
+			workload: envtestutil.NewNotebook("test-notebook", testNamespace),
+		},
+		{
+			name: "Kueue not enabled, skip assignment",
+			existingObjs: []client.Object{
+				envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}, withDefaultQueueAnnotation("team-queue")),
+				createDSCWithKueueState(operatorv1.Removed),
+			},
+			workload: envtestutil.NewNotebook("test-notebook", testNamespace),
+		},
+		{
+			name: "Update without queue name label is left untouched",
+			existingObjs: []client.Object{
+				envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}, withDefaultQueueAnnotation("team-queue")),
+				createDSCWithKueueState(operatorv1.Managed),
+			},
+			workload:    envtestutil.NewNotebook("test-notebook", testNamespace),
+			oldWorkload: envtestutil.NewNotebook("test-notebook", testNamespace),
+		},
+		{
+			name: "Queue name label removed by an update",
+			existingObjs: []client.Object{
+				envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}, withDefaultQueueAnnotation("team-queue")),
+				createDSCWithKueueState(operatorv1.Managed),
+			},
+			workload: envtestutil.NewNotebook("test-notebook", testNamespace),
+			oldWorkload: envtestutil.NewNotebook("test-notebook", testNamespace, func(obj client.Object) {
+				obj.SetLabels(map[string]string{objLabelQueueName: validQueueName})
+			}),
+			expectedQueue: "team-queue",
+		},
+		{
+			name: "No default queue configured",
+			existingObjs: []client.Object{
+				envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}),
+				createDSCWithKueueState(operatorv1.Managed),
+			},
+			workload: envtestutil.NewNotebook("test-notebook", testNamespace),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(tc.existingObjs...).Build()
+			defaulter := &kueuewebhook.Defaulter{
+				Client:  cli,
+				Name:    "test-defaulter",
+				Decoder: admission.NewDecoder(sch),
+			}
+
+			req := envtestutil.NewAdmissionRequest(t, admissionv1.Create, tc.workload, gvk.Notebook, notebookResource)
+			if tc.oldWorkload != nil {
+				req = envtestutil.WithOldObject(t,
+					envtestutil.NewAdmissionRequest(t, admissionv1.Update, tc.workload, gvk.Notebook, notebookResource),
+					tc.oldWorkload)
+			}
+			resp := defaulter.Handle(ctx, req)
+			g.Expect(resp.Allowed).To(BeTrue())
+
+			if tc.expectedQueue == "" {
+				g.Expect(resp.Patches).To(BeEmpty())
+				return
+			}
+
+			g.Expect(resp.Patches).To(HaveLen(1))
+			g.Expect(resp.Patches[0].Operation).To(Equal("add"))
+			g.Expect(resp.Patches[0].Value).To(HaveKeyWithValue(objLabelQueueName, tc.expectedQueue))
+		})
+	}
+}
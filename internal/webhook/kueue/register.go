@@ -7,7 +7,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
-// RegisterWebhooks registers the webhooks for kueue label validation.
+// RegisterWebhooks registers the webhooks for kueue label validation and default queue assignment.
 //
 // Parameters:
 //   - mgr: The controller-runtime manager to register webhooks with.
@@ -23,5 +23,13 @@ func RegisterWebhooks(mgr ctrl.Manager) error {
 		return err
 	}
 
+	if err := (&Defaulter{
+		Client:  mgr.GetAPIReader(),
+		Decoder: admission.NewDecoder(mgr.GetScheme()),
+		Name:    "kueue-mutating",
+	}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+
 	return nil
 }
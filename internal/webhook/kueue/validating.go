@@ -11,6 +11,7 @@ import (
 	operatorv1 "github.com/openshift/api/operator/v1"
 	admissionv1 "k8s.io/api/admission/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -18,7 +19,6 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
-	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
@@ -41,6 +41,11 @@ var (
 	// Error messages for Kueue label validation.
 	ErrMissingRequiredLabel = fmt.Errorf("missing required label %q", cluster.KueueQueueNameLabel)
 	ErrEmptyRequiredLabel   = fmt.Errorf("label %q is set but empty", cluster.KueueQueueNameLabel)
+
+	// Error messages for Kueue queue validation.
+	ErrLocalQueueNotFound   = errors.New("LocalQueue does not exist")
+	ErrClusterQueueInactive = errors.New("ClusterQueue is not active")
+	ErrKueueAPINotInstalled = errors.New("Kueue API is not installed")
 )
 
 // Validator implements webhook.AdmissionHandler for Kueue validation webhooks.
@@ -62,10 +67,7 @@ var _ admission.Handler = &Validator{}
 //   - error: Always nil (for future extensibility).
 func (v *Validator) SetupWithManager(mgr ctrl.Manager) error {
 	hookServer := mgr.GetWebhookServer()
-	hookServer.Register("/validate-kueue", &webhook.Admission{
-		Handler:        v,
-		LogConstructor: webhookutils.NewWebhookLogConstructor(v.Name),
-	})
+	hookServer.Register("/validate-kueue", webhookutils.NewAdmission(mgr, v.Name, v))
 	return nil
 }
 
@@ -184,26 +186,78 @@ func validateNamespaceLabels(ns client.Object) bool {
 		resources.HasLabel(ns, cluster.KueueLegacyManagedLabelKey, "true")
 }
 
-// isNamespaceManagedByKueue checks if the given namespace is labeled for Kueue management.
+// getNamespace returns the metadata of the given namespace.
 //
 // Parameters:
 //   - ctx: Context for the API call
-//   - cli: The controller-runtime client to use for checking the namespace labels
-//   - namespace: The name of the namespace to check
+//   - cli: The controller-runtime client to use for getting the namespace
+//   - namespace: The name of the namespace to get
 //
 // Returns:
-//   - bool: true if the namespace is labeled for Kueue, false otherwise
-//   - error: Any error encountered while checking the namespace labels
-func isNamespaceManagedByKueue(ctx context.Context, cli client.Reader, namespace string) (bool, error) {
+//   - *metav1.PartialObjectMetadata: The namespace metadata
+//   - error: Any error encountered while getting the namespace
+func getNamespace(ctx context.Context, cli client.Reader, namespace string) (*metav1.PartialObjectMetadata, error) {
 	ns := &metav1.PartialObjectMetadata{}
 	ns.SetGroupVersionKind(gvk.Namespace)
 
 	if err := cli.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
-		// Unable to get the namespace, return an error
-		return false, err
+		return nil, err
 	}
 
-	return validateNamespaceLabels(ns), nil
+	return ns, nil
+}
+
+// checkKueueAdmission checks whether Kueue admission logic applies to workloads of the given namespace,
+// which is the case when the namespace is labeled for Kueue management and Kueue is enabled in the DSC.
+//
+// Parameters:
+//   - ctx: Context for the admission request
+//   - cli: The controller-runtime client to use for reading the namespace and the DSC
+//   - namespace: The namespace of the workload
+//   - action: A short description of the admission logic, used in the response messages
+//
+// Returns:
+//   - *metav1.PartialObjectMetadata: The namespace metadata, when Kueue admission applies
+//   - *admission.Response: The response to return immediately, when Kueue admission does not apply or fails
+func checkKueueAdmission(ctx context.Context, cli client.Reader, namespace string, action string) (*metav1.PartialObjectMetadata, *admission.Response) {
+	log := logf.FromContext(ctx)
+
+	// Check if the namespace is labeled for Kueue management
+	// TODO: to be removed: https://issues.redhat.com/browse/RHOAIENG-27558
+	ns, err := getNamespace(ctx, cli, namespace)
+	if err != nil {
+		// Unable to determine if the namespace is labeled for Kueue, return an error response
+		log.Error(err, "failed to check namespace Kueue labels", "namespace", namespace)
+		resp := admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to check if namespace %q is labeled for Kueue: %w", namespace, err))
+		return nil, &resp
+	}
+
+	if !validateNamespaceLabels(ns) {
+		// Namespace is not labeled for Kueue
+		resp := admission.Allowed(fmt.Sprintf("Namespace %q is not labeled for Kueue (%q), skipping %s", namespace, cluster.KueueManagedLabelKey, action))
+		return nil, &resp
+	}
+
+	// Check if Kueue is enabled in the DataScienceCluster (DSC)
+	kueueEnabled, err := isKueueEnabledInDSC(ctx, cli)
+
+	switch {
+	case err != nil && k8serr.IsNotFound(err):
+		// DSC not found — skip
+		resp := admission.Allowed("No DataScienceCluster found, skipping " + action)
+		return nil, &resp
+	case err != nil:
+		// Unable to determine if Kueue is enabled, return an error response
+		log.Error(err, "failed to check if Kueue is enabled in DSC")
+		resp := admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to check if Kueue is enabled: %w", err))
+		return nil, &resp
+	case !kueueEnabled:
+		// Kueue is not enabled in the DSC
+		resp := admission.Allowed("Kueue is not enabled in DSC, skipping " + action)
+		return nil, &resp
+	}
+
+	return ns, nil
 }
 
 // validateKueueLabels checks if the required Kueue labels are present and valid.
@@ -235,7 +289,96 @@ func validateKueueLabels(labels map[string]string) error {
 	return nil
 }
 
-// performLabelValidation checks if the Kueue labels are present and valid for the given request.
+// validateQueue checks that the LocalQueue referenced by a workload exists in its namespace, and that
+// the ClusterQueue backing it is active, so that the workload can actually be admitted by Kueue.
+//
+// Parameters:
+//   - ctx: Context for the API call
+//   - cli: The controller-runtime client to use for reading the queues
+//   - namespace: The namespace of the workload
+//   - queueName: The name of the LocalQueue referenced by the workload
+//
+// Returns:
+//   - error: ErrLocalQueueNotFound, ErrClusterQueueInactive or ErrKueueAPINotInstalled (wrapped) if the queue
+//     cannot admit workloads, any other error if the queues could not be read
+func validateQueue(ctx context.Context, cli client.Reader, namespace string, queueName string) error {
+	lq := resources.GvkToUnstructured(gvk.LocalQueue)
+	if err := cli.Get(ctx, types.NamespacedName{Namespace: namespace, Name: queueName}, lq); err != nil {
+		switch {
+		case k8serr.IsNotFound(err):
+			return fmt.Errorf("%w: %q in namespace %q", ErrLocalQueueNotFound, queueName, namespace)
+		case meta.IsNoMatchError(err):
+			return fmt.Errorf("%w: unable to check LocalQueue %q in namespace %q", ErrKueueAPINotInstalled, queueName, namespace)
+		}
+		return fmt.Errorf("failed to get LocalQueue %q in namespace %q: %w", queueName, namespace, err)
+	}
+
+	clusterQueueName, _, err := unstructured.NestedString(lq.Object, "spec", "clusterQueue")
+	if err != nil {
+		return fmt.Errorf("failed to get ClusterQueue of LocalQueue %q: %w", queueName, err)
+	}
+	if clusterQueueName == "" {
+		return fmt.Errorf("%w: LocalQueue %q does not reference a ClusterQueue", ErrClusterQueueInactive, queueName)
+	}
+
+	cq := resources.GvkToUnstructured(gvk.ClusterQueue)
+	if err := cli.Get(ctx, types.NamespacedName{Name: clusterQueueName}, cq); err != nil {
+		switch {
+		case k8serr.IsNotFound(err):
+			return fmt.Errorf("%w: %q referenced by LocalQueue %q does not exist", ErrClusterQueueInactive, clusterQueueName, queueName)
+		case meta.IsNoMatchError(err):
+			return fmt.Errorf("%w: unable to check ClusterQueue %q referenced by LocalQueue %q", ErrKueueAPINotInstalled, clusterQueueName, queueName)
+		}
+		return fmt.Errorf("failed to get ClusterQueue %q: %w", clusterQueueName, err)
+	}
+
+	conditions, _, err := unstructured.NestedSlice(cq.Object, "status", "conditions")
+	if err != nil {
+		return fmt.Errorf("failed to get conditions of ClusterQueue %q: %w", clusterQueueName, err)
+	}
+
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if condition["type"] == "Active" && condition["status"] == string(metav1.ConditionTrue) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %q referenced by LocalQueue %q", ErrClusterQueueInactive, clusterQueueName, queueName)
+}
+
+// queueNameLabelChanged returns true when the Kueue queue name label is assigned by the request: always on create,
+// on update only when the label is added, removed or set to a different queue.
+//
+// Parameters:
+//   - decoder: The decoder of the old object
+//   - req: The admission.Request containing the operation and the old object
+//   - obj: The decoded workload
+//
+// Returns:
+//   - bool: true if the queue name label is assigned by the request
+//   - error: If the old object could not be decoded
+func queueNameLabelChanged(decoder admission.Decoder, req *admission.Request, obj *unstructured.Unstructured) (bool, error) {
+	if req.Operation != admissionv1.Update || len(req.OldObject.Raw) == 0 {
+		return true, nil
+	}
+
+	old := &unstructured.Unstructured{}
+	if err := decoder.DecodeRaw(req.OldObject, old); err != nil {
+		return false, err
+	}
+
+	oldQueue, oldFound := old.GetLabels()[cluster.KueueQueueNameLabel]
+	newQueue, newFound := obj.GetLabels()[cluster.KueueQueueNameLabel]
+
+	return oldFound != newFound || oldQueue != newQueue, nil
+}
+
+// performLabelValidation checks if the Kueue labels are present and valid for the given request,
+// and, on create or when the queue name label changes, that the referenced LocalQueue can admit the workload.
 //
 // Parameters:
 //   - ctx: Context for the admission request
@@ -249,42 +392,36 @@ func (v *Validator) performLabelValidation(ctx context.Context, req *admission.R
 
 	// Object already decoded in Handle method and passed as parameter
 
-	// Check if the namespace is labeled for Kueue management
-	// TODO: to be removed: https://issues.redhat.com/browse/RHOAIENG-27558
-	kueueManagedNamespace, err := isNamespaceManagedByKueue(ctx, v.Client, namespace)
-	if err != nil {
-		// Unable to determine if the namespace is labeled for Kueue, return an error response
-		log.Error(err, "failed to check namespace Kueue labels", "namespace", namespace)
-		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to check if namespace %q is labeled for Kueue: %w", namespace, err))
+	if _, resp := checkKueueAdmission(ctx, v.Client, namespace, "Kueue label validation"); resp != nil {
+		return *resp
 	}
 
-	if !kueueManagedNamespace {
-		// Namespace is not labeled for Kueue
-		return admission.Allowed(fmt.Sprintf("Namespace %q is not labeled for Kueue (%q), skipping Kueue label validation", namespace, cluster.KueueManagedLabelKey))
+	// Check if the workload has Kueue labels
+	if err := validateKueueLabels(obj.GetLabels()); err != nil {
+		// No Kueue labels found
+		return admission.Denied(fmt.Sprintf("Kueue label validation failed: %v", err))
 	}
 
-	// Check if Kueue is enabled in the DataScienceCluster (DSC)
-	kueueEnabled, err := isKueueEnabledInDSC(ctx, v.Client)
+	// Existing workloads keep running in the queue they were admitted to, the queue is only checked when assigned
+	changed, err := queueNameLabelChanged(v.Decoder, req, obj)
+	if err != nil {
+		log.Error(err, "failed to decode old object")
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to decode old object: %w", err))
+	}
+	if !changed {
+		return admission.Allowed(fmt.Sprintf("Label %q unchanged, skipping Kueue queue validation", cluster.KueueQueueNameLabel))
+	}
 
+	// Check that the referenced queue exists and is usable
+	err = validateQueue(ctx, v.Client, namespace, obj.GetLabels()[cluster.KueueQueueNameLabel])
 	switch {
-	case err != nil && k8serr.IsNotFound(err):
-		// DSC not found — skip validation
-		return admission.Allowed("No DataScienceCluster found, skipping Kueue label validation")
+	case errors.Is(err, ErrLocalQueueNotFound), errors.Is(err, ErrClusterQueueInactive), errors.Is(err, ErrKueueAPINotInstalled):
+		return admission.Denied(fmt.Sprintf("Kueue queue validation failed: %v", err))
 	case err != nil:
-		// Unable to determine if Kueue is enabled, return an error response
-		log.Error(err, "failed to check if Kueue is enabled in DSC")
-		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to check if Kueue is enabled: %w", err))
-	case !kueueEnabled:
-		// Kueue is not enabled in the DSC
-		return admission.Allowed("Kueue is not enabled in DSC, skipping Kueue label validation")
-	}
-
-	// Check if the workload has Kueue labels
-	if err := validateKueueLabels(obj.GetLabels()); err != nil {
-		// No Kueue labels found
-		return admission.Denied(fmt.Sprintf("Kueue label validation failed: %v", err))
+		log.Error(err, "failed to validate Kueue queue", "namespace", namespace)
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to validate Kueue queue: %w", err))
 	}
 
-	// Kueue is enabled, namespace is labeled for Kueue, and workload has Kueue labels
+	// Kueue is enabled, namespace is labeled for Kueue, and workload has Kueue labels referencing an active queue
 	return admission.Allowed(fmt.Sprintf("Kueue label validation passed for %q in namespace %q", req.Kind.Kind, namespace))
 }
@@ -1,14 +1,19 @@
 package kueue_test
 
 import (
+	"context"
+	"net/http"
 	"testing"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
@@ -17,17 +22,19 @@ import (
 	kueuewebhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/kueue"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
 
 	. "github.com/onsi/gomega"
 )
 
 const (
-	testNamespace        = "test-ns"
-	nsLabelManaged       = cluster.KueueManagedLabelKey
-	legacyNsLabelManaged = cluster.KueueLegacyManagedLabelKey
-	objLabelQueueName    = cluster.KueueQueueNameLabel
-	validQueueName       = "queue"
+	testNamespace         = "test-ns"
+	nsLabelManaged        = cluster.KueueManagedLabelKey
+	legacyNsLabelManaged  = cluster.KueueLegacyManagedLabelKey
+	objLabelQueueName     = cluster.KueueQueueNameLabel
+	validQueueName        = "queue"
+	validClusterQueueName = "cluster-queue"
 )
 
 // createDSCWithKueueState creates a DSC with the specified Kueue management state for testing.
@@ -41,6 +48,32 @@ func createDSCWithKueueState(state operatorv1.ManagementState) *dscv1.DataScienc
 	return dsc
 }
 
+// newLocalQueue creates a LocalQueue in the test namespace backed by the given ClusterQueue.
+func newLocalQueue(name string, clusterQueue string) *unstructured.Unstructured {
+	lq := resources.GvkToUnstructured(gvk.LocalQueue)
+	lq.SetName(name)
+	lq.SetNamespace(testNamespace)
+	_ = unstructured.SetNestedField(lq.Object, clusterQueue, "spec", "clusterQueue")
+
+	return lq
+}
+
+// newClusterQueue creates a ClusterQueue with its Active condition set according to the active parameter.
+func newClusterQueue(name string, active bool) *unstructured.Unstructured {
+	status := metav1.ConditionFalse
+	if active {
+		status = metav1.ConditionTrue
+	}
+
+	cq := resources.GvkToUnstructured(gvk.ClusterQueue)
+	cq.SetName(name)
+	_ = unstructured.SetNestedSlice(cq.Object, []interface{}{
+		map[string]interface{}{"type": "Active", "status": string(status)},
+	}, "status", "conditions")
+
+	return cq
+}
+
 // TestKueueWebhook_DeniesWhenDecoderNotInitialized tests that the webhook returns an error when the decoder is nil.
 func TestKueueWebhook_DeniesWhenDecoderNotInitialized(t *testing.T) {
 	t.Parallel()
@@ -131,6 +164,8 @@ func TestKueueWebhook_AcceptsExpectedKinds(t *testing.T) {
 	cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(
 		envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}),
 		createDSCWithKueueState(operatorv1.Managed),
+		newLocalQueue(validQueueName, validClusterQueueName),
+		newClusterQueue(validClusterQueueName, true),
 	).Build()
 	decoder := admission.NewDecoder(sch)
 	validator := &kueuewebhook.Validator{
@@ -278,9 +313,22 @@ func TestKueueWebhook_ValidatingWebhook(t *testing.T) {
 	sch, err := scheme.New()
 	g.Expect(err).ToNot(HaveOccurred())
 
+	notebookResource := metav1.GroupVersionResource{
+		Group:    gvk.Notebook.Group,
+		Version:  gvk.Notebook.Version,
+		Resource: "notebooks",
+	}
+
+	withQueueName := func(queueName string) func(client.Object) {
+		return func(obj client.Object) {
+			obj.SetLabels(map[string]string{objLabelQueueName: queueName})
+		}
+	}
+
 	cases := []struct {
 		name         string
 		existingObjs []client.Object
+		interceptor  interceptor.Funcs
 		req          admission.Request
 		allowed      bool
 		errorMessage string
@@ -370,6 +418,8 @@ func TestKueueWebhook_ValidatingWebhook(t *testing.T) {
 			existingObjs: []client.Object{
 				envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}),
 				createDSCWithKueueState(operatorv1.Managed),
+				newLocalQueue(validQueueName, validClusterQueueName),
+				newClusterQueue(validClusterQueueName, true),
 			},
 			req: envtestutil.NewAdmissionRequest(
 				t,
@@ -391,6 +441,8 @@ func TestKueueWebhook_ValidatingWebhook(t *testing.T) {
 			existingObjs: []client.Object{
 				envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}),
 				createDSCWithKueueState(operatorv1.Managed),
+				newLocalQueue(validQueueName, validClusterQueueName),
+				newClusterQueue(validClusterQueueName, true),
 			},
 			req: envtestutil.NewAdmissionRequest(
 				t,
@@ -415,6 +467,8 @@ func TestKueueWebhook_ValidatingWebhook(t *testing.T) {
 			existingObjs: []client.Object{
 				envtestutil.NewNamespace(testNamespace, map[string]string{legacyNsLabelManaged: "true"}),
 				createDSCWithKueueState(operatorv1.Managed),
+				newLocalQueue(validQueueName, validClusterQueueName),
+				newClusterQueue(validClusterQueueName, true),
 			},
 			req: envtestutil.NewAdmissionRequest(
 				t,
@@ -436,6 +490,8 @@ func TestKueueWebhook_ValidatingWebhook(t *testing.T) {
 			existingObjs: []client.Object{
 				envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}),
 				createDSCWithKueueState(operatorv1.Managed),
+				newLocalQueue(validQueueName, validClusterQueueName),
+				newClusterQueue(validClusterQueueName, true),
 			},
 			req: envtestutil.NewAdmissionRequest(
 				t,
@@ -472,6 +528,151 @@ func TestKueueWebhook_ValidatingWebhook(t *testing.T) {
 			allowed:      false,
 			errorMessage: "Kueue label validation failed: missing required label \"kueue.x-k8s.io/queue-name\"",
 		},
+		{
+			name: "LocalQueue does not exist",
+			existingObjs: []client.Object{
+				envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}),
+				createDSCWithKueueState(operatorv1.Managed),
+			},
+			req: envtestutil.NewAdmissionRequest(
+				t,
+				admissionv1.Create,
+				envtestutil.NewNotebook("test-notebook", testNamespace, func(obj client.Object) {
+					obj.SetLabels(map[string]string{objLabelQueueName: validQueueName})
+				}),
+				gvk.Notebook,
+				metav1.GroupVersionResource{
+					Group:    gvk.Notebook.Group,
+					Version:  gvk.Notebook.Version,
+					Resource: "notebooks",
+				},
+			),
+			allowed:      false,
+			errorMessage: "Kueue queue validation failed: LocalQueue does not exist: \"queue\" in namespace \"test-ns\"",
+		},
+		{
+			name: "ClusterQueue is not active",
+			existingObjs: []client.Object{
+				envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}),
+				createDSCWithKueueState(operatorv1.Managed),
+				newLocalQueue(validQueueName, validClusterQueueName),
+				newClusterQueue(validClusterQueueName, false),
+			},
+			req: envtestutil.NewAdmissionRequest(
+				t,
+				admissionv1.Create,
+				envtestutil.NewNotebook("test-notebook", testNamespace, func(obj client.Object) {
+					obj.SetLabels(map[string]string{objLabelQueueName: validQueueName})
+				}),
+				gvk.Notebook,
+				metav1.GroupVersionResource{
+					Group:    gvk.Notebook.Group,
+					Version:  gvk.Notebook.Version,
+					Resource: "notebooks",
+				},
+			),
+			allowed:      false,
+			errorMessage: "Kueue queue validation failed: ClusterQueue is not active: \"cluster-queue\" referenced by LocalQueue \"queue\"",
+		},
+		{
+			name: "ClusterQueue does not exist",
+			existingObjs: []client.Object{
+				envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}),
+				createDSCWithKueueState(operatorv1.Managed),
+				newLocalQueue(validQueueName, validClusterQueueName),
+			},
+			req: envtestutil.NewAdmissionRequest(
+				t,
+				admissionv1.Create,
+				envtestutil.NewNotebook("test-notebook", testNamespace, func(obj client.Object) {
+					obj.SetLabels(map[string]string{objLabelQueueName: validQueueName})
+				}),
+				gvk.Notebook,
+				metav1.GroupVersionResource{
+					Group:    gvk.Notebook.Group,
+					Version:  gvk.Notebook.Version,
+					Resource: "notebooks",
+				},
+			),
+			allowed:      false,
+			errorMessage: "does not exist",
+		},
+		{
+			name: "Update operation with unchanged label and inactive ClusterQueue",
+			existingObjs: []client.Object{
+				envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}),
+				createDSCWithKueueState(operatorv1.Managed),
+				newLocalQueue(validQueueName, validClusterQueueName),
+				newClusterQueue(validClusterQueueName, false),
+			},
+			req: envtestutil.WithOldObject(t, envtestutil.NewAdmissionRequest(
+				t,
+				admissionv1.Update,
+				envtestutil.NewNotebook("test-notebook", testNamespace, withQueueName(validQueueName)),
+				gvk.Notebook,
+				notebookResource,
+			), envtestutil.NewNotebook("test-notebook", testNamespace, withQueueName(validQueueName))),
+			allowed: true,
+		},
+		{
+			name: "Update operation changing the label to an inactive ClusterQueue",
+			existingObjs: []client.Object{
+				envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}),
+				createDSCWithKueueState(operatorv1.Managed),
+				newLocalQueue(validQueueName, validClusterQueueName),
+				newClusterQueue(validClusterQueueName, false),
+			},
+			req: envtestutil.WithOldObject(t, envtestutil.NewAdmissionRequest(
+				t,
+				admissionv1.Update,
+				envtestutil.NewNotebook("test-notebook", testNamespace, withQueueName(validQueueName)),
+				gvk.Notebook,
+				notebookResource,
+			), envtestutil.NewNotebook("test-notebook", testNamespace, withQueueName("other-queue"))),
+			allowed:      false,
+			errorMessage: "ClusterQueue is not active",
+		},
+		{
+			name: "LocalQueue without ClusterQueue",
+			existingObjs: []client.Object{
+				envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}),
+				createDSCWithKueueState(operatorv1.Managed),
+				newLocalQueue(validQueueName, ""),
+			},
+			req: envtestutil.NewAdmissionRequest(
+				t,
+				admissionv1.Create,
+				envtestutil.NewNotebook("test-notebook", testNamespace, withQueueName(validQueueName)),
+				gvk.Notebook,
+				notebookResource,
+			),
+			allowed:      false,
+			errorMessage: "LocalQueue \"queue\" does not reference a ClusterQueue",
+		},
+		{
+			name: "Kueue API not installed",
+			existingObjs: []client.Object{
+				envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}),
+				createDSCWithKueueState(operatorv1.Managed),
+			},
+			interceptor: interceptor.Funcs{
+				Get: func(ctx context.Context, cli client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					if obj.GetObjectKind().GroupVersionKind() == gvk.LocalQueue {
+						return &meta.NoKindMatchError{GroupKind: gvk.LocalQueue.GroupKind()}
+					}
+					return cli.Get(ctx, key, obj, opts...)
+				},
+			},
+			req: envtestutil.NewAdmissionRequest(
+				t,
+				admissionv1.Create,
+				envtestutil.NewNotebook("test-notebook", testNamespace, withQueueName(validQueueName)),
+				gvk.Notebook,
+				notebookResource,
+			),
+			allowed:      false,
+			errorMessage: "Kueue API is not installed",
+		},
 		{
 			name: "Delete operation should be allowed",
 			existingObjs: []client.Object{
@@ -496,7 +697,7 @@ func TestKueueWebhook_ValidatingWebhook(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
-			cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(tc.existingObjs...).Build()
+			cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(tc.existingObjs...).WithInterceptorFuncs(tc.interceptor).Build()
 			decoder := admission.NewDecoder(sch)
 			validator := &kueuewebhook.Validator{
 				Client:  cli,
@@ -506,6 +707,7 @@ func TestKueueWebhook_ValidatingWebhook(t *testing.T) {
 			resp := validator.Handle(ctx, tc.req)
 			g.Expect(resp.Allowed).To(Equal(tc.allowed))
 			if !tc.allowed {
+				g.Expect(resp.Result.Code).To(Equal(int32(http.StatusForbidden)), "Expected request to be denied, not errored")
 				g.Expect(resp.Result.Message).ToNot(BeEmpty(), "Expected error message when request is denied")
 				if tc.errorMessage != "" {
 					g.Expect(resp.Result.Message).To(ContainSubstring(tc.errorMessage), "Expected specific error message")
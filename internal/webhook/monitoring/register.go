@@ -0,0 +1,21 @@
+//go:build !nowebhook
+
+package monitoring
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// RegisterWebhooks registers the webhooks for Monitoring.
+func RegisterWebhooks(mgr ctrl.Manager) error {
+	if err := (&Validator{
+		Client:  mgr.GetAPIReader(),
+		Decoder: admission.NewDecoder(mgr.GetScheme()),
+		Name:    "monitoring-validating",
+	}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+
+	return nil
+}
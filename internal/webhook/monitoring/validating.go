@@ -0,0 +1,222 @@
+//go:build !nowebhook
+
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	monitoringctrl "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/monitoring"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/otelcol"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/promql"
+	webhookutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/webhook"
+)
+
+//+kubebuilder:webhook:path=/validate-monitoring,mutating=false,failurePolicy=fail,sideEffects=None,groups=services.platform.opendatahub.io,resources=monitorings,verbs=create;update,versions=v1alpha1,name=monitoring-validator.opendatahub.io,admissionReviewVersions=v1
+//nolint:lll
+
+// Validator implements webhook.AdmissionHandler for Monitoring validation webhooks.
+// It checks that the custom metrics exporters match the schema of their type, that the custom alerting rules
+// are valid PromQL, that the alerting rules parameters exist, and that the Secrets referenced by the alerting
+// receivers exist in the monitoring namespace.
+type Validator struct {
+	Client  client.Reader
+	Decoder admission.Decoder
+	Name    string
+}
+
+// Assert that Validator implements admission.Handler interface.
+var _ admission.Handler = &Validator{}
+
+// SetupWithManager registers the validating webhook with the provided controller-runtime manager.
+//
+// Parameters:
+//   - mgr: The controller-runtime manager to register the webhook with.
+//
+// Returns:
+//   - error: Always nil (for future extensibility).
+func (v *Validator) SetupWithManager(mgr ctrl.Manager) error {
+	hookServer := mgr.GetWebhookServer()
+	hookServer.Register("/validate-monitoring", webhookutils.NewAdmission(mgr, v.Name, v))
+	return nil
+}
+
+// Handle processes admission requests for create and update operations on Monitoring resources.
+//
+// Parameters:
+//   - ctx: Context for the admission request (logger is extracted from here).
+//   - req: The admission.Request containing the operation and object details.
+//
+// Returns:
+//   - admission.Response: The result of the admission check, indicating whether the operation is allowed or denied.
+func (v *Validator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	log := logf.FromContext(ctx)
+
+	if v.Decoder == nil {
+		log.Error(nil, "Decoder is nil - webhook not properly initialized")
+		return admission.Errored(http.StatusInternalServerError, errors.New("webhook decoder not initialized"))
+	}
+
+	switch req.Operation {
+	case admissionv1.Create, admissionv1.Update:
+	default:
+		return admission.Allowed(fmt.Sprintf("Operation %s on %s allowed", req.Operation, req.Kind.Kind))
+	}
+
+	monitoring := &serviceApi.Monitoring{}
+	if err := v.Decoder.Decode(req, monitoring); err != nil {
+		log.Error(err, "failed to decode object")
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to decode object: %w", err))
+	}
+
+	if monitoring.Spec.Metrics != nil {
+		if err := ValidateMetricsExporters(monitoring.Spec.Metrics); err != nil {
+			return admission.Denied(fmt.Sprintf("invalid metrics configuration: %v", err))
+		}
+	}
+
+	if monitoring.Spec.Alerting == nil {
+		return admission.Allowed("No alerting configuration")
+	}
+
+	if err := ValidateAlertingRules(monitoring.Spec.Alerting); err != nil {
+		return admission.Denied(fmt.Sprintf("invalid alerting configuration: %v", err))
+	}
+
+	if err := monitoringctrl.ValidateAlertParameters(monitoring.Spec.Alerting); err != nil {
+		return admission.Denied(fmt.Sprintf("invalid alerting configuration: %v", err))
+	}
+
+	if err := ValidateAlertingSecrets(ctx, v.Client, monitoring.Spec.Namespace, monitoring.Spec.Alerting); err != nil {
+		var denied *secretReferenceError
+		if errors.As(err, &denied) {
+			return admission.Denied(fmt.Sprintf("invalid alerting configuration: %v", err))
+		}
+
+		log.Error(err, "failed to validate alerting secrets")
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	return admission.Allowed(fmt.Sprintf("Operation %s on %s allowed", req.Operation, req.Kind.Kind))
+}
+
+// ValidateMetricsExporters checks the custom metrics exporters against the schema of their type, and that
+// their names do not collide with the exporters rendered for the metrics destinations.
+//
+// Parameters:
+//   - metrics: The metrics configuration.
+//
+// Returns:
+//   - error: The problems found, nil if the exporters are valid.
+func ValidateMetricsExporters(metrics *serviceApi.Metrics) error {
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(metrics.Exporters)) {
+		cfg, err := otelcol.ParseExporterConfig(name, metrics.Exporters[name])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if err := otelcol.ValidateExporterConfig(name, cfg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, d := range metrics.Destinations {
+		name := monitoringctrl.MetricsDestinationExporterName(d)
+		if _, ok := metrics.Exporters[name]; ok {
+			errs = append(errs, fmt.Errorf("exporter '%s' is defined by both exporters and destination '%s'", name, d.Name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateAlertingRules checks the PromQL expressions of the custom alerting rules.
+//
+// Parameters:
+//   - alerting: The alerting configuration.
+//
+// Returns:
+//   - error: The invalid expressions found, nil if all the expressions are valid.
+func ValidateAlertingRules(alerting *serviceApi.Alerting) error {
+	var errs []error
+	for _, r := range alerting.Rules {
+		for _, c := range r.CustomRules {
+			if err := promql.Validate(c.Expr); err != nil {
+				errs = append(errs, fmt.Errorf("custom rule %s of component %s: %w", c.Alert, r.Component, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// secretReferenceError reports a Secret key referenced by a receiver which does not exist.
+type secretReferenceError struct {
+	receiver string
+	ref      serviceApi.SecretKeyReference
+	reason   string
+}
+
+func (e *secretReferenceError) Error() string {
+	return fmt.Sprintf("receiver %s references Secret %s key %s: %s", e.receiver, e.ref.Name, e.ref.Key, e.reason)
+}
+
+// ValidateAlertingSecrets checks that the Secret keys referenced by the alerting receivers exist in the
+// monitoring namespace.
+//
+// Parameters:
+//   - ctx: Context for the API calls.
+//   - cli: The client used to read the Secrets.
+//   - namespace: The monitoring namespace.
+//   - alerting: The alerting configuration.
+//
+// Returns:
+//   - error: The first missing Secret key found, or the error encountered reading a Secret.
+func ValidateAlertingSecrets(ctx context.Context, cli client.Reader, namespace string, alerting *serviceApi.Alerting) error {
+	for _, r := range alerting.Receivers {
+		var refs []serviceApi.SecretKeyReference
+
+		switch {
+		case r.Webhook != nil:
+			refs = append(refs, r.Webhook.URLSecret)
+		case r.Email != nil && r.Email.AuthPasswordSecret != nil:
+			refs = append(refs, *r.Email.AuthPasswordSecret)
+		case r.PagerDuty != nil:
+			refs = append(refs, r.PagerDuty.RoutingKeySecret)
+		case r.Slack != nil:
+			refs = append(refs, r.Slack.APIURLSecret)
+		}
+
+		for _, ref := range refs {
+			secret := corev1.Secret{}
+			err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, &secret)
+			switch {
+			case k8serr.IsNotFound(err):
+				return &secretReferenceError{receiver: r.Name, ref: ref, reason: "Secret not found in namespace " + namespace}
+			case err != nil:
+				return fmt.Errorf("failed to get Secret %s/%s: %w", namespace, ref.Name, err)
+			}
+
+			if _, ok := secret.Data[ref.Key]; !ok {
+				return &secretReferenceError{receiver: r.Name, ref: ref, reason: "key not found in Secret"}
+			}
+		}
+	}
+
+	return nil
+}
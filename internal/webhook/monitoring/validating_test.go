@@ -0,0 +1,212 @@
+package monitoring_test
+
+import (
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/envtestutil"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/monitoring"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+const monitoringNamespace = "monitoring-ns"
+
+func newMonitoring(alerting *serviceApi.Alerting) *serviceApi.Monitoring {
+	return &serviceApi.Monitoring{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gvk.Monitoring.GroupVersion().String(),
+			Kind:       gvk.Monitoring.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: serviceApi.MonitoringInstanceName,
+		},
+		Spec: serviceApi.MonitoringSpec{
+			MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+				Namespace: monitoringNamespace,
+				Alerting:  alerting,
+			},
+		},
+	}
+}
+
+func newWebhookAlerting(key string) *serviceApi.Alerting {
+	return &serviceApi.Alerting{
+		DefaultReceiver: "platform",
+		Receivers: []serviceApi.AlertReceiver{
+			{
+				Name: "platform",
+				Webhook: &serviceApi.WebhookReceiver{
+					URLSecret: serviceApi.SecretKeyReference{Name: "alerting", Key: key},
+				},
+			},
+		},
+	}
+}
+
+func newCustomRuleAlerting(expr string) *serviceApi.Alerting {
+	return &serviceApi.Alerting{
+		Rules: []serviceApi.ComponentAlertRules{
+			{
+				Component: "dashboard",
+				CustomRules: []serviceApi.CustomAlertRule{
+					{Alert: "DashboardDown", Expr: expr},
+				},
+			},
+		},
+	}
+}
+
+func newMetricsMonitoring(metrics *serviceApi.Metrics) *serviceApi.Monitoring {
+	m := newMonitoring(nil)
+	m.Spec.Metrics = metrics
+
+	return m
+}
+
+// TestMonitoring_ValidatingWebhook exercises the validating webhook logic for Monitoring resources.
+// It verifies that the custom metrics exporters must match the schema of their type, that the custom alerting
+// rules must be valid PromQL, that the alerting rules parameters must exist, and that the Secrets referenced by the
+// alerting receivers must exist in the monitoring namespace.
+func TestMonitoring_ValidatingWebhook(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	ctx := t.Context()
+	sch, err := scheme.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "alerting",
+			Namespace: monitoringNamespace,
+		},
+		Data: map[string][]byte{
+			"url": []byte("https://alerts.example.com"),
+		},
+	}
+
+	newRequest := func(op admissionv1.Operation, obj client.Object) admission.Request {
+		return envtestutil.NewAdmissionRequest(
+			t,
+			op,
+			obj,
+			gvk.Monitoring,
+			metav1.GroupVersionResource{
+				Group:    gvk.Monitoring.Group,
+				Version:  gvk.Monitoring.Version,
+				Resource: "monitorings",
+			},
+		)
+	}
+
+	cases := []struct {
+		name         string
+		existingObjs []client.Object
+		req          admission.Request
+		allowed      bool
+	}{
+		{
+			name:    "Allows creation without alerting",
+			req:     newRequest(admissionv1.Create, newMonitoring(nil)),
+			allowed: true,
+		},
+		{
+			name:         "Allows creation if the referenced Secret key exists",
+			existingObjs: []client.Object{secret},
+			req:          newRequest(admissionv1.Create, newMonitoring(newWebhookAlerting("url"))),
+			allowed:      true,
+		},
+		{
+			name:    "Denies creation if the referenced Secret does not exist",
+			req:     newRequest(admissionv1.Create, newMonitoring(newWebhookAlerting("url"))),
+			allowed: false,
+		},
+		{
+			name:         "Denies update if the referenced Secret key does not exist",
+			existingObjs: []client.Object{secret},
+			req:          newRequest(admissionv1.Update, newMonitoring(newWebhookAlerting("missing"))),
+			allowed:      false,
+		},
+		{
+			name:    "Allows creation with a valid custom rule",
+			req:     newRequest(admissionv1.Create, newMonitoring(newCustomRuleAlerting(`absent(up{job="dashboard"}) == 1`))),
+			allowed: true,
+		},
+		{
+			name:    "Denies creation with an invalid custom rule",
+			req:     newRequest(admissionv1.Create, newMonitoring(newCustomRuleAlerting(`sum(up{job="dashboard"}`))),
+			allowed: false,
+		},
+		{
+			name: "Allows creation with a known alerting rules parameter",
+			req: newRequest(admissionv1.Create, newMonitoring(&serviceApi.Alerting{
+				Rules: []serviceApi.ComponentAlertRules{
+					{Component: "dashboard", Parameters: map[string]string{"probeSuccessObjective": "0.95"}},
+				},
+			})),
+			allowed: true,
+		},
+		{
+			name: "Denies creation with a misspelled alerting rules parameter",
+			req: newRequest(admissionv1.Create, newMonitoring(&serviceApi.Alerting{
+				Rules: []serviceApi.ComponentAlertRules{
+					{Component: "dashboard", Parameters: map[string]string{"probeSucessObjective": "0.95"}},
+				},
+			})),
+			allowed: false,
+		},
+		{
+			name: "Allows creation with valid metrics exporters and destinations",
+			req: newRequest(admissionv1.Create, newMetricsMonitoring(&serviceApi.Metrics{
+				Exporters: map[string]string{"otlphttp/backend": "endpoint: https://backend.example.com"},
+				Destinations: []serviceApi.MetricsDestination{
+					{Name: "thanos", Type: serviceApi.MetricsDestinationPrometheusRemoteWrite, Endpoint: "https://thanos.example.com/api/v1/receive"},
+				},
+			})),
+			allowed: true,
+		},
+		{
+			name: "Denies creation with a misspelled exporter setting",
+			req: newRequest(admissionv1.Create, newMetricsMonitoring(&serviceApi.Metrics{
+				Exporters: map[string]string{"otlp/jaeger": "endpoint: jaeger:4317\ntsl:\n  insecure: true"},
+			})),
+			allowed: false,
+		},
+		{
+			name: "Denies update with an exporter colliding with a destination",
+			req: newRequest(admissionv1.Update, newMetricsMonitoring(&serviceApi.Metrics{
+				Exporters: map[string]string{"otlp/jaeger": "endpoint: jaeger:4317"},
+				Destinations: []serviceApi.MetricsDestination{
+					{Name: "jaeger", Type: serviceApi.MetricsDestinationOTLPGRPC, Endpoint: "jaeger:4317"},
+				},
+			})),
+			allowed: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(tc.existingObjs...).Build()
+			validator := &monitoring.Validator{
+				Client:  cli,
+				Decoder: admission.NewDecoder(sch),
+				Name:    "test",
+			}
+			resp := validator.Handle(ctx, tc.req)
+			g.Expect(resp.Allowed).To(Equal(tc.allowed))
+			if !tc.allowed {
+				g.Expect(resp.Result.Message).ToNot(BeEmpty(), "Expected error message when request is denied")
+			}
+		})
+	}
+}
@@ -18,7 +18,6 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
-	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
@@ -46,10 +45,7 @@ var _ admission.Handler = &NotebookWebhook{}
 
 func (w *NotebookWebhook) SetupWithManager(mgr ctrl.Manager) error {
 	hookServer := mgr.GetWebhookServer()
-	hookServer.Register("/platform-connection-notebook", &webhook.Admission{
-		Handler:        w,
-		LogConstructor: webhookutils.NewWebhookLogConstructor(w.Name),
-	})
+	hookServer.Register("/platform-connection-notebook", webhookutils.NewAdmission(mgr, w.Name, w))
 	return nil
 }
 
@@ -132,8 +128,8 @@ func (w *NotebookWebhook) validateNotebookConnectionAnnotation(
 		return admission.Denied(fmt.Sprintf("failed to parse connections annotation: %v", err)), false, nil
 	}
 
-	// Validate each connection secret exists and the user has permission to get each secret
-	secretExistsErrors, permissionsErrors, err := w.checkSecretsExistsAndUserHasPermissions(ctx, req, connectionSecrets)
+	// Validate each connection secret exists, the user has permission to get each secret and it matches its connection type schema
+	secretExistsErrors, permissionsErrors, schemaErrors, err := w.checkSecretsExistsAndUserHasPermissions(ctx, req, connectionSecrets)
 	if err != nil {
 		log.Error(err, "error verifying secret(s) exist or confirming user has get permissions for the secret(s)", "connectionSecrets", connectionSecrets)
 		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("error verifying secret(s) exist/user has permissions for them %s: %w", connectionSecrets, err)), false, nil
@@ -148,6 +144,10 @@ func (w *NotebookWebhook) validateNotebookConnectionAnnotation(
 		return admission.Denied(fmt.Sprintf("user does not have permission to access the following connection secret(s): %s", strings.Join(permissionsErrors, ", "))), false, nil
 	}
 
+	if len(schemaErrors) > 0 {
+		return admission.Denied(fmt.Sprintf("some of the connection secret(s) do not match their connection type: %s", strings.Join(schemaErrors, "; "))), false, nil
+	}
+
 	return admission.Allowed("Connection permissions validated successfully"), true, connectionSecrets
 }
 
@@ -191,11 +191,17 @@ func parseConnectionsAnnotation(value string) ([]corev1.SecretReference, error)
 
 // checkSecretsExistsAndUserHasPermissions checks that each connection secret exists
 // It also verifies that the user has permission to "get" the specified secrets using SubjectAccessReviews.
-func (w *NotebookWebhook) checkSecretsExistsAndUserHasPermissions(ctx context.Context, req *admission.Request, secretRefs []corev1.SecretReference) ([]string, []string, error) {
+// Secrets the user is allowed to read are finally validated against the schema of their connection type.
+func (w *NotebookWebhook) checkSecretsExistsAndUserHasPermissions(
+	ctx context.Context,
+	req *admission.Request,
+	secretRefs []corev1.SecretReference,
+) ([]string, []string, []string, error) {
 	log := logf.FromContext(ctx)
 
 	var secretExistsErrors []string
 	var permissionErrors []string
+	var schemaErrors []string
 
 	for _, secretRef := range secretRefs {
 		// First check if the secret is in the same namespace as the notebook
@@ -207,13 +213,14 @@ func (w *NotebookWebhook) checkSecretsExistsAndUserHasPermissions(ctx context.Co
 		}
 		// Second check if the secret even exists using APIReader to bypass cache
 		log.V(1).Info("checking that secret exists", "secret", secretRef.Name, "namespace", secretRef.Namespace)
-		if err := w.APIReader.Get(ctx, client.ObjectKey{Namespace: secretRef.Namespace, Name: secretRef.Name}, &corev1.Secret{}); err != nil {
+		secret := &corev1.Secret{}
+		if err := w.APIReader.Get(ctx, client.ObjectKey{Namespace: secretRef.Namespace, Name: secretRef.Name}, secret); err != nil {
 			if k8serr.IsNotFound(err) {
 				secretExistsErrors = append(secretExistsErrors, fmt.Sprintf("%s/%s", secretRef.Namespace, secretRef.Name))
 				continue
 			}
 			log.Error(err, "failed to check if secret exists", "secret", secretRef.Name, "namespace", secretRef.Namespace)
-			return nil, nil, fmt.Errorf("failed to check if secret exists: %w", err)
+			return nil, nil, nil, fmt.Errorf("failed to check if secret exists: %w", err)
 		}
 
 		// Create a SubjectAccessReview to check if the user can "get" the secret
@@ -236,7 +243,7 @@ func (w *NotebookWebhook) checkSecretsExistsAndUserHasPermissions(ctx context.Co
 		// Send the SubjectAccessReview to the API server to verify permission
 		if err := w.Client.Create(ctx, sar); err != nil {
 			log.Error(err, "failed to create SubjectAccessReview", "secret", secretRef.Name, "namespace", secretRef.Namespace)
-			return nil, nil, fmt.Errorf("failed to create SubjectAccessReview: %w", err)
+			return nil, nil, nil, fmt.Errorf("failed to create SubjectAccessReview: %w", err)
 		}
 
 		// Check the result
@@ -248,12 +255,19 @@ func (w *NotebookWebhook) checkSecretsExistsAndUserHasPermissions(ctx context.Co
 				"evaluationError", sar.Status.EvaluationError,
 			)
 			permissionErrors = append(permissionErrors, fmt.Sprintf("%s/%s", secretRef.Namespace, secretRef.Name))
+			continue
 		}
 
 		log.V(1).Info("user has permission to access secret", "secret", secretRef.Name, "namespace", secretRef.Namespace)
+
+		// Only report schema mismatches on secrets the user can read, to avoid leaking their content
+		if err := webhookutils.ValidateConnectionSecretSchema(secret); err != nil {
+			log.V(1).Info("secret does not match its connection type schema", "secret", secretRef.Name, "namespace", secretRef.Namespace, "reason", err.Error())
+			schemaErrors = append(schemaErrors, err.Error())
+		}
 	}
 
-	return secretExistsErrors, permissionErrors, nil
+	return secretExistsErrors, permissionErrors, schemaErrors, nil
 }
 
 func (w *NotebookWebhook) performConnectionInjection(nb *unstructured.Unstructured, secretRefs []corev1.SecretReference) (bool, *unstructured.Unstructured, error) {
@@ -331,6 +331,104 @@ func TestNotebookWebhook_Handle_Permissions(t *testing.T) {
 	}
 }
 
+func TestNotebookWebhook_Handle_ConnectionSchema(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name              string
+		connectionType    string
+		data              map[string][]byte
+		allowPermission   bool
+		expectedAllowed   bool
+		expectedMessage   string
+		shouldHavePatches bool
+	}{
+		{
+			name:           "s3 secret with all required keys is injected",
+			connectionType: "s3",
+			data: map[string][]byte{
+				"AWS_S3_ENDPOINT":       []byte("https://s3.example.com"),
+				"AWS_ACCESS_KEY_ID":     []byte("id"),
+				"AWS_SECRET_ACCESS_KEY": []byte("secret"),
+			},
+			allowPermission:   true,
+			expectedAllowed:   true,
+			shouldHavePatches: true,
+		},
+		{
+			name:           "s3 secret with missing keys is denied listing them",
+			connectionType: "s3",
+			data: map[string][]byte{
+				"AWS_ACCESS_KEY_ID": []byte("id"),
+				"AWS_S3_ENDPOINT":   []byte(""),
+			},
+			allowPermission: true,
+			expectedAllowed: false,
+			expectedMessage: fmt.Sprintf("connection secret '%s/%s' of type 's3' is missing required key(s): AWS_S3_ENDPOINT, AWS_SECRET_ACCESS_KEY",
+				testNamespace, testSecret1),
+		},
+		{
+			name:              "secret with unknown connection type is not schema validated",
+			connectionType:    "custom-type",
+			data:              map[string][]byte{},
+			allowPermission:   true,
+			expectedAllowed:   true,
+			shouldHavePatches: true,
+		},
+		{
+			name:            "schema is not reported for secrets the user cannot read",
+			connectionType:  "uri-v1",
+			data:            map[string][]byte{},
+			allowPermission: false,
+			expectedAllowed: false,
+			expectedMessage: "user does not have permission to access the following connection secret(s)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			cli := &mockClient{
+				Client:           fake.NewClientBuilder().Build(),
+				allowPermissions: map[string]bool{testSecret1: tt.allowPermission},
+			}
+
+			g.Expect(cli.Create(t.Context(), &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testSecret1,
+					Namespace: testNamespace,
+					Annotations: map[string]string{
+						annotations.ConnectionTypeRef: tt.connectionType,
+					},
+				},
+				Data: tt.data,
+			})).Should(Succeed())
+
+			webhook := createTestWebhook(t, cli)
+
+			notebook := createNotebook(withAnnotations(map[string]string{
+				annotations.Connection: fmt.Sprintf("%s/%s", testNamespace, testSecret1),
+			}))
+			req := createAdmissionRequest(t, admissionv1.Create, notebook)
+
+			resp := webhook.Handle(t.Context(), req)
+
+			g.Expect(resp.Allowed).Should(Equal(tt.expectedAllowed))
+
+			if tt.shouldHavePatches {
+				g.Expect(resp.Patches).ShouldNot(BeEmpty())
+			} else {
+				g.Expect(resp.Patches).Should(BeEmpty())
+			}
+
+			if tt.expectedMessage != "" {
+				g.Expect(resp.Result.Message).Should(ContainSubstring(tt.expectedMessage))
+			}
+		})
+	}
+}
+
 func TestNotebookWebhook_Handle_Operations(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -3,14 +3,21 @@ package cluster
 import (
 	"bytes"
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"errors"
 	"fmt"
+	"maps"
 	"math/big"
 	"net"
+	"reflect"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,16 +25,48 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
 const (
 	CertFieldOwner   = resources.PlatformFieldOwner + "/cert"
 	IngressNamespace = "openshift-ingress"
+
+	// InternalCASecretName is the Secret of the internal CA of the operator, in the operator namespace.
+	InternalCASecretName = "odh-internal-ca"
+	// CACertKey is the key of the CA certificate in the Secrets of the issued certificates.
+	CACertKey = "ca.crt"
+
+	// Values of the certificate label, set on the Secrets of the certificates managed by the operator.
+	CACertificate     = "ca"
+	IssuedCertificate = "issued"
+	CopiedCertificate = "copied"
+
+	DefaultCertificateDuration    = 90 * 24 * time.Hour
+	DefaultCertificateRenewBefore = 30 * 24 * time.Hour
+
+	// CARenewBefore is the window before the expiry of the internal CA in which it is renewed.
+	CARenewBefore = 365 * 24 * time.Hour
+	// CARotationOverlap is the time the successor of the internal CA is published in the CA bundle before it
+	// signs the certificates, for their clients to trust it beforehand.
+	CARotationOverlap = 30 * 24 * time.Hour
+
+	// Keys of the internal CA Secret holding the successor of the CA during its rotation, and the certificate of
+	// the previous CA, kept in the CA bundle until it expires.
+	nextCACertKey     = "next.crt"
+	nextCAKeyKey      = "next.key"
+	previousCACertKey = "previous.crt"
+
+	caDuration = 5 * 365 * 24 * time.Hour
+	rsaKeySize = 2048
 )
 
 var IngressControllerName = types.NamespacedName{
@@ -35,34 +74,373 @@ var IngressControllerName = types.NamespacedName{
 	Name:      "default",
 }
 
-func CreateSelfSignedCertificate(ctx context.Context, c client.Client, secretName, domain, namespace string, metaOptions ...MetaOptions) error {
-	certSecret, err := GenerateSelfSignedCertificateAsSecret(secretName, domain, namespace)
-	if err != nil {
-		return fmt.Errorf("failed generating self-signed certificate: %w", err)
+// CertificateOptions configures the certificates issued by the operator.
+type CertificateOptions struct {
+	// KeyAlgorithm of the private keys, RSA when empty.
+	KeyAlgorithm dsciv1.KeyAlgorithm
+	// Duration is the validity of the issued certificates.
+	Duration time.Duration
+	// RenewBefore is the window before expiry in which the certificates are renewed.
+	RenewBefore time.Duration
+	// Issuer is the cert-manager issuer signing the certificates, when cert-manager is installed.
+	Issuer *dsciv1.CertManagerIssuerReference
+	// CANamespace is the namespace of the internal CA Secret, the operator namespace when empty.
+	CANamespace string
+}
+
+// NewCertificateOptions returns the options of the certificates configured in the DSCInitialization.
+func NewCertificateOptions(spec *dsciv1.CertificatesSpec) CertificateOptions {
+	opts := CertificateOptions{
+		KeyAlgorithm: dsciv1.RSAKeyAlgorithm,
+		Duration:     DefaultCertificateDuration,
+		RenewBefore:  DefaultCertificateRenewBefore,
 	}
 
-	if errApply := ApplyMetaOptions(certSecret, metaOptions...); errApply != nil {
-		return errApply
+	if spec == nil {
+		return opts
 	}
 
-	opts := []client.PatchOption{
-		client.ForceOwnership,
-		client.FieldOwner(CertFieldOwner),
+	if spec.KeyAlgorithm != "" {
+		opts.KeyAlgorithm = spec.KeyAlgorithm
 	}
-	err = resources.Apply(ctx, c, certSecret, opts...)
-	if err != nil && !k8serr.IsAlreadyExists(err) {
+	if spec.Duration != nil && spec.Duration.Duration > 0 {
+		opts.Duration = spec.Duration.Duration
+	}
+	if spec.RenewBefore != nil && spec.RenewBefore.Duration > 0 {
+		opts.RenewBefore = spec.RenewBefore.Duration
+	}
+	// a window longer than the validity would renew the certificates on each reconciliation
+	if opts.RenewBefore >= opts.Duration {
+		opts.RenewBefore = opts.Duration / 3
+	}
+
+	opts.Issuer = spec.CertManagerIssuer
+
+	return opts
+}
+
+// CertificateAuthority is the internal CA of the operator, signing the certificates it issues.
+type CertificateAuthority struct {
+	Certificate    *x509.Certificate
+	CertificatePEM []byte
+	Key            crypto.Signer
+	// BundlePEM holds the certificates to trust: the CA, its successor during a rotation, and the previous CA
+	// until it expires.
+	BundlePEM []byte
+	// RotateAt is the time of the next step of the rotation of the CA.
+	RotateAt time.Time
+}
+
+// CreateCertificate issues the certificate of a domain, stored in a TLS Secret. The certificate is signed by the
+// cert-manager issuer of the options when cert-manager is installed, by the internal CA of the operator otherwise.
+// An existing certificate is kept until it enters its renewal window, or no longer matches the domain or the options.
+func CreateCertificate(ctx context.Context, c client.Client, secretName, domain, namespace string, opts CertificateOptions, metaOptions ...MetaOptions) error {
+	hosts := certificateHosts(domain)
+
+	if opts.Issuer != nil {
+		hasCertManager, err := HasCRD(ctx, c, gvk.CertManagerCertificate)
+		if err != nil {
+			return fmt.Errorf("failed to check if cert-manager is installed: %w", err)
+		}
+		if hasCertManager {
+			return createCertManagerCertificate(ctx, c, secretName, namespace, hosts, opts, metaOptions...)
+		}
+	}
+
+	current, err := GetSecret(ctx, c, namespace, secretName)
+	if err != nil && !k8serr.IsNotFound(err) {
 		return err
 	}
+	if k8serr.IsNotFound(err) {
+		current = nil
+	}
 
-	return nil
+	_, err = issueCertificate(ctx, c, secretName, namespace, hosts, opts, current, metaOptions...)
+
+	return err
+}
+
+// RenewCertificate renews a certificate issued by the internal CA of the operator when it enters its renewal window,
+// no longer matches the options, or is not signed by the current internal CA. It returns true when it was renewed.
+func RenewCertificate(ctx context.Context, c client.Client, secret *corev1.Secret, opts CertificateOptions) (bool, error) {
+	hostsAnnotation := secret.GetAnnotations()[annotations.CertificateHosts]
+	if hostsAnnotation == "" {
+		return false, fmt.Errorf("missing %s annotation on Secret %s/%s", annotations.CertificateHosts, secret.Namespace, secret.Name)
+	}
+
+	hosts := strings.Split(hostsAnnotation, ",")
+
+	return issueCertificate(ctx, c, secret.Name, secret.Namespace, hosts, opts, secret, WithOwnerReference(secret.OwnerReferences...))
+}
+
+// IssueCertificate issues a certificate of the hosts signed by the internal CA of the operator, without storing it.
+// It returns the PEM encoded certificate, private key and CA certificate.
+func IssueCertificate(ctx context.Context, c client.Client, hosts []string, opts CertificateOptions) ([]byte, []byte, []byte, error) {
+	if len(hosts) == 0 {
+		return nil, nil, nil, errors.New("no host to issue the certificate for")
+	}
+
+	ca, err := GetOrCreateCA(ctx, c, opts)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get the internal CA: %w", err)
+	}
+
+	certPEM, keyPEM, err := generateCertificate(ca, hosts, opts)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error generating certificate: %w", err)
+	}
+
+	return certPEM, keyPEM, ca.BundlePEM, nil
+}
+
+// CertificateNotAfter returns the expiry time of the certificate of a TLS Secret.
+func CertificateNotAfter(secret *corev1.Secret) (time.Time, error) {
+	cert, err := parseCertificate(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return cert.NotAfter, nil
+}
+
+// GetOrCreateCA returns the internal CA of the operator, generating it when missing or expired.
+//
+// The CA is rotated in steps: its successor is generated when it enters its renewal window, and published in the
+// CA bundle for CARotationOverlap before replacing it, the certificates being reissued by the new CA afterwards.
+// The previous CA stays in the bundle until it expires.
+func GetOrCreateCA(ctx context.Context, c client.Client, opts CertificateOptions) (*CertificateAuthority, error) {
+	namespace := opts.CANamespace
+	if namespace == "" {
+		ns, err := GetOperatorNamespace()
+		if err != nil {
+			return nil, err
+		}
+		namespace = ns
+	}
+
+	secret, err := GetSecret(ctx, c, namespace, InternalCASecretName)
+	switch {
+	case k8serr.IsNotFound(err):
+	case err != nil:
+		return nil, err
+	default:
+		if ca, err := parseCA(secret); err == nil && time.Now().Before(ca.Certificate.NotAfter) {
+			data, err := rotateCA(secret.Data, ca, opts.KeyAlgorithm, time.Now())
+			if err != nil {
+				return nil, err
+			}
+			if data == nil {
+				return ca, nil
+			}
+
+			secret = newCertificateSecret(InternalCASecretName, namespace, CACertificate, nil, nil)
+			secret.Data = data
+			if err := applyCertificateSecret(ctx, c, secret); err != nil {
+				return nil, err
+			}
+
+			return parseCA(secret)
+		}
+	}
+
+	certPEM, keyPEM, err := generateCA(opts.KeyAlgorithm)
+	if err != nil {
+		return nil, fmt.Errorf("error generating CA: %w", err)
+	}
+
+	secret = newCertificateSecret(InternalCASecretName, namespace, CACertificate, certPEM, keyPEM)
+	if err := applyCertificateSecret(ctx, c, secret); err != nil {
+		return nil, err
+	}
+
+	return parseCA(secret)
 }
 
-func GenerateSelfSignedCertificateAsSecret(name, addr, namespace string) (*corev1.Secret, error) {
-	cert, key, err := generateCertificate(addr)
+// rotateCA returns the data of the internal CA Secret after the next step of the rotation of the CA, or nil when
+// there is nothing to do: generating the successor of the CA in its renewal window, replacing the CA by its
+// successor after the overlap, or dropping the expired previous CA.
+func rotateCA(data map[string][]byte, ca *CertificateAuthority, algorithm dsciv1.KeyAlgorithm, now time.Time) (map[string][]byte, error) {
+	res := maps.Clone(data)
+
+	if _, found := data[previousCACertKey]; found {
+		if previous, err := parseCertificate(data[previousCACertKey]); err != nil || !now.Before(previous.NotAfter) {
+			delete(res, previousCACertKey)
+		}
+	}
+
+	if !now.Add(CARenewBefore).Before(ca.Certificate.NotAfter) {
+		next, err := parseCertificate(data[nextCACertKey])
+		switch {
+		case err != nil || data[nextCAKeyKey] == nil:
+			certPEM, keyPEM, err := generateCA(algorithm)
+			if err != nil {
+				return nil, fmt.Errorf("error generating CA: %w", err)
+			}
+
+			res[nextCACertKey] = certPEM
+			res[nextCAKeyKey] = keyPEM
+		case !now.Before(next.NotBefore.Add(CARotationOverlap)):
+			res[previousCACertKey] = data[corev1.TLSCertKey]
+			res[corev1.TLSCertKey] = data[nextCACertKey]
+			res[corev1.TLSPrivateKeyKey] = data[nextCAKeyKey]
+			delete(res, nextCACertKey)
+			delete(res, nextCAKeyKey)
+		}
+	}
+
+	if maps.EqualFunc(res, data, bytes.Equal) {
+		return nil, nil
+	}
+
+	return res, nil
+}
+
+func issueCertificate(
+	ctx context.Context,
+	c client.Client,
+	secretName string,
+	namespace string,
+	hosts []string,
+	opts CertificateOptions,
+	current *corev1.Secret,
+	metaOptions ...MetaOptions,
+) (bool, error) {
+	ca, err := GetOrCreateCA(ctx, c, opts)
 	if err != nil {
-		return nil, fmt.Errorf("error generating certificate: %w", err)
+		return false, fmt.Errorf("failed to get the internal CA: %w", err)
+	}
+
+	renewed := current == nil || certificateNeedsRenewal(current, ca, hosts, opts, time.Now())
+	if !renewed && bytes.Equal(current.Data[CACertKey], ca.BundlePEM) {
+		return false, nil
+	}
+
+	// a valid certificate is kept when only the CA bundle changed, during the rotation of the CA
+	var certPEM, keyPEM []byte
+	if renewed {
+		certPEM, keyPEM, err = generateCertificate(ca, hosts, opts)
+		if err != nil {
+			return false, fmt.Errorf("error generating certificate: %w", err)
+		}
+	} else {
+		certPEM, keyPEM = current.Data[corev1.TLSCertKey], current.Data[corev1.TLSPrivateKeyKey]
+	}
+
+	secret := newCertificateSecret(secretName, namespace, IssuedCertificate, certPEM, keyPEM)
+	secret.Data[CACertKey] = ca.BundlePEM
+	secret.Annotations = map[string]string{
+		annotations.CertificateHosts: strings.Join(hosts, ","),
+	}
+
+	if err := ApplyMetaOptions(secret, metaOptions...); err != nil {
+		return false, err
+	}
+
+	if err := applyCertificateSecret(ctx, c, secret); err != nil {
+		return false, err
+	}
+
+	return renewed, nil
+}
+
+// certificateNeedsRenewal returns true when the certificate of a Secret is invalid, in its renewal window, issued for
+// other hosts or with another key algorithm, or not signed by the CA.
+func certificateNeedsRenewal(secret *corev1.Secret, ca *CertificateAuthority, hosts []string, opts CertificateOptions, now time.Time) bool {
+	cert, err := parseCertificate(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return true
+	}
+
+	switch {
+	case !now.Add(opts.RenewBefore).Before(cert.NotAfter):
+		return true
+	case secret.GetAnnotations()[annotations.CertificateHosts] != strings.Join(hosts, ","):
+		return true
+	case cert.PublicKeyAlgorithm != publicKeyAlgorithm(opts.KeyAlgorithm):
+		return true
+	case cert.CheckSignatureFrom(ca.Certificate) != nil:
+		return true
+	default:
+		return false
+	}
+}
+
+// createCertManagerCertificate delegates the certificate to a cert-manager Certificate, which issues and renews it.
+func createCertManagerCertificate(
+	ctx context.Context,
+	c client.Client,
+	secretName string,
+	namespace string,
+	hosts []string,
+	opts CertificateOptions,
+	metaOptions ...MetaOptions,
+) error {
+	var dnsNames, ipAddresses []any
+	for _, h := range hosts {
+		if net.ParseIP(h) != nil {
+			ipAddresses = append(ipAddresses, h)
+		} else {
+			dnsNames = append(dnsNames, h)
+		}
+	}
+
+	kind := opts.Issuer.Kind
+	if kind == "" {
+		kind = "ClusterIssuer"
+	}
+
+	privateKey := map[string]any{
+		"algorithm":      string(dsciv1.RSAKeyAlgorithm),
+		"size":           int64(rsaKeySize),
+		"rotationPolicy": "Always",
+	}
+	if opts.KeyAlgorithm == dsciv1.ECDSAKeyAlgorithm {
+		privateKey["algorithm"] = string(dsciv1.ECDSAKeyAlgorithm)
+		privateKey["size"] = int64(256)
+	}
+
+	spec := map[string]any{
+		"secretName":  secretName,
+		"duration":    opts.Duration.String(),
+		"renewBefore": opts.RenewBefore.String(),
+		"privateKey":  privateKey,
+		"issuerRef": map[string]any{
+			"group": gvk.CertManagerCertificate.Group,
+			"kind":  kind,
+			"name":  opts.Issuer.Name,
+		},
+		// the Secret is tracked as the ones issued by the internal CA, to expose its expiry
+		"secretTemplate": map[string]any{
+			"labels": map[string]any{
+				labels.PlatformCertificate: IssuedCertificate,
+			},
+		},
+	}
+	if len(dnsNames) > 0 {
+		spec["dnsNames"] = dnsNames
+	}
+	if len(ipAddresses) > 0 {
+		spec["ipAddresses"] = ipAddresses
+	}
+
+	certificate := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	certificate.SetGroupVersionKind(gvk.CertManagerCertificate)
+	certificate.SetName(secretName)
+	certificate.SetNamespace(namespace)
+
+	if err := ApplyMetaOptions(certificate, metaOptions...); err != nil {
+		return err
+	}
+
+	patchOpts := []client.PatchOption{
+		client.ForceOwnership,
+		client.FieldOwner(CertFieldOwner),
 	}
 
+	return resources.Apply(ctx, c, certificate, patchOpts...)
+}
+
+func newCertificateSecret(name, namespace, kind string, certPEM, keyPEM []byte) *corev1.Secret {
 	return &corev1.Secret{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       gvk.Secret.Kind,
@@ -71,78 +449,220 @@ func GenerateSelfSignedCertificateAsSecret(name, addr, namespace string) (*corev
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
 			Namespace: namespace,
+			Labels: map[string]string{
+				labels.PlatformCertificate: kind,
+			},
 		},
 		Data: map[string][]byte{
-			corev1.TLSCertKey:       cert,
-			corev1.TLSPrivateKeyKey: key,
+			corev1.TLSCertKey:       certPEM,
+			corev1.TLSPrivateKeyKey: keyPEM,
 		},
 		Type: corev1.SecretTypeTLS,
-	}, nil
+	}
 }
 
-func generateCertificate(addr string) ([]byte, []byte, error) {
-	key, err := rsa.GenerateKey(rand.Reader, 2048)
+func applyCertificateSecret(ctx context.Context, c client.Client, secret *corev1.Secret) error {
+	opts := []client.PatchOption{
+		client.ForceOwnership,
+		client.FieldOwner(CertFieldOwner),
+	}
+	err := resources.Apply(ctx, c, secret, opts...)
+	if err != nil && !k8serr.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
+
+// certificateHosts returns the DNS names, or the IP address, a certificate of the address is issued for.
+func certificateHosts(addr string) []string {
+	if ip := net.ParseIP(addr); ip != nil {
+		return []string{addr, "localhost"}
+	}
+
+	var hosts []string
+	if strings.HasPrefix(addr, "*.") {
+		hosts = append(hosts, addr[2:])
+	}
+
+	return append(hosts, addr, "localhost")
+}
+
+func generateCA(algorithm dsciv1.KeyAlgorithm) ([]byte, []byte, error) {
+	key, keyPEM, err := generateKey(algorithm)
 	if err != nil {
-		return nil, nil, fmt.Errorf("error generating key: %w", err)
+		return nil, nil, err
 	}
 
-	seededRand, cryptErr := rand.Int(rand.Reader, big.NewInt(time.Now().UnixNano()))
-	if cryptErr != nil {
-		return nil, nil, fmt.Errorf("error generating random: %w", cryptErr)
+	serial, err := newSerialNumber()
+	if err != nil {
+		return nil, nil, err
 	}
 
 	now := time.Now()
 	tmpl := x509.Certificate{
-		SerialNumber: seededRand,
+		SerialNumber: serial,
 		Subject: pkix.Name{
-			CommonName:   addr,
-			Organization: []string{"opendatahub-self-signed"},
+			CommonName:   "opendatahub-internal-ca",
+			Organization: []string{"opendatahub"},
 		},
-		NotBefore:             now.UTC(),
-		NotAfter:              now.Add(time.Second * 60 * 60 * 24 * 365).UTC(),
-		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
-		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		NotBefore:             now.Add(-time.Hour).UTC(),
+		NotAfter:              now.Add(caDuration).UTC(),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
 		BasicConstraintsValid: true,
 		IsCA:                  true,
+		MaxPathLenZero:        true,
 	}
 
-	if ip := net.ParseIP(addr); ip != nil {
-		tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
-	} else {
-		if strings.HasPrefix(addr, "*.") {
-			tmpl.DNSNames = append(tmpl.DNSNames, addr[2:])
-		}
-		tmpl.DNSNames = append(tmpl.DNSNames, addr)
+	certDERBytes, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, key.Public(), key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating certificate: %w", err)
 	}
 
-	tmpl.DNSNames = append(tmpl.DNSNames, "localhost")
+	return encodeCertificate(certDERBytes), keyPEM, nil
+}
 
-	certDERBytes, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, key.Public(), key)
+func generateCertificate(ca *CertificateAuthority, hosts []string, opts CertificateOptions) ([]byte, []byte, error) {
+	key, keyPEM, err := generateKey(opts.KeyAlgorithm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serial, err := newSerialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	tmpl := x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName:   hosts[0],
+			Organization: []string{"opendatahub"},
+		},
+		NotBefore:             now.Add(-time.Hour).UTC(),
+		NotAfter:              now.Add(opts.Duration).UTC(),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+	if opts.KeyAlgorithm != dsciv1.ECDSAKeyAlgorithm {
+		tmpl.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+		} else {
+			tmpl.DNSNames = append(tmpl.DNSNames, h)
+		}
+	}
+
+	certDERBytes, err := x509.CreateCertificate(rand.Reader, &tmpl, ca.Certificate, key.Public(), ca.Key)
 	if err != nil {
 		return nil, nil, fmt.Errorf("error creating certificate: %w", err)
 	}
-	certificate, err := x509.ParseCertificate(certDERBytes)
+
+	return encodeCertificate(certDERBytes), keyPEM, nil
+}
+
+func generateKey(algorithm dsciv1.KeyAlgorithm) (crypto.Signer, []byte, error) {
+	if algorithm == dsciv1.ECDSAKeyAlgorithm {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error generating key: %w", err)
+		}
+
+		der, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error encoding key: %w", err)
+		}
+
+		return key, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, rsaKeySize)
 	if err != nil {
-		return nil, nil, fmt.Errorf("error parsing certificate: %w", err)
+		return nil, nil, fmt.Errorf("error generating key: %w", err)
 	}
 
-	certBuffer := bytes.Buffer{}
-	if err := pem.Encode(&certBuffer, &pem.Block{
-		Type:  "CERTIFICATE",
-		Bytes: certificate.Raw,
-	}); err != nil {
-		return nil, nil, fmt.Errorf("error encoding pem: %w", err)
+	return key, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}), nil
+}
+
+func publicKeyAlgorithm(algorithm dsciv1.KeyAlgorithm) x509.PublicKeyAlgorithm {
+	if algorithm == dsciv1.ECDSAKeyAlgorithm {
+		return x509.ECDSA
 	}
 
-	keyBuffer := bytes.Buffer{}
-	if err := pem.Encode(&keyBuffer, &pem.Block{
-		Type:  "RSA PRIVATE KEY",
-		Bytes: x509.MarshalPKCS1PrivateKey(key),
-	}); err != nil {
-		return nil, nil, fmt.Errorf("error encoding pem: %w", err)
+	return x509.RSA
+}
+
+func newSerialNumber() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("error generating random: %w", err)
 	}
 
-	return certBuffer.Bytes(), keyBuffer.Bytes(), nil
+	return serial, nil
+}
+
+func encodeCertificate(der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func parseCA(secret *corev1.Secret) (*CertificateAuthority, error) {
+	cert, err := parseCertificate(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(secret.Data[corev1.TLSPrivateKeyKey])
+	if block == nil {
+		return nil, errors.New("no PEM encoded key found")
+	}
+
+	var key crypto.Signer
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		err = fmt.Errorf("unsupported key type %s", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ca := &CertificateAuthority{
+		Certificate:    cert,
+		CertificatePEM: secret.Data[corev1.TLSCertKey],
+		Key:            key,
+		BundlePEM:      slices.Clone(secret.Data[corev1.TLSCertKey]),
+		RotateAt:       cert.NotAfter.Add(-CARenewBefore),
+	}
+
+	if next, err := parseCertificate(secret.Data[nextCACertKey]); err == nil {
+		ca.BundlePEM = append(ca.BundlePEM, secret.Data[nextCACertKey]...)
+		ca.RotateAt = next.NotBefore.Add(CARotationOverlap)
+	}
+	if previous, err := parseCertificate(secret.Data[previousCACertKey]); err == nil {
+		ca.BundlePEM = append(ca.BundlePEM, secret.Data[previousCACertKey]...)
+		if previous.NotAfter.Before(ca.RotateAt) {
+			ca.RotateAt = previous.NotAfter
+		}
+	}
+
+	return ca, nil
 }
 
 func FindDefaultIngressSecret(ctx context.Context, c client.Client) (*corev1.Secret, error) {
@@ -171,6 +691,26 @@ func PropagateDefaultIngressCertificate(ctx context.Context, c client.Client, se
 	return copySecretToNamespace(ctx, c, defaultIngressSecret, secretName, namespace)
 }
 
+// SyncCopiedCertificate updates a copy of the default ingress certificate when the copied Secret changed, e.g. when
+// the ingress certificate was rotated. It returns true when the copy was updated.
+func SyncCopiedCertificate(ctx context.Context, c client.Client, secret *corev1.Secret) (bool, error) {
+	source := strings.SplitN(secret.GetAnnotations()[annotations.CertificateSource], "/", 2)
+	if len(source) != 2 {
+		return false, fmt.Errorf("invalid %s annotation on Secret %s/%s", annotations.CertificateSource, secret.Namespace, secret.Name)
+	}
+
+	sourceSecret, err := GetSecret(ctx, c, source[0], source[1])
+	if err != nil {
+		return false, err
+	}
+
+	if reflect.DeepEqual(sourceSecret.Data, secret.Data) {
+		return false, nil
+	}
+
+	return true, copySecretToNamespace(ctx, c, sourceSecret, secret.Name, secret.Namespace, secret.OwnerReferences...)
+}
+
 func FindAvailableIngressController(ctx context.Context, c client.Client) (*operatorv1.IngressController, error) {
 	defaultIngressCtrl := &operatorv1.IngressController{}
 
@@ -197,7 +737,7 @@ func GetSecret(ctx context.Context, c client.Client, namespace, name string) (*c
 	return secret, nil
 }
 
-func copySecretToNamespace(ctx context.Context, c client.Client, secret *corev1.Secret, newSecretName, namespace string) error {
+func copySecretToNamespace(ctx context.Context, c client.Client, secret *corev1.Secret, newSecretName, namespace string, ownerReferences ...metav1.OwnerReference) error {
 	newSecret := &corev1.Secret{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       gvk.Secret.Kind,
@@ -206,6 +746,13 @@ func copySecretToNamespace(ctx context.Context, c client.Client, secret *corev1.
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      newSecretName,
 			Namespace: namespace,
+			Labels: map[string]string{
+				labels.PlatformCertificate: CopiedCertificate,
+			},
+			Annotations: map[string]string{
+				annotations.CertificateSource: secret.Namespace + "/" + secret.Name,
+			},
+			OwnerReferences: ownerReferences,
 		},
 		Data: secret.Data,
 		Type: secret.Type,
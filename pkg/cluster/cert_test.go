@@ -0,0 +1,363 @@
+package cluster_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"slices"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+// newApplyClient returns a fake client emulating the server-side apply, not supported by the fake client, with a
+// create or an update.
+func newApplyClient(g *WithT, objects ...client.Object) client.Client {
+	cli, err := fakeclient.New(
+		fakeclient.WithObjects(objects...),
+		fakeclient.WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				if patch.Type() != types.ApplyPatchType {
+					return c.Patch(ctx, obj, patch, opts...)
+				}
+
+				u, ok := obj.(*unstructured.Unstructured)
+				g.Expect(ok).To(BeTrue())
+
+				current := &unstructured.Unstructured{}
+				current.SetGroupVersionKind(u.GroupVersionKind())
+				if err := c.Get(ctx, client.ObjectKeyFromObject(u), current); err != nil {
+					return c.Create(ctx, u)
+				}
+
+				u.SetResourceVersion(current.GetResourceVersion())
+
+				return c.Update(ctx, u)
+			},
+		}),
+	)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	return cli
+}
+
+func parsePEMCertificate(g *WithT, data []byte) *x509.Certificate {
+	block, _ := pem.Decode(data)
+	g.Expect(block).ShouldNot(BeNil())
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	return cert
+}
+
+func TestNewCertificateOptions(t *testing.T) {
+	g := NewWithT(t)
+
+	opts := cluster.NewCertificateOptions(nil)
+	g.Expect(opts.KeyAlgorithm).To(Equal(dsciv1.RSAKeyAlgorithm))
+	g.Expect(opts.Duration).To(Equal(cluster.DefaultCertificateDuration))
+	g.Expect(opts.RenewBefore).To(Equal(cluster.DefaultCertificateRenewBefore))
+
+	opts = cluster.NewCertificateOptions(&dsciv1.CertificatesSpec{
+		KeyAlgorithm: dsciv1.ECDSAKeyAlgorithm,
+		Duration:     &metav1.Duration{Duration: 24 * time.Hour},
+		RenewBefore:  &metav1.Duration{Duration: 12 * time.Hour},
+	})
+	g.Expect(opts.KeyAlgorithm).To(Equal(dsciv1.ECDSAKeyAlgorithm))
+	g.Expect(opts.Duration).To(Equal(24 * time.Hour))
+	g.Expect(opts.RenewBefore).To(Equal(12 * time.Hour))
+
+	// a renewal window longer than the validity is shortened
+	opts = cluster.NewCertificateOptions(&dsciv1.CertificatesSpec{
+		Duration: &metav1.Duration{Duration: 24 * time.Hour},
+	})
+	g.Expect(opts.RenewBefore).To(Equal(8 * time.Hour))
+}
+
+func TestCreateCertificate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	cli := newApplyClient(g)
+
+	opts := cluster.NewCertificateOptions(nil)
+	opts.CANamespace = "operator-ns"
+
+	err := cluster.CreateCertificate(ctx, cli, "knative-serving-cert", "*.apps.example.com", "istio-system", opts)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	ca, err := cluster.GetSecret(ctx, cli, "operator-ns", cluster.InternalCASecretName)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(ca.Labels).To(HaveKeyWithValue(labels.PlatformCertificate, cluster.CACertificate))
+
+	caCert := parsePEMCertificate(g, ca.Data[corev1.TLSCertKey])
+	g.Expect(caCert.IsCA).To(BeTrue())
+
+	secret, err := cluster.GetSecret(ctx, cli, "istio-system", "knative-serving-cert")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(secret.Type).To(Equal(corev1.SecretTypeTLS))
+	g.Expect(secret.Labels).To(HaveKeyWithValue(labels.PlatformCertificate, cluster.IssuedCertificate))
+	g.Expect(secret.Annotations).To(HaveKeyWithValue(annotations.CertificateHosts, "apps.example.com,*.apps.example.com,localhost"))
+	g.Expect(secret.Data).To(HaveKeyWithValue(cluster.CACertKey, ca.Data[corev1.TLSCertKey]))
+
+	// the certificate is a leaf signed by the internal CA
+	cert := parsePEMCertificate(g, secret.Data[corev1.TLSCertKey])
+	g.Expect(cert.IsCA).To(BeFalse())
+	g.Expect(cert.PublicKeyAlgorithm).To(Equal(x509.RSA))
+
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+	_, err = cert.Verify(x509.VerifyOptions{Roots: roots, DNSName: "model.apps.example.com"})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	// a valid certificate is kept
+	err = cluster.CreateCertificate(ctx, cli, "knative-serving-cert", "*.apps.example.com", "istio-system", opts)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	kept, err := cluster.GetSecret(ctx, cli, "istio-system", "knative-serving-cert")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(kept.Data[corev1.TLSCertKey]).To(Equal(secret.Data[corev1.TLSCertKey]))
+
+	// changing the key algorithm reissues the certificate
+	opts.KeyAlgorithm = dsciv1.ECDSAKeyAlgorithm
+	err = cluster.CreateCertificate(ctx, cli, "knative-serving-cert", "*.apps.example.com", "istio-system", opts)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	reissued, err := cluster.GetSecret(ctx, cli, "istio-system", "knative-serving-cert")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(parsePEMCertificate(g, reissued.Data[corev1.TLSCertKey]).PublicKeyAlgorithm).To(Equal(x509.ECDSA))
+}
+
+func TestCreateCertificateWithoutCertManager(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	cli := newApplyClient(g)
+
+	opts := cluster.NewCertificateOptions(&dsciv1.CertificatesSpec{
+		CertManagerIssuer: &dsciv1.CertManagerIssuerReference{Name: "letsencrypt"},
+	})
+	opts.CANamespace = "operator-ns"
+
+	// the internal CA issues the certificate when cert-manager is not installed
+	err := cluster.CreateCertificate(ctx, cli, "knative-serving-cert", "*.apps.example.com", "istio-system", opts)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	secret, err := cluster.GetSecret(ctx, cli, "istio-system", "knative-serving-cert")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(secret.Labels).To(HaveKeyWithValue(labels.PlatformCertificate, cluster.IssuedCertificate))
+}
+
+// newCAPEM returns a self-signed CA certificate valid in the given period, and its key.
+func newCAPEM(g *WithT, notBefore time.Time, notAfter time.Time) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	tmpl := x509.Certificate{
+		SerialNumber:          big.NewInt(notBefore.UnixNano()),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, key.Public(), key)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestCARotation(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	now := time.Now()
+	activePEM, activeKeyPEM := newCAPEM(g, now.Add(-4*365*24*time.Hour), now.Add(100*24*time.Hour))
+
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cluster.InternalCASecretName,
+			Namespace: "operator-ns",
+			Labels:    map[string]string{labels.PlatformCertificate: cluster.CACertificate},
+		},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       activePEM,
+			corev1.TLSPrivateKeyKey: activeKeyPEM,
+		},
+		Type: corev1.SecretTypeTLS,
+	}
+
+	cli := newApplyClient(g, caSecret)
+
+	opts := cluster.NewCertificateOptions(nil)
+	opts.CANamespace = "operator-ns"
+
+	err := cluster.CreateCertificate(ctx, cli, "serving-cert", "model.example.com", "istio-system", opts)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	// in its renewal window, the CA keeps signing the certificates while its successor is published in the bundle
+	secret, err := cluster.GetSecret(ctx, cli, "operator-ns", cluster.InternalCASecretName)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(secret.Data[corev1.TLSCertKey]).To(Equal(activePEM))
+	g.Expect(secret.Data).To(HaveKey("next.crt"))
+	g.Expect(secret.Data).To(HaveKey("next.key"))
+
+	ca, err := cluster.GetOrCreateCA(ctx, cli, opts)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(ca.BundlePEM).To(Equal(append(slices.Clone(activePEM), secret.Data["next.crt"]...)))
+	g.Expect(ca.RotateAt).To(BeTemporally("~", now.Add(cluster.CARotationOverlap), 2*time.Hour))
+
+	leaf, err := cluster.GetSecret(ctx, cli, "istio-system", "serving-cert")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(leaf.Data[cluster.CACertKey]).To(Equal(ca.BundlePEM))
+	g.Expect(parsePEMCertificate(g, leaf.Data[corev1.TLSCertKey]).CheckSignatureFrom(parsePEMCertificate(g, activePEM))).To(Succeed())
+
+	// after the overlap, the successor replaces the CA, the previous one is kept in the bundle
+	nextPEM, nextKeyPEM := newCAPEM(g, now.Add(-cluster.CARotationOverlap-time.Hour), now.Add(5*365*24*time.Hour))
+	secret.Data["next.crt"] = nextPEM
+	secret.Data["next.key"] = nextKeyPEM
+	g.Expect(cli.Update(ctx, secret)).To(Succeed())
+
+	renewed, err := cluster.RenewCertificate(ctx, cli, leaf, opts)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(renewed).To(BeTrue())
+
+	secret, err = cluster.GetSecret(ctx, cli, "operator-ns", cluster.InternalCASecretName)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(secret.Data[corev1.TLSCertKey]).To(Equal(nextPEM))
+	g.Expect(secret.Data["previous.crt"]).To(Equal(activePEM))
+	g.Expect(secret.Data).ToNot(HaveKey("next.crt"))
+
+	// the certificates are reissued by the new CA
+	leaf, err = cluster.GetSecret(ctx, cli, "istio-system", "serving-cert")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(leaf.Data[cluster.CACertKey]).To(Equal(append(slices.Clone(nextPEM), activePEM...)))
+	g.Expect(parsePEMCertificate(g, leaf.Data[corev1.TLSCertKey]).CheckSignatureFrom(parsePEMCertificate(g, nextPEM))).To(Succeed())
+
+	// the previous CA is dropped once expired
+	expiredPEM, _ := newCAPEM(g, now.Add(-2*time.Hour), now.Add(-time.Hour))
+	secret.Data["previous.crt"] = expiredPEM
+	g.Expect(cli.Update(ctx, secret)).To(Succeed())
+
+	ca, err = cluster.GetOrCreateCA(ctx, cli, opts)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(ca.BundlePEM).To(Equal(nextPEM))
+}
+
+func TestRenewCertificate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	cli := newApplyClient(g)
+
+	opts := cluster.CertificateOptions{
+		KeyAlgorithm: dsciv1.RSAKeyAlgorithm,
+		Duration:     2 * time.Hour,
+		RenewBefore:  time.Hour,
+		CANamespace:  "operator-ns",
+	}
+
+	err := cluster.CreateCertificate(ctx, cli, "serving-cert", "model.example.com", "istio-system", opts)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	secret, err := cluster.GetSecret(ctx, cli, "istio-system", "serving-cert")
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	renewed, err := cluster.RenewCertificate(ctx, cli, secret, opts)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(renewed).To(BeFalse())
+
+	notAfter, err := cluster.CertificateNotAfter(secret)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(notAfter).To(BeTemporally("~", time.Now().Add(2*time.Hour), time.Minute))
+
+	// the certificate enters its renewal window
+	opts.RenewBefore = 3 * time.Hour
+	renewed, err = cluster.RenewCertificate(ctx, cli, secret, opts)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(renewed).To(BeTrue())
+
+	renewedSecret, err := cluster.GetSecret(ctx, cli, "istio-system", "serving-cert")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(renewedSecret.Data[corev1.TLSCertKey]).ToNot(Equal(secret.Data[corev1.TLSCertKey]))
+	g.Expect(renewedSecret.Annotations).To(HaveKeyWithValue(annotations.CertificateHosts, "model.example.com,localhost"))
+}
+
+func TestIssueCertificate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	cli := newApplyClient(g)
+
+	opts := cluster.NewCertificateOptions(nil)
+	opts.CANamespace = "operator-ns"
+
+	certPEM, keyPEM, caPEM, err := cluster.IssueCertificate(ctx, cli, []string{"svc.ns.svc", "10.0.0.1"}, opts)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(keyPEM).ToNot(BeEmpty())
+
+	cert := parsePEMCertificate(g, certPEM)
+	g.Expect(cert.DNSNames).To(ConsistOf("svc.ns.svc"))
+	g.Expect(cert.IPAddresses).To(HaveLen(1))
+	g.Expect(cert.CheckSignatureFrom(parsePEMCertificate(g, caPEM))).To(Succeed())
+
+	_, _, _, err = cluster.IssueCertificate(ctx, cli, nil, opts)
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func TestSyncCopiedCertificate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "router-certs-default", Namespace: cluster.IngressNamespace},
+		Data:       map[string][]byte{corev1.TLSCertKey: []byte("rotated")},
+		Type:       corev1.SecretTypeTLS,
+	}
+	copied := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "knative-serving-cert",
+			Namespace:   "istio-system",
+			Labels:      map[string]string{labels.PlatformCertificate: cluster.CopiedCertificate},
+			Annotations: map[string]string{annotations.CertificateSource: cluster.IngressNamespace + "/router-certs-default"},
+		},
+		Data: map[string][]byte{corev1.TLSCertKey: []byte("initial")},
+		Type: corev1.SecretTypeTLS,
+	}
+	cli := newApplyClient(g, source, copied)
+
+	synced, err := cluster.SyncCopiedCertificate(ctx, cli, copied)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(synced).To(BeTrue())
+
+	current, err := cluster.GetSecret(ctx, cli, "istio-system", "knative-serving-cert")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(current.Data).To(Equal(source.Data))
+	g.Expect(current.Labels).To(HaveKeyWithValue(labels.PlatformCertificate, cluster.CopiedCertificate))
+
+	synced, err = cluster.SyncCopiedCertificate(ctx, cli, current)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(synced).To(BeFalse())
+}
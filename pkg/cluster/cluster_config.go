@@ -15,6 +15,7 @@ import (
 	"github.com/spf13/viper"
 	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -27,9 +28,11 @@ import (
 )
 
 type ClusterInfo struct {
-	Type        string                  `json:"type,omitempty"` // openshift , TODO: can be other value if we later support other type
+	Type        string                  `json:"type,omitempty"` // OpenShift or Kubernetes
 	Version     version.OperatorVersion `json:"version,omitempty"`
 	FipsEnabled bool                    `json:"fips_enabled,omitempty"`
+	// ID uniquely identifies the cluster, it is empty when it cannot be determined
+	ID string `json:"id,omitempty"`
 }
 
 var clusterConfig struct {
@@ -59,7 +62,7 @@ func Init(ctx context.Context, cli client.Client) error {
 		return err
 	}
 
-	clusterConfig.ClusterInfo, err = getClusterInfo(ctx, cli)
+	clusterConfig.ClusterInfo, err = getClusterInfo(ctx, cli, clusterConfig.Release.Name)
 	if err != nil {
 		return err
 	}
@@ -94,6 +97,11 @@ func GetClusterInfo() ClusterInfo {
 	return clusterConfig.ClusterInfo
 }
 
+// IsKubernetes returns true when the operator runs on an upstream Kubernetes cluster, without the OpenShift APIs.
+func IsKubernetes() bool {
+	return clusterConfig.Release.Name == Kubernetes
+}
+
 func GetDomain(ctx context.Context, c client.Client) (string, error) {
 	ingress := &unstructured.Unstructured{}
 	ingress.SetGroupVersionKind(gvk.OpenshiftIngress)
@@ -128,6 +136,27 @@ func getOCPVersion(ctx context.Context, c client.Client) (version.OperatorVersio
 	return version.OperatorVersion{Version: v}, nil
 }
 
+// getKubernetesClusterID returns the UID of the kube-system namespace, the de facto identifier of the Kubernetes
+// clusters.
+func getKubernetesClusterID(ctx context.Context, c client.Client) (string, error) {
+	ns := &corev1.Namespace{}
+	if err := c.Get(ctx, client.ObjectKey{Name: "kube-system"}, ns); err != nil {
+		return "", fmt.Errorf("unable to get cluster ID: %w", err)
+	}
+	return string(ns.UID), nil
+}
+
+// This is an Openshift specific implementation.
+func getClusterID(ctx context.Context, c client.Client) (string, error) {
+	clusterVersion := &configv1.ClusterVersion{}
+	if err := c.Get(ctx, client.ObjectKey{
+		Name: OpenShiftVersionObj,
+	}, clusterVersion); err != nil {
+		return "", fmt.Errorf("unable to get cluster ID: %w", err)
+	}
+	return string(clusterVersion.Spec.ClusterID), nil
+}
+
 func getOperatorNamespace() (string, error) {
 	operatorNS, exist := os.LookupEnv("OPERATOR_NAMESPACE")
 	if exist && operatorNS != "" {
@@ -212,6 +241,20 @@ func detectManagedRhoai(ctx context.Context, cli client.Client) (common.Platform
 	return ManagedRhoai, nil
 }
 
+// isOpenShift checks if the cluster serves the OpenShift APIs, its ClusterVersion being missing on the upstream
+// Kubernetes clusters.
+func isOpenShift(cli client.Client) (bool, error) {
+	_, err := cli.RESTMapper().RESTMapping(gvk.ClusterVersion.GroupKind(), gvk.ClusterVersion.Version)
+	switch {
+	case meta.IsNoMatchError(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("failed to check the OpenShift APIs: %w", err)
+	default:
+		return true, nil
+	}
+}
+
 func getPlatform(ctx context.Context, cli client.Client) (common.Platform, error) {
 	switch os.Getenv("ODH_PLATFORM_TYPE") {
 	case "OpenDataHub":
@@ -220,8 +263,17 @@ func getPlatform(ctx context.Context, cli client.Client) (common.Platform, error
 		return ManagedRhoai, nil
 	case "SelfManagedRHOAI":
 		return SelfManagedRhoai, nil
+	case "Kubernetes":
+		return Kubernetes, nil
 	default:
 		// fall back to detect platform if ODH_PLATFORM_TYPE env is not provided in CSV or set to ""
+		openShift, err := isOpenShift(cli)
+		if err != nil {
+			return OpenDataHub, err
+		}
+		if !openShift {
+			return Kubernetes, nil
+		}
 		platform, err := detectManagedRhoai(ctx, cli)
 		if err != nil {
 			return OpenDataHub, err
@@ -261,8 +313,8 @@ func getRelease(ctx context.Context, cli client.Client) (common.Release, error)
 		return initRelease, err
 	}
 	csv, err := GetClusterServiceVersion(ctx, cli, operatorNamespace)
-	if k8serr.IsNotFound(err) {
-		// hide not found, return default
+	if k8serr.IsNotFound(err) || meta.IsNoMatchError(err) {
+		// hide not found, or OLM not installed, return default
 		return initRelease, nil
 	}
 	if err != nil {
@@ -272,14 +324,28 @@ func getRelease(ctx context.Context, cli client.Client) (common.Release, error)
 	return initRelease, nil
 }
 
-func getClusterInfo(ctx context.Context, cli client.Client) (ClusterInfo, error) {
+func getClusterInfo(ctx context.Context, cli client.Client, platform common.Platform) (ClusterInfo, error) {
 	c := ClusterInfo{
 		Version: version.OperatorVersion{
 			Version: semver.Version{},
 		},
-		Type:        "OpenShift",
+		Type:        ClusterTypeOpenShift,
 		FipsEnabled: false,
 	}
+
+	// the version and the FIPS mode are only known on OpenShift
+	if platform == Kubernetes {
+		c.Type = ClusterTypeKubernetes
+
+		if clusterID, err := getKubernetesClusterID(ctx, cli); err == nil {
+			c.ID = clusterID
+		} else {
+			logf.FromContext(ctx).Info("could not determine cluster ID", "error", err)
+		}
+
+		return c, nil
+	}
+
 	// Set OCP
 	ocpVersion, err := getOCPVersion(ctx, cli)
 	if err != nil {
@@ -287,6 +353,12 @@ func getClusterInfo(ctx context.Context, cli client.Client) (ClusterInfo, error)
 	}
 	c.Version = ocpVersion
 
+	if clusterID, err := getClusterID(ctx, cli); err == nil {
+		c.ID = clusterID
+	} else {
+		logf.FromContext(ctx).Info("could not determine cluster ID", "error", err)
+	}
+
 	// Check for FIPs
 	if fipsEnabled, err := IsFipsEnabled(ctx, cli); err == nil {
 		c.FipsEnabled = fipsEnabled
@@ -0,0 +1,43 @@
+package cluster_test
+
+import (
+	"context"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Detecting the cluster platform", func() {
+
+	BeforeEach(func() {
+		GinkgoT().Setenv("CI", "true")
+		GinkgoT().Setenv("OPERATOR_NAMESPACE", "opendatahub-operator-system")
+		GinkgoT().Setenv("ODH_PLATFORM_TYPE", "")
+	})
+
+	It("should detect Kubernetes when the OpenShift APIs are not served", func(ctx context.Context) {
+		// when
+		err := cluster.Init(ctx, envTestClient)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.GetRelease().Name).To(Equal(cluster.Kubernetes))
+		Expect(cluster.IsKubernetes()).To(BeTrue())
+		Expect(cluster.GetClusterInfo().Type).To(Equal(cluster.ClusterTypeKubernetes))
+	})
+
+	It("should use the platform set in the environment", func(ctx context.Context) {
+		// given
+		GinkgoT().Setenv("ODH_PLATFORM_TYPE", "Kubernetes")
+
+		// when
+		err := cluster.Init(ctx, envTestClient)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cluster.GetRelease().Name).To(Equal(cluster.Kubernetes))
+		Expect(cluster.GetClusterInfo().ID).ToNot(BeEmpty())
+	})
+})
@@ -9,6 +9,12 @@ const (
 	SelfManagedRhoai common.Platform = "OpenShift AI Self-Managed"
 	// OpenDataHub defines display name in csv.
 	OpenDataHub common.Platform = "Open Data Hub"
+	// Kubernetes defines the platform of the upstream Kubernetes clusters, without the OpenShift APIs.
+	Kubernetes common.Platform = "Open Data Hub on Kubernetes"
+
+	// ClusterTypeOpenShift and ClusterTypeKubernetes are the types of cluster reported in the cluster info.
+	ClusterTypeOpenShift  = "OpenShift"
+	ClusterTypeKubernetes = "Kubernetes"
 
 	// DefaultNotebooksNamespaceODH defines default namespace for notebooks.
 	DefaultNotebooksNamespaceODH = "opendatahub"
@@ -38,4 +44,8 @@ const (
 
 	// KueueLegacyManagedLabelKey is the legacy label key used to indicate a namespace is managed by Kueue.
 	KueueLegacyManagedLabelKey = "kueue-managed"
+
+	// KueueDefaultQueueNameAnnotation is the namespace annotation used to override the LocalQueue assigned
+	// to workloads created without the Kueue queue name label.
+	KueueDefaultQueueNameAnnotation = "kueue.opendatahub.io/default-queue-name"
 )
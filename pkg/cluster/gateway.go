@@ -0,0 +1,83 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+)
+
+const (
+	// DefaultGatewayName is the name of the platform Gateway when not set in the DSCI.
+	DefaultGatewayName = "data-science-gateway"
+	// DefaultGatewayNamespace is the namespace of the platform Gateway when not set in the DSCI.
+	DefaultGatewayNamespace = "openshift-ingress"
+	// DefaultGatewayClassName is the name of the GatewayClass of the platform Gateway when not set in the DSCI.
+	DefaultGatewayClassName = "data-science-gateway-class"
+	// DefaultGatewayControllerName is the Gateway API implementation of OpenShift, set up by its ingress operator.
+	DefaultGatewayControllerName = "openshift.io/gateway-controller/v1"
+
+	gatewayCertificateSuffix = "-tls"
+)
+
+// IsGatewayManaged returns true when the components are exposed through the platform Gateway, instead of Routes.
+func IsGatewayManaged(spec *dsciv1.DSCInitializationSpec) bool {
+	return spec != nil && spec.Gateway != nil && spec.Gateway.ManagementState == operatorv1.Managed
+}
+
+// GatewayName returns the name and the namespace of the platform Gateway.
+func GatewayName(spec *serviceApi.GatewayCommonSpec) (string, string) {
+	name, namespace := spec.Name, spec.Namespace
+	if name == "" {
+		name = DefaultGatewayName
+	}
+	if namespace == "" {
+		namespace = DefaultGatewayNamespace
+	}
+
+	return name, namespace
+}
+
+// GatewayClass returns the name and the controller name of the GatewayClass of the platform Gateway.
+func GatewayClass(spec *serviceApi.GatewayCommonSpec) (string, string) {
+	name, controllerName := spec.GatewayClass.Name, spec.GatewayClass.ControllerName
+	if name == "" {
+		name = DefaultGatewayClassName
+	}
+	if controllerName == "" {
+		controllerName = DefaultGatewayControllerName
+	}
+
+	return name, controllerName
+}
+
+// GatewayCertificateSecretName returns the name of the Secret holding the TLS certificate of the platform Gateway,
+// named after the Gateway when not set.
+func GatewayCertificateSecretName(spec *serviceApi.GatewayCommonSpec) string {
+	if spec.Certificate.SecretName != "" {
+		return spec.Certificate.SecretName
+	}
+
+	name, _ := GatewayName(spec)
+
+	return name + gatewayCertificateSuffix
+}
+
+// GetGatewayDomain returns the domain the platform Gateway listens on, the ingress domain of the cluster when not
+// set.
+func GetGatewayDomain(ctx context.Context, cli client.Client, spec *serviceApi.GatewayCommonSpec) (string, error) {
+	if spec.Domain != "" {
+		return spec.Domain, nil
+	}
+
+	domain, err := GetDomain(ctx, cli)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the domain of the Gateway, set it in the DSCI when the cluster has no ingress domain: %w", err)
+	}
+
+	return domain, nil
+}
@@ -99,6 +99,12 @@ var (
 		Kind:    "Group",
 	}
 
+	User = schema.GroupVersionKind{
+		Group:   rbacv1.SchemeGroupVersion.Group,
+		Version: rbacv1.SchemeGroupVersion.Version,
+		Kind:    "User",
+	}
+
 	ClusterRole = schema.GroupVersionKind{
 		Group:   rbacv1.SchemeGroupVersion.Group,
 		Version: rbacv1.SchemeGroupVersion.Version,
@@ -153,6 +159,48 @@ var (
 		Kind:    "Route",
 	}
 
+	Ingress = schema.GroupVersionKind{
+		Group:   networkingv1.SchemeGroupVersion.Group,
+		Version: networkingv1.SchemeGroupVersion.Version,
+		Kind:    "Ingress",
+	}
+
+	ConsoleLink = schema.GroupVersionKind{
+		Group:   "console.openshift.io",
+		Version: "v1",
+		Kind:    "ConsoleLink",
+	}
+
+	SecurityContextConstraints = schema.GroupVersionKind{
+		Group:   "security.openshift.io",
+		Version: "v1",
+		Kind:    "SecurityContextConstraints",
+	}
+
+	Template = schema.GroupVersionKind{
+		Group:   "template.openshift.io",
+		Version: "v1",
+		Kind:    "Template",
+	}
+
+	ImageStream = schema.GroupVersionKind{
+		Group:   "image.openshift.io",
+		Version: "v1",
+		Kind:    "ImageStream",
+	}
+
+	BuildConfig = schema.GroupVersionKind{
+		Group:   "build.openshift.io",
+		Version: "v1",
+		Kind:    "BuildConfig",
+	}
+
+	OAuthClient = schema.GroupVersionKind{
+		Group:   "oauth.openshift.io",
+		Version: "v1",
+		Kind:    "OAuthClient",
+	}
+
 	KnativeServing = schema.GroupVersionKind{
 		Group:   "operator.knative.dev",
 		Version: "v1beta1",
@@ -165,12 +213,42 @@ var (
 		Kind:    "Ingress",
 	}
 
+	OpenshiftAuthentication = schema.GroupVersionKind{
+		Group:   "config.openshift.io",
+		Version: "v1",
+		Kind:    "Authentication",
+	}
+
 	ServiceMeshControlPlane = schema.GroupVersionKind{
 		Group:   "maistra.io",
 		Version: "v2",
 		Kind:    "ServiceMeshControlPlane",
 	}
 
+	Istio = schema.GroupVersionKind{
+		Group:   "sailoperator.io",
+		Version: "v1",
+		Kind:    "Istio",
+	}
+
+	IstioCNI = schema.GroupVersionKind{
+		Group:   "sailoperator.io",
+		Version: "v1",
+		Kind:    "IstioCNI",
+	}
+
+	ZTunnel = schema.GroupVersionKind{
+		Group:   "sailoperator.io",
+		Version: "v1alpha1",
+		Kind:    "ZTunnel",
+	}
+
+	CertManagerCertificate = schema.GroupVersionKind{
+		Group:   "cert-manager.io",
+		Version: "v1",
+		Kind:    "Certificate",
+	}
+
 	OdhApplication = schema.GroupVersionKind{
 		Group:   "dashboard.opendatahub.io",
 		Version: "v1",
@@ -333,12 +411,48 @@ var (
 		Kind:    "AuthorizationPolicy",
 	}
 
+	RequestAuthentication = schema.GroupVersionKind{
+		Group:   "security.istio.io",
+		Version: "v1beta1",
+		Kind:    "RequestAuthentication",
+	}
+
 	Gateway = schema.GroupVersionKind{
 		Group:   "networking.istio.io",
 		Version: "v1beta1",
 		Kind:    "Gateway",
 	}
 
+	GatewayClass = schema.GroupVersionKind{
+		Group:   "gateway.networking.k8s.io",
+		Version: "v1",
+		Kind:    "GatewayClass",
+	}
+
+	KubernetesGateway = schema.GroupVersionKind{
+		Group:   "gateway.networking.k8s.io",
+		Version: "v1",
+		Kind:    "Gateway",
+	}
+
+	HTTPRoute = schema.GroupVersionKind{
+		Group:   "gateway.networking.k8s.io",
+		Version: "v1",
+		Kind:    "HTTPRoute",
+	}
+
+	BackendTLSPolicy = schema.GroupVersionKind{
+		Group:   "gateway.networking.k8s.io",
+		Version: "v1alpha3",
+		Kind:    "BackendTLSPolicy",
+	}
+
+	GatewayConfig = schema.GroupVersionKind{
+		Group:   serviceApi.GroupVersion.Group,
+		Version: serviceApi.GroupVersion.Version,
+		Kind:    serviceApi.GatewayConfigKind,
+	}
+
 	Auth = schema.GroupVersionKind{
 		Group:   serviceApi.GroupVersion.Group,
 		Version: serviceApi.GroupVersion.Version,
@@ -471,6 +585,12 @@ var (
 		Kind:    "TempoStack",
 	}
 
+	LokiStack = schema.GroupVersionKind{
+		Group:   "loki.grafana.com",
+		Version: "v1",
+		Kind:    "LokiStack",
+	}
+
 	OpenTelemetryCollector = schema.GroupVersionKind{
 		Group:   "opentelemetry.io",
 		Version: "v1beta1",
@@ -489,12 +609,36 @@ var (
 		Kind:    "ServiceMonitor",
 	}
 
+	PodMonitor = schema.GroupVersionKind{
+		Group:   "monitoring.rhobs",
+		Version: "v1",
+		Kind:    "PodMonitor",
+	}
+
 	PrometheusRule = schema.GroupVersionKind{
 		Group:   "monitoring.rhobs",
 		Version: "v1",
 		Kind:    "PrometheusRule",
 	}
 
+	AlertmanagerConfig = schema.GroupVersionKind{
+		Group:   "monitoring.rhobs",
+		Version: "v1alpha1",
+		Kind:    "AlertmanagerConfig",
+	}
+
+	Alertmanager = schema.GroupVersionKind{
+		Group:   "monitoring.rhobs",
+		Version: "v1",
+		Kind:    "Alertmanager",
+	}
+
+	PersesDashboard = schema.GroupVersionKind{
+		Group:   "perses.dev",
+		Version: "v1alpha1",
+		Kind:    "PersesDashboard",
+	}
+
 	ServiceMesh = schema.GroupVersionKind{
 		Group:   serviceApi.GroupVersion.Group,
 		Version: serviceApi.GroupVersion.Version,
@@ -518,4 +662,10 @@ var (
 		Version: "v1beta1",
 		Kind:    "Authorino",
 	}
+
+	AuthConfig = schema.GroupVersionKind{
+		Group:   "authorino.kuadrant.io",
+		Version: "v1beta3",
+		Kind:    "AuthConfig",
+	}
 )
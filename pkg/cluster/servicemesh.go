@@ -0,0 +1,51 @@
+package cluster
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+const (
+	// OSSM3OperatorName is the name of the OpenShift Service Mesh 3 operator.
+	OSSM3OperatorName = "servicemeshoperator3"
+	// SailOperatorName is the name of the upstream Sail operator.
+	SailOperatorName = "sailoperator"
+
+	ambientDataPlaneMode = "ambient"
+)
+
+// GetServiceMeshProvider returns the provider of the service mesh, Maistra when not set.
+func GetServiceMeshProvider(spec *infrav1.ServiceMeshSpec) infrav1.ServiceMeshProvider {
+	if spec == nil || spec.Provider == "" {
+		return infrav1.MaistraProvider
+	}
+
+	return spec.Provider
+}
+
+// SailOperatorExists returns true when the OpenShift Service Mesh 3 operator, or the upstream Sail operator, is
+// installed.
+func SailOperatorExists(ctx context.Context, cli client.Client) (bool, error) {
+	for _, name := range []string{OSSM3OperatorName, SailOperatorName} {
+		found, err := SubscriptionExists(ctx, cli, name)
+		if err != nil || found {
+			return found, err
+		}
+	}
+
+	return false, nil
+}
+
+// MeshMemberLabels returns the labels adding a namespace to the mesh of the Sail provider, as key/value pairs: the
+// revision of the control plane in sidecar mode, the ambient data plane mode otherwise.
+func MeshMemberLabels(controlPlaneName string, dataPlaneMode string) []string {
+	if dataPlaneMode == infrav1.AmbientDataPlaneMode {
+		return []string{labels.IstioDataPlaneMode, ambientDataPlaneMode}
+	}
+
+	return []string{labels.IstioRevision, controlPlaneName}
+}
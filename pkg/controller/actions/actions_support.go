@@ -33,3 +33,11 @@ func IfGVKInstalled(kvg schema.GroupVersionKind) func(context.Context, *odhTypes
 		return hasCRD
 	}
 }
+
+// IfOpenShift returns a predicate, true when the operator does not run on upstream Kubernetes, used to watch the
+// OpenShift APIs only where they are served.
+func IfOpenShift() func(context.Context, *odhTypes.ReconciliationRequest) bool {
+	return func(_ context.Context, rr *odhTypes.ReconciliationRequest) bool {
+		return rr.Release.Name != cluster.Kubernetes
+	}
+}
@@ -0,0 +1,390 @@
+package httproute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+const (
+	// DefaultBackendCAConfigMap is the ConfigMap generated in the namespace of the re-encrypt Routes, holding the
+	// service CA of OpenShift under the ca.crt key, as required by the BackendTLSPolicies.
+	DefaultBackendCAConfigMap = "odh-backend-ca-bundle"
+
+	// ServiceCAConfigMap is the ConfigMap holding the service CA of OpenShift, injected in every namespace under the
+	// service-ca.crt key.
+	ServiceCAConfigMap = "openshift-service-ca.crt"
+
+	serviceCAKey        = "service-ca.crt"
+	backendCAKey        = "ca.crt"
+	gatewayListenerName = "https"
+)
+
+var (
+	ErrUnresolvedPort               = errors.New("unable to resolve the port of the backend service")
+	ErrBackendTLSPolicyNotInstalled = errors.New("the BackendTLSPolicy " + gvk.BackendTLSPolicy.Version + " CRD of the Gateway API experimental channel is not installed")
+	ErrServiceCANotInjected         = errors.New("the service CA is not injected yet")
+)
+
+type Action struct {
+	// backendCAConfigMap is the name of an existing ConfigMap holding the CA of the backends, when empty the
+	// DefaultBackendCAConfigMap is generated from the service CA.
+	backendCAConfigMap string
+}
+
+type ActionOpts func(*Action)
+
+// WithBackendCAConfigMap sets an existing ConfigMap holding the CA certificate the Gateway validates the backends of
+// the re-encrypt Routes against, under the ca.crt key, instead of generating one from the service CA.
+func WithBackendCAConfigMap(name string) ActionOpts {
+	return func(action *Action) {
+		action.backendCAConfigMap = name
+	}
+}
+
+// run replaces the Routes among the rendered resources with HTTPRoutes attached to the platform Gateway, when the
+// Gateway is managed in the DSCI, or with Ingresses on Kubernetes, which has no Routes.
+func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	if !cluster.IsGatewayManaged(&rr.DSCI.Spec) {
+		if rr.Release.Name == cluster.Kubernetes {
+			return toIngresses(rr)
+		}
+
+		return nil
+	}
+
+	gateway := &rr.DSCI.Spec.Gateway.GatewayCommonSpec
+
+	domain, err := cluster.GetGatewayDomain(ctx, rr.Client, gateway)
+	if err != nil {
+		return err
+	}
+
+	gatewayName, gatewayNamespace := cluster.GatewayName(gateway)
+
+	// the namespaces the backend CA ConfigMap has been generated in, and whether the BackendTLSPolicy CRD is
+	// installed, both evaluated on the first re-encrypt Route only
+	caNamespaces := map[string]bool{}
+	var hasBackendTLSPolicy *bool
+
+	result := make([]unstructured.Unstructured, 0, len(rr.Resources))
+	for i := range rr.Resources {
+		if rr.Resources[i].GroupVersionKind() != gvk.Route {
+			result = append(result, rr.Resources[i])
+			continue
+		}
+
+		route := routev1.Route{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rr.Resources[i].Object, &route); err != nil {
+			return fmt.Errorf("failed to convert Route %s/%s: %w", rr.Resources[i].GetNamespace(), rr.Resources[i].GetName(), err)
+		}
+
+		port, err := resolvePort(rr.Resources, &route)
+		if err != nil {
+			return fmt.Errorf("failed to convert Route %s/%s: %w", route.Namespace, route.Name, err)
+		}
+
+		result = append(result, newHTTPRoute(&route, gatewayName, gatewayNamespace, host(&route, domain), port))
+
+		if route.Spec.TLS == nil || route.Spec.TLS.Termination != routev1.TLSTerminationReencrypt {
+			continue
+		}
+
+		if hasBackendTLSPolicy == nil {
+			found, err := cluster.HasCRD(ctx, rr.Client, gvk.BackendTLSPolicy)
+			if err != nil {
+				return fmt.Errorf("failed to check the BackendTLSPolicy CRD: %w", err)
+			}
+
+			hasBackendTLSPolicy = &found
+		}
+		if !*hasBackendTLSPolicy {
+			return odherrors.NewStopError("unable to expose the re-encrypt Route %s/%s through the Gateway: %w",
+				route.Namespace, route.Name, ErrBackendTLSPolicyNotInstalled)
+		}
+
+		caConfigMap := a.backendCAConfigMap
+		if caConfigMap == "" {
+			caConfigMap = DefaultBackendCAConfigMap
+
+			if !caNamespaces[route.Namespace] {
+				cm, err := newBackendCAConfigMap(ctx, rr, route.Namespace)
+				if err != nil {
+					return err
+				}
+
+				result = append(result, cm)
+				caNamespaces[route.Namespace] = true
+			}
+		}
+
+		result = append(result, newBackendTLSPolicy(&route, caConfigMap))
+	}
+
+	rr.Resources = result
+
+	return nil
+}
+
+// toIngresses replaces the Routes among the rendered resources with Ingresses of the default IngressClass. The
+// Ingresses of the Routes without host match any host.
+func toIngresses(rr *types.ReconciliationRequest) error {
+	for i := range rr.Resources {
+		if rr.Resources[i].GroupVersionKind() != gvk.Route {
+			continue
+		}
+
+		route := routev1.Route{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rr.Resources[i].Object, &route); err != nil {
+			return fmt.Errorf("failed to convert Route %s/%s: %w", rr.Resources[i].GetNamespace(), rr.Resources[i].GetName(), err)
+		}
+
+		port, err := resolvePort(rr.Resources, &route)
+		if err != nil {
+			return fmt.Errorf("failed to convert Route %s/%s: %w", route.Namespace, route.Name, err)
+		}
+
+		rr.Resources[i] = newIngress(&route, port)
+	}
+
+	return nil
+}
+
+// host returns the host name of a Route in the domain of the Gateway, following the naming of the Routes admitted
+// by OpenShift when the host is not set.
+func host(route *routev1.Route, domain string) string {
+	if route.Spec.Host != "" {
+		return route.Spec.Host
+	}
+
+	return route.Name + "-" + route.Namespace + "." + domain
+}
+
+func newHTTPRoute(route *routev1.Route, gatewayName string, gatewayNamespace string, hostname string, port int64) unstructured.Unstructured {
+	path := route.Spec.Path
+	if path == "" {
+		path = "/"
+	}
+
+	backendRefs := []any{
+		newBackendRef(route.Spec.To, port),
+	}
+	for _, backend := range route.Spec.AlternateBackends {
+		backendRefs = append(backendRefs, newBackendRef(backend, port))
+	}
+
+	u := unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"parentRefs": []any{
+				map[string]any{
+					"group":       gvk.KubernetesGateway.Group,
+					"kind":        gvk.KubernetesGateway.Kind,
+					"name":        gatewayName,
+					"namespace":   gatewayNamespace,
+					"sectionName": gatewayListenerName,
+				},
+			},
+			"hostnames": []any{hostname},
+			"rules": []any{
+				map[string]any{
+					"matches": []any{
+						map[string]any{
+							"path": map[string]any{
+								"type":  "PathPrefix",
+								"value": path,
+							},
+						},
+					},
+					"backendRefs": backendRefs,
+				},
+			},
+		},
+	}}
+
+	u.SetGroupVersionKind(gvk.HTTPRoute)
+	u.SetName(route.Name)
+	u.SetNamespace(route.Namespace)
+	u.SetLabels(route.Labels)
+	u.SetAnnotations(route.Annotations)
+
+	return u
+}
+
+func newIngress(route *routev1.Route, port int64) unstructured.Unstructured {
+	path := route.Spec.Path
+	if path == "" {
+		path = "/"
+	}
+
+	rule := map[string]any{
+		"http": map[string]any{
+			"paths": []any{
+				map[string]any{
+					"path":     path,
+					"pathType": "Prefix",
+					"backend": map[string]any{
+						"service": map[string]any{
+							"name": route.Spec.To.Name,
+							"port": map[string]any{
+								"number": port,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if route.Spec.Host != "" {
+		rule["host"] = route.Spec.Host
+	}
+
+	u := unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"rules": []any{rule},
+		},
+	}}
+
+	u.SetGroupVersionKind(gvk.Ingress)
+	u.SetName(route.Name)
+	u.SetNamespace(route.Namespace)
+	u.SetLabels(route.Labels)
+	u.SetAnnotations(route.Annotations)
+
+	return u
+}
+
+func newBackendRef(backend routev1.RouteTargetReference, port int64) map[string]any {
+	ref := map[string]any{
+		"name": backend.Name,
+		"port": port,
+	}
+
+	if backend.Weight != nil {
+		ref["weight"] = int64(*backend.Weight)
+	}
+
+	return ref
+}
+
+// newBackendCAConfigMap returns a ConfigMap holding the service CA of OpenShift under the ca.crt key, the only key
+// the BackendTLSPolicies read the CA certificates from, copied from the ConfigMap injected in the namespace.
+func newBackendCAConfigMap(ctx context.Context, rr *types.ReconciliationRequest, namespace string) (unstructured.Unstructured, error) {
+	source := corev1.ConfigMap{}
+	if err := rr.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ServiceCAConfigMap}, &source); err != nil {
+		return unstructured.Unstructured{}, fmt.Errorf("failed to get ConfigMap %s/%s: %w", namespace, ServiceCAConfigMap, err)
+	}
+
+	ca := source.Data[serviceCAKey]
+	if ca == "" {
+		return unstructured.Unstructured{}, fmt.Errorf("%w in ConfigMap %s/%s", ErrServiceCANotInjected, namespace, ServiceCAConfigMap)
+	}
+
+	u := unstructured.Unstructured{Object: map[string]any{
+		"data": map[string]any{
+			backendCAKey: ca,
+		},
+	}}
+
+	u.SetGroupVersionKind(gvk.ConfigMap)
+	u.SetName(DefaultBackendCAConfigMap)
+	u.SetNamespace(namespace)
+
+	return u, nil
+}
+
+func newBackendTLSPolicy(route *routev1.Route, caConfigMap string) unstructured.Unstructured {
+	u := unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"targetRefs": []any{
+				map[string]any{
+					"group": "",
+					"kind":  "Service",
+					"name":  route.Spec.To.Name,
+				},
+			},
+			"validation": map[string]any{
+				"caCertificateRefs": []any{
+					map[string]any{
+						"group": "",
+						"kind":  "ConfigMap",
+						"name":  caConfigMap,
+					},
+				},
+				"hostname": route.Spec.To.Name + "." + route.Namespace + ".svc",
+			},
+		},
+	}}
+
+	u.SetGroupVersionKind(gvk.BackendTLSPolicy)
+	u.SetName(route.Name)
+	u.SetNamespace(route.Namespace)
+	u.SetLabels(route.Labels)
+
+	return u
+}
+
+// resolvePort returns the port of the Service targeted by a Route: a Route refers to the name or to the target port
+// of the Service, an HTTPRoute to its port.
+func resolvePort(resources []unstructured.Unstructured, route *routev1.Route) (int64, error) {
+	var targetPort *intstr.IntOrString
+	if route.Spec.Port != nil {
+		targetPort = &route.Spec.Port.TargetPort
+	}
+
+	for i := range resources {
+		if resources[i].GroupVersionKind() != gvk.Service ||
+			resources[i].GetName() != route.Spec.To.Name ||
+			resources[i].GetNamespace() != route.Namespace {
+			continue
+		}
+
+		svc := corev1.Service{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(resources[i].Object, &svc); err != nil {
+			return 0, fmt.Errorf("failed to convert Service %s: %w", route.Spec.To.Name, err)
+		}
+
+		for _, p := range svc.Spec.Ports {
+			switch {
+			case targetPort == nil:
+				return int64(p.Port), nil
+			case targetPort.Type == intstr.String && p.Name == targetPort.StrVal:
+				return int64(p.Port), nil
+			case targetPort.Type == intstr.Int && (p.TargetPort.IntValue() == targetPort.IntValue() || int(p.Port) == targetPort.IntValue()):
+				return int64(p.Port), nil
+			}
+		}
+
+		return 0, fmt.Errorf("%w %s", ErrUnresolvedPort, route.Spec.To.Name)
+	}
+
+	// the Service is not part of the resources of the component, the target port is assumed to be its port
+	if targetPort != nil && targetPort.Type == intstr.Int {
+		return int64(targetPort.IntValue()), nil
+	}
+
+	return 0, fmt.Errorf("%w %s", ErrUnresolvedPort, route.Spec.To.Name)
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
@@ -0,0 +1,278 @@
+package httproute_test
+
+import (
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/rs/xid"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/httproute"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+func newRequest(t *testing.T, ns string, gateway *serviceApi.DSCIGateway, objects ...any) *types.ReconciliationRequest {
+	t.Helper()
+
+	g := NewWithT(t)
+
+	cl, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	rr := types.ReconciliationRequest{
+		Client: cl,
+		DSCI: &dsciv1.DSCInitialization{Spec: dsciv1.DSCInitializationSpec{
+			ApplicationsNamespace: ns,
+			Gateway:               gateway,
+		}},
+		Release: common.Release{Name: cluster.OpenDataHub},
+	}
+
+	for _, obj := range objects {
+		u, err := resources.ToUnstructured(obj)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		rr.Resources = append(rr.Resources, *u)
+	}
+
+	return &rr
+}
+
+func newManagedGateway() *serviceApi.DSCIGateway {
+	return &serviceApi.DSCIGateway{
+		ManagementSpec: common.ManagementSpec{ManagementState: operatorv1.Managed},
+		GatewayCommonSpec: serviceApi.GatewayCommonSpec{
+			Domain: "apps.example.com",
+		},
+	}
+}
+
+func newRoute(ns string, targetPort intstr.IntOrString, termination routev1.TLSTerminationType) *routev1.Route {
+	return &routev1.Route{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: routev1.SchemeGroupVersion.String(),
+			Kind:       gvk.Route.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "odh-dashboard",
+			Namespace: ns,
+			Labels:    map[string]string{"app": "odh-dashboard"},
+		},
+		Spec: routev1.RouteSpec{
+			To:   routev1.RouteTargetReference{Kind: "Service", Name: "odh-dashboard"},
+			Port: &routev1.RoutePort{TargetPort: targetPort},
+			TLS:  &routev1.TLSConfig{Termination: termination},
+		},
+	}
+}
+
+// newBackendTLSClient returns a client of a cluster with the BackendTLSPolicy CRD installed, and with the service CA
+// injected in the given namespace.
+func newBackendTLSClient(t *testing.T, ns string) client.Client {
+	t.Helper()
+
+	g := NewWithT(t)
+
+	crd := mocks.NewMockCRD(gvk.BackendTLSPolicy.Group, gvk.BackendTLSPolicy.Version, gvk.BackendTLSPolicy.Kind, "dashboard")
+	crd.Name = "backendtlspolicies." + gvk.BackendTLSPolicy.Group
+	crd.Status.StoredVersions = []string{gvk.BackendTLSPolicy.Version}
+
+	s, err := scheme.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	s.AddKnownTypeWithName(gvk.BackendTLSPolicy, &unstructured.Unstructured{})
+
+	cl, err := fakeclient.New(
+		fakeclient.WithScheme(s),
+		fakeclient.WithObjects(crd, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: httproute.ServiceCAConfigMap, Namespace: ns},
+			Data:       map[string]string{"service-ca.crt": "service-ca"},
+		}),
+	)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	return cl
+}
+
+func newService(ns string) *corev1.Service {
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       gvk.Service.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "odh-dashboard",
+			Namespace: ns,
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{
+				Name:       "dashboard-ui",
+				Port:       8443,
+				TargetPort: intstr.FromInt32(8443),
+			}},
+		},
+	}
+}
+
+func TestHTTPRouteActionGatewayNotManaged(t *testing.T) {
+	g := NewWithT(t)
+	ns := xid.New().String()
+
+	rr := newRequest(t, ns, nil, newRoute(ns, intstr.FromString("dashboard-ui"), routev1.TLSTerminationReencrypt), newService(ns))
+
+	err := httproute.NewAction()(t.Context(), rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(rr.Resources).Should(HaveLen(2))
+	g.Expect(rr.Resources[0].GroupVersionKind()).Should(Equal(gvk.Route))
+}
+
+func TestHTTPRouteActionReplacesRoutes(t *testing.T) {
+	g := NewWithT(t)
+	ns := xid.New().String()
+
+	rr := newRequest(t, ns, newManagedGateway(), newRoute(ns, intstr.FromString("dashboard-ui"), routev1.TLSTerminationReencrypt), newService(ns))
+	rr.Client = newBackendTLSClient(t, ns)
+
+	err := httproute.NewAction()(t.Context(), rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(rr.Resources).Should(HaveLen(4))
+	g.Expect(rr.Resources).ShouldNot(ContainElement(HaveField("Object", HaveKeyWithValue("kind", gvk.Route.Kind))))
+
+	route := findResource(rr.Resources, gvk.HTTPRoute)
+	g.Expect(route).ShouldNot(BeNil())
+	g.Expect(route.GetLabels()).Should(HaveKeyWithValue("app", "odh-dashboard"))
+	hostnames, _, err := unstructured.NestedStringSlice(route.Object, "spec", "hostnames")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(hostnames).Should(Equal([]string{"odh-dashboard-" + ns + ".apps.example.com"}))
+	g.Expect(route.Object).Should(HaveKeyWithValue("spec", HaveKeyWithValue("parentRefs", ConsistOf(SatisfyAll(
+		HaveKeyWithValue("name", cluster.DefaultGatewayName),
+		HaveKeyWithValue("namespace", cluster.DefaultGatewayNamespace),
+	)))))
+	g.Expect(route.Object).Should(HaveKeyWithValue("spec", HaveKeyWithValue("rules", ConsistOf(
+		HaveKeyWithValue("backendRefs", ConsistOf(SatisfyAll(
+			HaveKeyWithValue("name", "odh-dashboard"),
+			HaveKeyWithValue("port", BeEquivalentTo(8443)),
+		))),
+	))))
+
+	policy := findResource(rr.Resources, gvk.BackendTLSPolicy)
+	g.Expect(policy).ShouldNot(BeNil())
+	hostname, _, err := unstructured.NestedString(policy.Object, "spec", "validation", "hostname")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(hostname).Should(Equal("odh-dashboard." + ns + ".svc"))
+	g.Expect(policy.Object).Should(HaveKeyWithValue("spec", HaveKeyWithValue("validation", HaveKeyWithValue("caCertificateRefs", ConsistOf(
+		HaveKeyWithValue("name", httproute.DefaultBackendCAConfigMap),
+	)))))
+
+	ca := findResource(rr.Resources, gvk.ConfigMap)
+	g.Expect(ca).ShouldNot(BeNil())
+	g.Expect(ca.GetName()).Should(Equal(httproute.DefaultBackendCAConfigMap))
+	g.Expect(ca.GetNamespace()).Should(Equal(ns))
+	g.Expect(ca.Object).Should(HaveKeyWithValue("data", HaveKeyWithValue("ca.crt", "service-ca")))
+}
+
+func TestHTTPRouteActionExistingBackendCA(t *testing.T) {
+	g := NewWithT(t)
+	ns := xid.New().String()
+
+	rr := newRequest(t, ns, newManagedGateway(), newRoute(ns, intstr.FromString("dashboard-ui"), routev1.TLSTerminationReencrypt), newService(ns))
+	rr.Client = newBackendTLSClient(t, ns)
+
+	err := httproute.NewAction(httproute.WithBackendCAConfigMap("my-ca"))(t.Context(), rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(rr.Resources).Should(HaveLen(3))
+	g.Expect(findResource(rr.Resources, gvk.ConfigMap)).Should(BeNil())
+
+	policy := findResource(rr.Resources, gvk.BackendTLSPolicy)
+	g.Expect(policy).ShouldNot(BeNil())
+	g.Expect(policy.Object).Should(HaveKeyWithValue("spec", HaveKeyWithValue("validation", HaveKeyWithValue("caCertificateRefs", ConsistOf(
+		HaveKeyWithValue("name", "my-ca"),
+	)))))
+}
+
+func TestHTTPRouteActionBackendTLSPolicyNotInstalled(t *testing.T) {
+	g := NewWithT(t)
+	ns := xid.New().String()
+
+	rr := newRequest(t, ns, newManagedGateway(), newRoute(ns, intstr.FromString("dashboard-ui"), routev1.TLSTerminationReencrypt), newService(ns))
+
+	err := httproute.NewAction()(t.Context(), rr)
+	g.Expect(err).Should(MatchError(ContainSubstring(httproute.ErrBackendTLSPolicyNotInstalled.Error())))
+}
+
+func TestHTTPRouteActionEdgeRouteWithoutService(t *testing.T) {
+	g := NewWithT(t)
+	ns := xid.New().String()
+
+	rr := newRequest(t, ns, newManagedGateway(), newRoute(ns, intstr.FromInt32(8080), routev1.TLSTerminationEdge))
+
+	err := httproute.NewAction()(t.Context(), rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(rr.Resources).Should(HaveLen(1))
+	g.Expect(rr.Resources[0].GroupVersionKind()).Should(Equal(gvk.HTTPRoute))
+	g.Expect(rr.Resources[0].Object).Should(HaveKeyWithValue("spec", HaveKeyWithValue("rules", ConsistOf(
+		HaveKeyWithValue("backendRefs", ConsistOf(HaveKeyWithValue("port", BeEquivalentTo(8080)))),
+	))))
+}
+
+func TestHTTPRouteActionUnresolvedPort(t *testing.T) {
+	g := NewWithT(t)
+	ns := xid.New().String()
+
+	rr := newRequest(t, ns, newManagedGateway(), newRoute(ns, intstr.FromString("unknown"), routev1.TLSTerminationEdge), newService(ns))
+
+	err := httproute.NewAction()(t.Context(), rr)
+	g.Expect(err).Should(MatchError(httproute.ErrUnresolvedPort))
+}
+
+func TestHTTPRouteActionIngressOnKubernetes(t *testing.T) {
+	g := NewWithT(t)
+	ns := xid.New().String()
+
+	rr := newRequest(t, ns, nil, newRoute(ns, intstr.FromString("dashboard-ui"), routev1.TLSTerminationReencrypt), newService(ns))
+	rr.Release.Name = cluster.Kubernetes
+
+	err := httproute.NewAction()(t.Context(), rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(rr.Resources).Should(HaveLen(2))
+
+	ingress := findResource(rr.Resources, gvk.Ingress)
+	g.Expect(ingress).ShouldNot(BeNil())
+	g.Expect(ingress.GetLabels()).Should(HaveKeyWithValue("app", "odh-dashboard"))
+	g.Expect(ingress.Object).Should(HaveKeyWithValue("spec", HaveKeyWithValue("rules", ConsistOf(SatisfyAll(
+		Not(HaveKey("host")),
+		HaveKeyWithValue("http", HaveKeyWithValue("paths", ConsistOf(SatisfyAll(
+			HaveKeyWithValue("path", "/"),
+			HaveKeyWithValue("backend", HaveKeyWithValue("service", SatisfyAll(
+				HaveKeyWithValue("name", "odh-dashboard"),
+				HaveKeyWithValue("port", HaveKeyWithValue("number", BeEquivalentTo(8443))),
+			))),
+		)))),
+	)))))
+}
+
+func findResource(resources []unstructured.Unstructured, kind schema.GroupVersionKind) *unstructured.Unstructured {
+	for i := range resources {
+		if resources[i].GroupVersionKind() == kind {
+			return &resources[i]
+		}
+	}
+
+	return nil
+}
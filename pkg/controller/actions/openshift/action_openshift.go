@@ -0,0 +1,80 @@
+package openshift
+
+import (
+	"context"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+// DefaultKinds are the OpenShift APIs found in the manifests of the components, not served by the upstream
+// Kubernetes clusters.
+var DefaultKinds = []schema.GroupKind{
+	gvk.Route.GroupKind(),
+	gvk.ConsoleLink.GroupKind(),
+	gvk.SecurityContextConstraints.GroupKind(),
+	gvk.Template.GroupKind(),
+	gvk.ImageStream.GroupKind(),
+	gvk.BuildConfig.GroupKind(),
+	gvk.OAuthClient.GroupKind(),
+}
+
+type Action struct {
+	kinds []schema.GroupKind
+}
+
+type ActionOpts func(*Action)
+
+// WithKinds adds kinds to the OpenShift APIs removed from the resources.
+func WithKinds(values ...schema.GroupKind) ActionOpts {
+	return func(action *Action) {
+		action.kinds = append(action.kinds, values...)
+	}
+}
+
+// run removes the resources of the OpenShift APIs from the rendered resources, when the operator runs on upstream
+// Kubernetes. The Routes must have been converted by the httproute action beforehand to be exposed.
+func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	if rr.Release.Name != cluster.Kubernetes {
+		return nil
+	}
+
+	log := logf.FromContext(ctx)
+
+	result := make([]unstructured.Unstructured, 0, len(rr.Resources))
+	for i := range rr.Resources {
+		if slices.Contains(a.kinds, rr.Resources[i].GroupVersionKind().GroupKind()) {
+			log.V(3).Info("skipping resource not supported on Kubernetes",
+				"gvk", rr.Resources[i].GroupVersionKind(),
+				"namespace", rr.Resources[i].GetNamespace(),
+				"name", rr.Resources[i].GetName())
+
+			continue
+		}
+
+		result = append(result, rr.Resources[i])
+	}
+
+	rr.Resources = result
+
+	return nil
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{
+		kinds: slices.Clone(DefaultKinds),
+	}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
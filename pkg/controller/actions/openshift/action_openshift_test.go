@@ -0,0 +1,70 @@
+package openshift_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/openshift"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func newRequest(platform common.Platform) *types.ReconciliationRequest {
+	return &types.ReconciliationRequest{
+		Release: common.Release{Name: platform},
+		Resources: []unstructured.Unstructured{
+			*resources.GvkToUnstructured(gvk.Deployment),
+			*resources.GvkToUnstructured(gvk.Route),
+			*resources.GvkToUnstructured(gvk.ConsoleLink),
+			*resources.GvkToUnstructured(gvk.SecurityContextConstraints),
+		},
+	}
+}
+
+func kinds(rr *types.ReconciliationRequest) []string {
+	result := make([]string, 0, len(rr.Resources))
+	for i := range rr.Resources {
+		result = append(result, rr.Resources[i].GetKind())
+	}
+
+	return result
+}
+
+func TestOpenShiftActionOnKubernetes(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	rr := newRequest(cluster.Kubernetes)
+
+	err := openshift.NewAction()(ctx, rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(kinds(rr)).Should(ConsistOf(gvk.Deployment.Kind))
+}
+
+func TestOpenShiftActionOnOpenShift(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	rr := newRequest(cluster.OpenDataHub)
+
+	err := openshift.NewAction()(ctx, rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(rr.Resources).Should(HaveLen(4))
+}
+
+func TestOpenShiftActionWithKinds(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	rr := newRequest(cluster.Kubernetes)
+
+	err := openshift.NewAction(openshift.WithKinds(gvk.Deployment.GroupKind()))(ctx, rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(rr.Resources).Should(BeEmpty())
+}
@@ -21,6 +21,23 @@ var (
 			"engine",
 		},
 	)
+
+	// RenderedManifestsSize is a prometheus histogram metrics which holds the number
+	// of resources produced by each render per controller and rendering type.
+	// It has two labels.
+	// controller label refers to the controller name.
+	// engine label refers to the rendering engine.
+	RenderedManifestsSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "action_renderer_manifests_size",
+			Help:    "Number of resources produced by a render",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 10),
+		},
+		[]string{
+			"controller",
+			"engine",
+		},
+	)
 )
 
 // init register metrics to the global registry from controller-runtime/pkg/metrics.
@@ -28,5 +45,5 @@ var (
 //
 //nolint:gochecknoinits
 func init() {
-	metrics.Registry.MustRegister(RenderedResourcesTotal)
+	metrics.Registry.MustRegister(RenderedResourcesTotal, RenderedManifestsSize)
 }
@@ -42,6 +42,7 @@ func TestRenderTemplate(t *testing.T) {
 	)
 
 	render.RenderedResourcesTotal.Reset()
+	render.RenderedManifestsSize.Reset()
 
 	// run the renderer in a loop to ensure the cache is off, and the
 	// manifests are re-rendered on each iteration
@@ -84,6 +85,7 @@ func TestRenderTemplate(t *testing.T) {
 
 		rc := testutil.ToFloat64(render.RenderedResourcesTotal)
 		g.Expect(rc).Should(BeNumerically("==", i))
+		g.Expect(testutil.CollectAndCount(render.RenderedManifestsSize)).Should(Equal(1))
 	}
 }
 
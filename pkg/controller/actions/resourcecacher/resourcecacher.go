@@ -47,6 +47,7 @@ func (s *ResourceCacher) Render(ctx context.Context, rr *types.ReconciliationReq
 
 		controllerName := strings.ToLower(rr.Instance.GetObjectKind().GroupVersionKind().Kind)
 		render.RenderedResourcesTotal.WithLabelValues(controllerName, s.name).Add(float64(resLen))
+		render.RenderedManifestsSize.WithLabelValues(controllerName, s.name).Observe(float64(resLen))
 
 		// flag new resources, used by GC to avoid useless run
 		rr.Generated = true
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -65,6 +66,7 @@ type Reconciler struct {
 	instanceFactory          func() (common.PlatformObject, error)
 	conditionsManagerFactory func(common.ConditionsAccessor) *conditions.Manager
 	gvks                     map[schema.GroupVersionKind]gvkInfo
+	readiness                readinessTracker
 }
 
 // NewReconciler creates a new reconciler for the given type.
@@ -158,6 +160,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	if err := r.Client.Get(ctx, req.NamespacedName, res); err != nil {
+		if k8serr.IsNotFound(err) {
+			InstanceReady.DeleteLabelValues(r.name)
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
@@ -230,6 +235,8 @@ func (r *Reconciler) delete(ctx context.Context, res common.PlatformObject) erro
 	l := log.FromContext(ctx)
 	l.Info("delete")
 
+	r.readiness.forget(res.GetUID())
+
 	rr := types.ReconciliationRequest{
 		Client:     r.Client,
 		Controller: r,
@@ -287,6 +294,9 @@ func (r *Reconciler) apply(ctx context.Context, res common.PlatformObject) error
 
 	rr.Conditions.Reset()
 
+	// account the time to ready from the first reconciliation of a new generation
+	r.readiness.changed(rr.Instance, time.Now())
+
 	var provisionErr error
 
 	dsci, dscilErr := cluster.GetDSCI(ctx, r.Client)
@@ -340,6 +350,13 @@ func (r *Reconciler) apply(ctx context.Context, res common.PlatformObject) error
 	if rr.Conditions.IsHappy() {
 		is.Phase = status.PhaseReady
 		is.ObservedGeneration = rr.Instance.GetGeneration()
+
+		if d, ok := r.readiness.ready(rr.Instance, time.Now()); ok {
+			TimeToReadySeconds.WithLabelValues(r.name).Observe(d.Seconds())
+		}
+		InstanceReady.WithLabelValues(r.name).Set(1)
+	} else {
+		InstanceReady.WithLabelValues(r.name).Set(0)
 	}
 
 	err := resources.ApplyStatus(
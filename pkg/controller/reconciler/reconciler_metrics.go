@@ -1,8 +1,14 @@
 package reconciler
 
 import (
+	"sync"
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 )
 
 var (
@@ -19,6 +25,36 @@ var (
 			"controller",
 		},
 	)
+
+	// TimeToReadySeconds is a prometheus histogram metrics which holds the time
+	// taken by the instances to become Ready after a change of their spec, per
+	// controller. It has one label.
+	// controller label refers to the controller name.
+	TimeToReadySeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "reconciler_time_to_ready_seconds",
+			Help:    "Time from a change of the instance to the instance being Ready",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 12),
+		},
+		[]string{
+			"controller",
+		},
+	)
+
+	// InstanceReady is a prometheus gauge metrics which is set to 1 when the
+	// instance reconciled by a controller is Ready, and to 0 otherwise. As the
+	// components are singletons, it holds the readiness of each component.
+	// It has one label.
+	// controller label refers to the controller name.
+	InstanceReady = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "reconciler_instance_ready",
+			Help: "Whether the reconciled instance is Ready",
+		},
+		[]string{
+			"controller",
+		},
+	)
 )
 
 // init register metrics to the global registry from controller-runtime/pkg/metrics.
@@ -26,5 +62,75 @@ var (
 //
 //nolint:gochecknoinits
 func init() {
-	metrics.Registry.MustRegister(DynamicWatchResourcesTotal)
+	metrics.Registry.MustRegister(
+		DynamicWatchResourcesTotal,
+		TimeToReadySeconds,
+		InstanceReady,
+	)
+}
+
+// pendingGeneration is a generation of an instance that is not Ready yet.
+type pendingGeneration struct {
+	generation int64
+	since      time.Time
+}
+
+// readinessTracker records the generations of the instances that are not Ready yet, to measure the
+// time the instances take to become Ready.
+type readinessTracker struct {
+	mu      sync.Mutex
+	pending map[k8stypes.UID]pendingGeneration
+}
+
+// changed records the current generation of the instance if it has not been observed as Ready yet. The
+// first generation is accounted from the creation of the instance.
+func (t *readinessTracker) changed(obj common.PlatformObject, now time.Time) {
+	if obj.GetStatus().ObservedGeneration == obj.GetGeneration() {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if p, ok := t.pending[obj.GetUID()]; ok && p.generation == obj.GetGeneration() {
+		return
+	}
+
+	since := now
+	if created := obj.GetCreationTimestamp(); obj.GetStatus().ObservedGeneration == 0 && !created.IsZero() {
+		since = created.Time
+	}
+
+	if t.pending == nil {
+		t.pending = make(map[k8stypes.UID]pendingGeneration)
+	}
+
+	t.pending[obj.GetUID()] = pendingGeneration{
+		generation: obj.GetGeneration(),
+		since:      since,
+	}
+}
+
+// ready returns the time the instance took to become Ready, if its current generation was recorded
+// as changed.
+func (t *readinessTracker) ready(obj common.PlatformObject, now time.Time) (time.Duration, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.pending[obj.GetUID()]
+	if !ok || p.generation != obj.GetGeneration() {
+		return 0, false
+	}
+
+	delete(t.pending, obj.GetUID())
+
+	return now.Sub(p.since), true
+}
+
+// forget drops the generation recorded for a deleted instance.
+func (t *readinessTracker) forget(uid k8stypes.UID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.pending, uid)
 }
@@ -0,0 +1,68 @@
+//nolint:testpackage
+package reconciler
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestReadinessTracker(t *testing.T) {
+	g := NewWithT(t)
+
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	obj := &componentApi.Dashboard{
+		ObjectMeta: metav1.ObjectMeta{
+			UID:               types.UID("dashboard"),
+			Generation:        1,
+			CreationTimestamp: metav1.NewTime(created),
+		},
+	}
+
+	tracker := readinessTracker{}
+
+	// the first generation is accounted from the creation of the instance
+	tracker.changed(obj, created.Add(time.Minute))
+	tracker.changed(obj, created.Add(2*time.Minute))
+	d, ok := tracker.ready(obj, created.Add(3*time.Minute))
+	g.Expect(ok).Should(BeTrue())
+	g.Expect(d).Should(Equal(3 * time.Minute))
+
+	// an instance is accounted once per generation
+	_, ok = tracker.ready(obj, created.Add(4*time.Minute))
+	g.Expect(ok).Should(BeFalse())
+
+	// an already observed generation is not accounted
+	obj.Status.ObservedGeneration = 1
+	tracker.changed(obj, created.Add(5*time.Minute))
+	_, ok = tracker.ready(obj, created.Add(6*time.Minute))
+	g.Expect(ok).Should(BeFalse())
+
+	// a new generation is accounted from its first reconciliation
+	obj.Generation = 2
+	tracker.changed(obj, created.Add(10*time.Minute))
+	d, ok = tracker.ready(obj, created.Add(12*time.Minute))
+	g.Expect(ok).Should(BeTrue())
+	g.Expect(d).Should(Equal(2 * time.Minute))
+
+	// a generation changed before being ready is accounted from its own change
+	obj.Generation = 3
+	tracker.changed(obj, created.Add(20*time.Minute))
+	obj.Generation = 4
+	tracker.changed(obj, created.Add(25*time.Minute))
+	d, ok = tracker.ready(obj, created.Add(26*time.Minute))
+	g.Expect(ok).Should(BeTrue())
+	g.Expect(d).Should(Equal(time.Minute))
+
+	// deleted instances are forgotten
+	obj.Generation = 5
+	tracker.changed(obj, created.Add(30*time.Minute))
+	tracker.forget(obj.GetUID())
+	g.Expect(tracker.pending).Should(BeEmpty())
+}
@@ -4,7 +4,15 @@ package annotations
 const ManagedByODHOperator = "opendatahub.io/managed"
 
 // trust CA bundler.
-const InjectionOfCABundleAnnotatoion = "security.opendatahub.io/inject-trusted-ca-bundle"
+const (
+	InjectionOfCABundleAnnotatoion = "security.opendatahub.io/inject-trusted-ca-bundle"
+	// TrustedCABundleSource set on a namespace references a ConfigMap or a Secret of the namespace holding CA
+	// certificates added to its trusted CA bundle, as configmap/<name>[/<key>] or secret/<name>[/<key>].
+	TrustedCABundleSource = "security.opendatahub.io/trusted-ca-bundle-source"
+	// TrustedCABundleHash set on the trusted CA bundle ConfigMaps holds the hash of the CA bundle they were last
+	// written with.
+	TrustedCABundleHash = "security.opendatahub.io/trusted-ca-bundle-hash"
+)
 
 // secret generator.
 const (
@@ -12,6 +20,31 @@ const (
 	SecretTypeAnnotation        = "secret-generator.opendatahub.io/type"
 	SecretLengthAnnotation      = "secret-generator.opendatahub.io/complexity"
 	SecretOauthClientAnnotation = "secret-generator.opendatahub.io/oauth-client-route"
+	SecretCharsetAnnotation     = "secret-generator.opendatahub.io/charset"
+	SecretUsernameAnnotation    = "secret-generator.opendatahub.io/username"
+	SecretHostsAnnotation       = "secret-generator.opendatahub.io/hosts"
+	// SecretSpecAnnotation holds the whole configuration of the generated secret as a JSON or YAML object, the
+	// other annotations taking precedence over its fields.
+	SecretSpecAnnotation = "secret-generator.opendatahub.io/spec"
+	// SecretRotationIntervalAnnotation is the duration after which the generated secret is rotated.
+	SecretRotationIntervalAnnotation = "secret-generator.opendatahub.io/rotation-interval"
+	// SecretRotateAnnotation rotates the generated secret when its value changes.
+	SecretRotateAnnotation = "secret-generator.opendatahub.io/rotate"
+	// SecretGracePeriodAnnotation is the duration the previous values are kept for after a rotation.
+	SecretGracePeriodAnnotation = "secret-generator.opendatahub.io/grace-period"
+	// SecretLastRotationAnnotation set on the generated secrets records the time of their last rotation.
+	SecretLastRotationAnnotation = "secret-generator.opendatahub.io/last-rotation"
+)
+
+// model serving authorization.
+const (
+	// AuthMode set on an InferenceService protects its endpoints with an AuthConfig of the mode, one of kubernetes,
+	// api-key or oidc.
+	AuthMode = "security.opendatahub.io/auth-mode"
+	// AuthOIDCIssuerURL set on an InferenceService in the oidc auth mode is the URL of the issuer of the JWTs.
+	AuthOIDCIssuerURL = "security.opendatahub.io/oidc-issuer-url"
+	// AuthPolicy set on the InferenceServices with an auth mode describes the effective policy, as a JSON object.
+	AuthPolicy = "security.opendatahub.io/auth-policy"
 )
 
 // ManagementStateAnnotation set on Component CR only, to show which ManagementState value if defined in DSC for the component.
@@ -30,3 +63,20 @@ const Connection = "opendatahub.io/connections"
 
 // ConnectionTypeRef annotation for specifying the type of connection.
 const ConnectionTypeRef = "opendatahub.io/connection-type-ref"
+
+// ConnectionsHash annotation set on the pod template of connection consumers (Notebooks, InferenceServices)
+// with a hash of the referenced connection secrets, so that a change in any of them triggers a rollout.
+const ConnectionsHash = "opendatahub.io/connections-hash"
+
+// StorageMigrationAnnotation set on the DSCInitialization, or on the Monitoring CR, acknowledges the loss of the stored
+// traces when switching the traces storage backend, its value being the backend switched to.
+const StorageMigrationAnnotation = "monitoring.opendatahub.io/acknowledge-storage-migration"
+
+// certificate management.
+const (
+	// CertificateHosts set on the Secrets of the certificates issued by the operator lists the hosts they are issued for.
+	CertificateHosts = "certificate.opendatahub.io/hosts"
+	// CertificateSource set on the copies of the default ingress certificate references the copied Secret,
+	// as <namespace>/<name>.
+	CertificateSource = "certificate.opendatahub.io/source"
+)
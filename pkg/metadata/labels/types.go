@@ -8,9 +8,17 @@ const (
 	ClusterMonitoring      = "openshift.io/cluster-monitoring"
 	PlatformPartOf         = ODHPlatformPrefix + "/part-of"
 	PlatformDependency     = ODHPlatformPrefix + "/dependency"
+	PlatformCertificate    = ODHPlatformPrefix + "/certificate"
 	Platform               = "platform"
 	True                   = "true"
 	CustomizedAppNamespace = "opendatahub.io/application-namespace"
+	WebhooksOptOut         = "opendatahub.io/webhooks-opt-out"
+	IstioRevision          = "istio.io/rev"
+	IstioDataPlaneMode     = "istio.io/dataplane-mode"
+	// AuthAPIKey set on a Secret makes its api_key an API key of the InferenceService named by the value.
+	AuthAPIKey = "security.opendatahub.io/api-key"
+	// InferenceService set on the resources generated for an InferenceService holds its name.
+	InferenceService = "serving.kserve.io/inferenceservice"
 )
 
 // K8SCommon keeps common kubernetes labels [1]
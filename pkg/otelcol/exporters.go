@@ -0,0 +1,143 @@
+// Package otelcol provides a local validation of the OpenTelemetry Collector configuration provided by users,
+// catching the errors that would otherwise surface as a crashing collector.
+package otelcol
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// exporterSchema lists the settings accepted by an exporter type, and the settings it requires.
+type exporterSchema struct {
+	settings []string
+	// required lists the settings of which at least one must be set
+	required []string
+}
+
+var (
+	httpClientSettings = []string{
+		"endpoint", "tls", "headers", "compression", "timeout", "auth", "proxy_url", "read_buffer_size",
+		"write_buffer_size", "max_idle_conns", "max_idle_conns_per_host", "max_conns_per_host",
+		"idle_conn_timeout", "disable_keep_alives", "http2_read_idle_timeout", "http2_ping_timeout", "cookies",
+	}
+	grpcClientSettings = []string{
+		"endpoint", "tls", "headers", "compression", "timeout", "auth", "balancer_name", "authority",
+		"keepalive", "read_buffer_size", "write_buffer_size", "wait_for_ready",
+	}
+	exporterHelperSettings = []string{"sending_queue", "retry_on_failure", "batcher"}
+)
+
+// exporterSchemas are the schemas of the exporters shipped with the OpenTelemetry Collector distribution.
+var exporterSchemas = map[string]exporterSchema{
+	"debug": {
+		settings: []string{"verbosity", "sampling_initial", "sampling_thereafter", "use_internal_logger"},
+	},
+	"otlp": {
+		settings: slices.Concat(grpcClientSettings, exporterHelperSettings),
+		required: []string{"endpoint"},
+	},
+	"otlphttp": {
+		settings: slices.Concat(httpClientSettings, exporterHelperSettings,
+			[]string{"traces_endpoint", "metrics_endpoint", "logs_endpoint", "encoding"}),
+		required: []string{"endpoint", "metrics_endpoint"},
+	},
+	"prometheusremotewrite": {
+		settings: slices.Concat(httpClientSettings, []string{
+			"namespace", "external_labels", "resource_to_telemetry_conversion", "remote_write_queue",
+			"retry_on_failure", "target_info", "export_created_metric", "add_metric_suffixes",
+			"send_metadata", "max_batch_size_bytes", "max_batch_request_parallelism", "wal",
+		}),
+		required: []string{"endpoint"},
+	},
+	"kafka": {
+		settings: slices.Concat(exporterHelperSettings, []string{
+			"brokers", "topic", "topic_from_attribute", "encoding", "protocol_version", "client_id",
+			"resolve_canonical_bootstrap_servers_only", "auth", "tls", "metadata", "producer", "timeout",
+			"partition_traces_by_id", "partition_metrics_by_resource_attributes", "partition_logs_by_resource_attributes",
+		}),
+	},
+	"file": {
+		settings: []string{"path", "append", "format", "compression", "rotation", "flush_interval", "group_by"},
+		required: []string{"path"},
+	},
+}
+
+// passthroughExporters are the other exporters shipped with the OpenTelemetry Collector distribution, their
+// configuration is passed through to the collector unchecked.
+var passthroughExporters = []string{
+	"awscloudwatchlogs", "awsemf", "awss3", "awsxray", "azuremonitor", "elasticsearch", "googlecloud",
+	"googlemanagedprometheus", "loadbalancing", "prometheus", "sapm", "signalfx", "splunk_hec", "zipkin",
+}
+
+// ParseExporterConfig parses the YAML configuration of an exporter.
+//
+// Parameters:
+//   - name: The name of the exporter, used in the error messages.
+//   - config: The YAML configuration of the exporter.
+//
+// Returns:
+//   - map[string]any: The parsed configuration.
+//   - error: An error if the configuration is not a valid YAML mapping.
+func ParseExporterConfig(name string, config string) (map[string]any, error) {
+	var cfg any
+	if err := yaml.Unmarshal([]byte(strings.TrimSpace(config)), &cfg); err != nil {
+		return nil, fmt.Errorf("invalid YAML configuration for exporter '%s': %w", name, err)
+	}
+
+	// Require a mapping/object to avoid invalid collector config
+	cfgMap, ok := cfg.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("exporter '%s' configuration must be a YAML mapping/object", name)
+	}
+
+	return cfgMap, nil
+}
+
+// ValidateExporterConfig checks the configuration of an exporter against the schema of its type, the type
+// being the part of the exporter name before the optional '/'. The exporters of the other types shipped with
+// the collector are passed through unchecked, the exporters of unknown types are rejected.
+//
+// Parameters:
+//   - name: The name of the exporter, such as otlphttp or otlphttp/backend.
+//   - config: The parsed configuration of the exporter.
+//
+// Returns:
+//   - error: An error listing the problems found, nil if the configuration is valid.
+func ValidateExporterConfig(name string, config map[string]any) error {
+	typ, _, _ := strings.Cut(name, "/")
+
+	schema, ok := exporterSchemas[typ]
+	if !ok {
+		if slices.Contains(passthroughExporters, typ) {
+			return nil
+		}
+
+		return fmt.Errorf("exporter '%s' has unknown type '%s'", name, typ)
+	}
+
+	var errs []error
+	for _, key := range slices.Sorted(maps.Keys(config)) {
+		if !slices.Contains(schema.settings, key) {
+			errs = append(errs, fmt.Errorf("exporter '%s' has invalid setting '%s'", name, key))
+		}
+	}
+
+	if len(schema.required) > 0 && !slices.ContainsFunc(schema.required, func(key string) bool {
+		return config[key] != nil
+	}) {
+		errs = append(errs, fmt.Errorf("exporter '%s' requires setting '%s'", name, strings.Join(schema.required, "' or '")))
+	}
+
+	if endpoint, ok := config["endpoint"]; ok {
+		if s, isString := endpoint.(string); !isString || s == "" {
+			errs = append(errs, fmt.Errorf("exporter '%s' endpoint must be a non-empty string", name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,115 @@
+package otelcol_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/otelcol"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseExporterConfig(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	cfg, err := otelcol.ParseExporterConfig("otlp/jaeger", "endpoint: jaeger:4317\ntls:\n  insecure: true")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(cfg).Should(Equal(map[string]any{
+		"endpoint": "jaeger:4317",
+		"tls":      map[string]any{"insecure": true},
+	}))
+
+	_, err = otelcol.ParseExporterConfig("otlp", "endpoint: [unclosed")
+	g.Expect(err).Should(MatchError(ContainSubstring("invalid YAML")))
+
+	_, err = otelcol.ParseExporterConfig("otlp", "- endpoint: jaeger:4317")
+	g.Expect(err).Should(MatchError(ContainSubstring("must be a YAML mapping/object")))
+}
+
+func TestValidateExporterConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		exporter string
+		config   string
+		errs     []string
+	}{
+		{
+			name:     "valid otlp exporter",
+			exporter: "otlp/jaeger",
+			config:   "endpoint: jaeger:4317\ntls:\n  insecure: true\nsending_queue:\n  enabled: true",
+		},
+		{
+			name:     "valid otlphttp exporter with a metrics endpoint",
+			exporter: "otlphttp",
+			config:   "metrics_endpoint: https://backend.example.com/v1/metrics",
+		},
+		{
+			name:     "valid kafka exporter without required settings",
+			exporter: "kafka/events",
+			config:   "topic: metrics",
+		},
+		{
+			name:     "valid debug exporter",
+			exporter: "debug/verbose",
+			config:   "verbosity: detailed\nsampling_initial: 5",
+		},
+		{
+			name:     "known type passed through",
+			exporter: "zipkin/tracing",
+			config:   "endpoint: http://zipkin:9411/api/v2/spans\nformat: proto",
+		},
+		{
+			name:     "misspelled type",
+			exporter: "otlphtp/backend",
+			config:   "endpoint: https://backend.example.com",
+			errs:     []string{"unknown type 'otlphtp'"},
+		},
+		{
+			name:     "unknown type",
+			exporter: "prometheusremotwrite",
+			config:   "endpoint: https://thanos.example.com/api/v1/receive",
+			errs:     []string{"unknown type 'prometheusremotwrite'"},
+		},
+		{
+			name:     "misspelled setting",
+			exporter: "prometheusremotewrite/thanos",
+			config:   "endpoint: https://thanos.example.com/api/v1/receive\nresource_to_telemetry_convertion:\n  enabled: true",
+			errs:     []string{"invalid setting 'resource_to_telemetry_convertion'"},
+		},
+		{
+			name:     "missing endpoint",
+			exporter: "otlphttp/backend",
+			config:   "compression: gzip",
+			errs:     []string{"requires setting 'endpoint' or 'metrics_endpoint'"},
+		},
+		{
+			name:     "invalid endpoint",
+			exporter: "otlp",
+			config:   "endpoint: 4317\nheader: {}",
+			errs:     []string{"endpoint must be a non-empty string", "invalid setting 'header'"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			cfg, err := otelcol.ParseExporterConfig(tt.exporter, tt.config)
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			err = otelcol.ValidateExporterConfig(tt.exporter, cfg)
+			if len(tt.errs) == 0 {
+				g.Expect(err).ShouldNot(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).Should(HaveOccurred())
+			for _, msg := range tt.errs {
+				g.Expect(err.Error()).Should(ContainSubstring(msg))
+			}
+		})
+	}
+}
@@ -0,0 +1,281 @@
+package promql
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenType int
+
+const (
+	tokenEOF tokenType = iota
+	tokenIdentifier
+	tokenNumber
+	tokenDuration
+	tokenString
+	tokenLeftParen
+	tokenRightParen
+	tokenLeftBrace
+	tokenRightBrace
+	tokenLeftBracket
+	tokenRightBracket
+	tokenComma
+	tokenColon
+	tokenAt
+	tokenOperator
+	tokenMatchOperator
+)
+
+type token struct {
+	typ tokenType
+	val string
+	pos int
+}
+
+// lexer splits a PromQL expression into tokens.
+type lexer struct {
+	input string
+	pos   int
+}
+
+// next returns the next token of the input, skipping whitespaces and comments.
+func (l *lexer) next() (token, error) {
+	l.skipSpacesAndComments()
+
+	start := l.pos
+	if l.pos >= len(l.input) {
+		return token{typ: tokenEOF, pos: start}, nil
+	}
+
+	c := l.input[l.pos]
+	switch {
+	case c == '(':
+		return l.emit(tokenLeftParen, start, 1), nil
+	case c == ')':
+		return l.emit(tokenRightParen, start, 1), nil
+	case c == '{':
+		return l.emit(tokenLeftBrace, start, 1), nil
+	case c == '}':
+		return l.emit(tokenRightBrace, start, 1), nil
+	case c == '[':
+		return l.emit(tokenLeftBracket, start, 1), nil
+	case c == ']':
+		return l.emit(tokenRightBracket, start, 1), nil
+	case c == ',':
+		return l.emit(tokenComma, start, 1), nil
+	case c == ':':
+		return l.emit(tokenColon, start, 1), nil
+	case c == '@':
+		return l.emit(tokenAt, start, 1), nil
+	case c == '+', c == '-', c == '*', c == '/', c == '%', c == '^':
+		return l.emit(tokenOperator, start, 1), nil
+	case c == '=':
+		switch l.peekAt(1) {
+		case '=':
+			return l.emit(tokenOperator, start, 2), nil
+		case '~':
+			return l.emit(tokenMatchOperator, start, 2), nil
+		}
+		return l.emit(tokenMatchOperator, start, 1), nil
+	case c == '!':
+		switch l.peekAt(1) {
+		case '=':
+			return l.emit(tokenOperator, start, 2), nil
+		case '~':
+			return l.emit(tokenMatchOperator, start, 2), nil
+		}
+		return token{}, newError(start, "unexpected character after '!'")
+	case c == '<', c == '>':
+		if l.peekAt(1) == '=' {
+			return l.emit(tokenOperator, start, 2), nil
+		}
+		return l.emit(tokenOperator, start, 1), nil
+	case c == '"', c == '\'', c == '`':
+		return l.lexString(c)
+	case isDigit(c) || (c == '.' && isDigit(l.peekAt(1))):
+		return l.lexNumberOrDuration()
+	case isIdentifierStart(c):
+		for l.pos < len(l.input) && isIdentifierChar(l.input[l.pos]) {
+			l.pos++
+		}
+		return token{typ: tokenIdentifier, val: l.input[start:l.pos], pos: start}, nil
+	}
+
+	r, _ := utf8.DecodeRuneInString(l.input[l.pos:])
+	return token{}, newError(start, "unexpected character %q", r)
+}
+
+func (l *lexer) emit(typ tokenType, start int, size int) token {
+	l.pos += size
+	return token{typ: typ, val: l.input[start:l.pos], pos: start}
+}
+
+func (l *lexer) peekAt(offset int) byte {
+	if l.pos+offset >= len(l.input) {
+		return 0
+	}
+	return l.input[l.pos+offset]
+}
+
+func (l *lexer) skipSpacesAndComments() {
+	for l.pos < len(l.input) {
+		r, size := utf8.DecodeRuneInString(l.input[l.pos:])
+		switch {
+		case unicode.IsSpace(r):
+			l.pos += size
+		case r == '#':
+			if i := strings.IndexByte(l.input[l.pos:], '\n'); i >= 0 {
+				l.pos += i + 1
+			} else {
+				l.pos = len(l.input)
+			}
+		default:
+			return
+		}
+	}
+}
+
+// lexString lexes a quoted string, the value of the token is the unquoted string.
+func (l *lexer) lexString(quote byte) (token, error) {
+	start := l.pos
+	l.pos++
+
+	if quote == '`' {
+		end := strings.IndexByte(l.input[l.pos:], '`')
+		if end < 0 {
+			return token{}, newError(start, "unterminated raw string")
+		}
+		val := l.input[l.pos : l.pos+end]
+		l.pos += end + 1
+		return token{typ: tokenString, val: val, pos: start}, nil
+	}
+
+	var sb strings.Builder
+	s := l.input[l.pos:]
+	for {
+		if s == "" || s[0] == '\n' {
+			return token{}, newError(start, "unterminated quoted string")
+		}
+		if s[0] == quote {
+			l.pos = len(l.input) - len(s) + 1
+			return token{typ: tokenString, val: sb.String(), pos: start}, nil
+		}
+
+		r, _, tail, err := strconv.UnquoteChar(s, quote)
+		if err != nil {
+			return token{}, newError(len(l.input)-len(s), "invalid escape sequence in quoted string")
+		}
+		sb.WriteRune(r)
+		s = tail
+	}
+}
+
+// lexNumberOrDuration lexes a decimal or hexadecimal number, or a duration such as 5m or 1h30m.
+func (l *lexer) lexNumberOrDuration() (token, error) {
+	start := l.pos
+
+	if l.input[l.pos] == '0' && (l.peekAt(1) == 'x' || l.peekAt(1) == 'X') {
+		l.pos += 2
+		digits := l.pos
+		for l.pos < len(l.input) && isHexDigit(l.input[l.pos]) {
+			l.pos++
+		}
+		if l.pos == digits {
+			return token{}, newError(start, "invalid hexadecimal number")
+		}
+		return l.endNumber(start)
+	}
+
+	l.skipDigits()
+
+	// durations are integers followed by a unit, optionally repeated such as in 1h30m
+	if l.pos < len(l.input) && l.input[l.pos] != '.' && durationUnitLength(l.input[l.pos:]) > 0 {
+		for {
+			size := durationUnitLength(l.input[l.pos:])
+			if size == 0 {
+				return token{}, newError(start, "invalid duration %q", l.input[start:l.pos])
+			}
+			l.pos += size
+
+			if l.pos >= len(l.input) || !isDigit(l.input[l.pos]) {
+				break
+			}
+			l.skipDigits()
+		}
+		return l.endDuration(start)
+	}
+
+	if l.pos < len(l.input) && l.input[l.pos] == '.' {
+		l.pos++
+		l.skipDigits()
+	}
+
+	if l.pos < len(l.input) && (l.input[l.pos] == 'e' || l.input[l.pos] == 'E') {
+		l.pos++
+		if l.pos < len(l.input) && (l.input[l.pos] == '+' || l.input[l.pos] == '-') {
+			l.pos++
+		}
+		digits := l.pos
+		l.skipDigits()
+		if l.pos == digits {
+			return token{}, newError(start, "invalid exponent in number %q", l.input[start:l.pos])
+		}
+	}
+
+	return l.endNumber(start)
+}
+
+func (l *lexer) endNumber(start int) (token, error) {
+	if l.pos < len(l.input) && isAlphanumeric(l.input[l.pos]) {
+		return token{}, newError(start, "invalid number %q", l.input[start:l.pos+1])
+	}
+	return token{typ: tokenNumber, val: l.input[start:l.pos], pos: start}, nil
+}
+
+func (l *lexer) endDuration(start int) (token, error) {
+	if l.pos < len(l.input) && isAlphanumeric(l.input[l.pos]) {
+		return token{}, newError(start, "invalid duration %q", l.input[start:l.pos+1])
+	}
+	return token{typ: tokenDuration, val: l.input[start:l.pos], pos: start}, nil
+}
+
+func (l *lexer) skipDigits() {
+	for l.pos < len(l.input) && isDigit(l.input[l.pos]) {
+		l.pos++
+	}
+}
+
+// durationUnitLength returns the length of the duration unit s starts with, 0 if none.
+func durationUnitLength(s string) int {
+	if strings.HasPrefix(s, "ms") {
+		return 2
+	}
+	if s != "" && strings.IndexByte("smhdwy", s[0]) >= 0 {
+		return 1
+	}
+	return 0
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || c == ':' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// isAlphanumeric reports whether c can be part of an identifier, except for the colon separating the
+// durations of a subquery.
+func isAlphanumeric(c byte) bool {
+	return c != ':' && isIdentifierChar(c)
+}
+
+func isIdentifierChar(c byte) bool {
+	return isIdentifierStart(c) || isDigit(c)
+}
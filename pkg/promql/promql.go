@@ -0,0 +1,567 @@
+// Package promql provides a local syntax check of PromQL expressions, used to validate alerting and
+// recording rules before they are deployed without requiring a running Prometheus.
+//
+// The check covers the PromQL grammar (selectors, matchers, operators and their modifiers, aggregations,
+// function calls, range and subqueries, offset and @ modifiers) and the names and arity of aggregations,
+// it does not type check the arguments of functions.
+package promql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Error is a syntax error found in a PromQL expression.
+type Error struct {
+	// Pos is the offset of the error in the expression.
+	Pos int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("parse error at position %d: %s", e.Pos+1, e.Msg)
+}
+
+func newError(pos int, format string, args ...any) *Error {
+	return &Error{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// binaryOperators maps the binary operators to their precedence.
+var binaryOperators = map[string]int{
+	"or":     1,
+	"and":    2,
+	"unless": 2,
+	"==":     3,
+	"!=":     3,
+	"<":      3,
+	"<=":     3,
+	">":      3,
+	">=":     3,
+	"+":      4,
+	"-":      4,
+	"*":      5,
+	"/":      5,
+	"%":      5,
+	"atan2":  5,
+	"^":      6,
+}
+
+var comparisonOperators = map[string]bool{
+	"==": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true,
+}
+
+var setOperators = map[string]bool{
+	"and": true, "or": true, "unless": true,
+}
+
+// aggregations maps the aggregation operators to whether they take a parameter.
+var aggregations = map[string]bool{
+	"sum":          false,
+	"avg":          false,
+	"count":        false,
+	"min":          false,
+	"max":          false,
+	"group":        false,
+	"stddev":       false,
+	"stdvar":       false,
+	"topk":         true,
+	"bottomk":      true,
+	"count_values": true,
+	"quantile":     true,
+	"limitk":       true,
+	"limit_ratio":  true,
+}
+
+var functions = map[string]bool{}
+
+func init() {
+	for _, f := range []string{
+		"abs", "absent", "absent_over_time", "acos", "acosh", "asin", "asinh", "atan", "atanh",
+		"avg_over_time", "ceil", "changes", "clamp", "clamp_max", "clamp_min", "cos", "cosh",
+		"count_over_time", "day_of_month", "day_of_week", "day_of_year", "days_in_month", "deg",
+		"delta", "deriv", "double_exponential_smoothing", "exp", "floor", "histogram_avg",
+		"histogram_count", "histogram_fraction", "histogram_quantile", "histogram_stddev",
+		"histogram_stdvar", "histogram_sum", "holt_winters", "hour", "idelta", "increase", "info",
+		"irate", "label_join", "label_replace", "last_over_time", "ln", "log10", "log2",
+		"mad_over_time", "max_over_time", "min_over_time", "minute", "month", "pi",
+		"predict_linear", "present_over_time", "quantile_over_time", "rad", "rate", "resets",
+		"round", "scalar", "sgn", "sin", "sinh", "sort", "sort_by_label", "sort_by_label_desc",
+		"sort_desc", "sqrt", "stddev_over_time", "stdvar_over_time", "sum_over_time", "tan",
+		"tanh", "time", "timestamp", "vector", "year",
+	} {
+		functions[f] = true
+	}
+}
+
+// Validate checks the syntax of a PromQL expression.
+//
+// Parameters:
+//   - expr: The PromQL expression.
+//
+// Returns:
+//   - error: An *Error describing the first syntax error found, nil if the expression is valid.
+func Validate(expr string) error {
+	p := &parser{lex: lexer{input: expr}}
+	if err := p.advance(); err != nil {
+		return err
+	}
+
+	if p.tok.typ == tokenEOF {
+		return newError(0, "no expression found in input")
+	}
+
+	if err := p.parseExpr(0); err != nil {
+		return err
+	}
+
+	if p.tok.typ != tokenEOF {
+		return p.unexpected("end of input")
+	}
+
+	return nil
+}
+
+type parser struct {
+	lex lexer
+	tok token
+}
+
+func (p *parser) advance() error {
+	tok, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+
+	return nil
+}
+
+func (p *parser) expect(typ tokenType, what string) error {
+	if p.tok.typ != typ {
+		return p.unexpected(what)
+	}
+	return p.advance()
+}
+
+func (p *parser) unexpected(expected string) error {
+	if p.tok.typ == tokenEOF {
+		return newError(p.tok.pos, "unexpected end of input, expected %s", expected)
+	}
+	return newError(p.tok.pos, "unexpected %q, expected %s", p.tok.val, expected)
+}
+
+// keyword returns the lower-cased value of the current token if it is an identifier,
+// keywords are case-insensitive.
+func (p *parser) keyword() string {
+	if p.tok.typ != tokenIdentifier {
+		return ""
+	}
+	return strings.ToLower(p.tok.val)
+}
+
+// binaryOperator returns the current token if it is a binary operator.
+func (p *parser) binaryOperator() (string, bool) {
+	var op string
+	switch p.tok.typ {
+	case tokenOperator:
+		op = p.tok.val
+	case tokenIdentifier:
+		op = p.keyword()
+	default:
+		return "", false
+	}
+
+	_, ok := binaryOperators[op]
+	return op, ok
+}
+
+// parseExpr parses a binary expression whose operators have at least the given precedence.
+func (p *parser) parseExpr(minPrecedence int) error {
+	if err := p.parseUnary(); err != nil {
+		return err
+	}
+
+	for {
+		op, ok := p.binaryOperator()
+		if !ok || binaryOperators[op] < minPrecedence {
+			return nil
+		}
+		if err := p.advance(); err != nil {
+			return err
+		}
+
+		if err := p.parseBinaryModifiers(op); err != nil {
+			return err
+		}
+
+		// ^ is right associative, the other operators are left associative
+		next := binaryOperators[op] + 1
+		if op == "^" {
+			next = binaryOperators[op]
+		}
+		if err := p.parseExpr(next); err != nil {
+			return err
+		}
+	}
+}
+
+// parseBinaryModifiers parses the bool, on, ignoring, group_left and group_right modifiers of a binary operator.
+func (p *parser) parseBinaryModifiers(op string) error {
+	if p.keyword() == "bool" {
+		if !comparisonOperators[op] {
+			return newError(p.tok.pos, "bool modifier can only be used on comparison operators")
+		}
+		if err := p.advance(); err != nil {
+			return err
+		}
+	}
+
+	switch p.keyword() {
+	case "on", "ignoring":
+		if err := p.advance(); err != nil {
+			return err
+		}
+		if err := p.parseLabels(); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	switch p.keyword() {
+	case "group_left", "group_right":
+		if setOperators[op] {
+			return newError(p.tok.pos, "no grouping allowed for %q operation", op)
+		}
+		if err := p.advance(); err != nil {
+			return err
+		}
+		if p.tok.typ == tokenLeftParen {
+			return p.parseLabels()
+		}
+	}
+
+	return nil
+}
+
+func (p *parser) parseUnary() error {
+	if p.tok.typ == tokenOperator && (p.tok.val == "+" || p.tok.val == "-") {
+		if err := p.advance(); err != nil {
+			return err
+		}
+		return p.parseUnary()
+	}
+
+	return p.parsePostfix()
+}
+
+// parsePostfix parses an expression followed by range, subquery, offset and @ modifiers.
+func (p *parser) parsePostfix() error {
+	selector, err := p.parsePrimary()
+	if err != nil {
+		return err
+	}
+
+	for {
+		switch {
+		case p.tok.typ == tokenLeftBracket:
+			pos := p.tok.pos
+			if err := p.advance(); err != nil {
+				return err
+			}
+			if err := p.expect(tokenDuration, "duration"); err != nil {
+				return err
+			}
+			if p.tok.typ == tokenColon {
+				if err := p.advance(); err != nil {
+					return err
+				}
+				if p.tok.typ == tokenDuration {
+					if err := p.advance(); err != nil {
+						return err
+					}
+				}
+			} else if !selector {
+				return newError(pos, "ranges only allowed for vector selectors")
+			}
+			if err := p.expect(tokenRightBracket, "\"]\""); err != nil {
+				return err
+			}
+			selector = false
+		case p.keyword() == "offset":
+			if err := p.advance(); err != nil {
+				return err
+			}
+			if p.tok.typ == tokenOperator && p.tok.val == "-" {
+				if err := p.advance(); err != nil {
+					return err
+				}
+			}
+			if err := p.expect(tokenDuration, "duration"); err != nil {
+				return err
+			}
+		case p.tok.typ == tokenAt:
+			if err := p.advance(); err != nil {
+				return err
+			}
+			if err := p.parseAtModifier(); err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+	}
+}
+
+func (p *parser) parseAtModifier() error {
+	switch k := p.keyword(); {
+	case k == "start" || k == "end":
+		if err := p.advance(); err != nil {
+			return err
+		}
+		if err := p.expect(tokenLeftParen, "\"(\""); err != nil {
+			return err
+		}
+		return p.expect(tokenRightParen, "\")\"")
+	case p.tok.typ == tokenOperator && (p.tok.val == "+" || p.tok.val == "-"):
+		if err := p.advance(); err != nil {
+			return err
+		}
+	}
+
+	return p.expect(tokenNumber, "timestamp")
+}
+
+// parsePrimary parses a literal, a parenthesized expression, an aggregation, a function call or a
+// vector selector, and reports whether it is a vector selector.
+func (p *parser) parsePrimary() (bool, error) {
+	switch p.tok.typ {
+	case tokenNumber, tokenString:
+		return false, p.advance()
+	case tokenLeftParen:
+		if err := p.advance(); err != nil {
+			return false, err
+		}
+		if err := p.parseExpr(0); err != nil {
+			return false, err
+		}
+		return false, p.expect(tokenRightParen, "\")\"")
+	case tokenLeftBrace:
+		return true, p.parseSelector(false)
+	case tokenIdentifier:
+		return p.parseIdentifier()
+	case tokenDuration:
+		return false, newError(p.tok.pos, "unexpected duration %q, durations are only allowed in ranges and offsets", p.tok.val)
+	default:
+		return false, p.unexpected("expression")
+	}
+}
+
+func (p *parser) parseIdentifier() (bool, error) {
+	name := p.tok.val
+	keyword := p.keyword()
+
+	switch {
+	case keyword == "inf" || keyword == "nan":
+		return false, p.advance()
+	case keyword == "bool" || keyword == "by" || keyword == "without" || keyword == "on" ||
+		keyword == "ignoring" || keyword == "group_left" || keyword == "group_right" || keyword == "offset":
+		return false, p.unexpected("expression")
+	}
+
+	if _, ok := binaryOperators[keyword]; ok {
+		return false, p.unexpected("expression")
+	}
+
+	if hasParam, ok := aggregations[keyword]; ok {
+		return false, p.parseAggregation(hasParam)
+	}
+
+	pos := p.tok.pos
+	if err := p.advance(); err != nil {
+		return false, err
+	}
+
+	if p.tok.typ == tokenLeftParen {
+		if !functions[name] {
+			return false, newError(pos, "unknown function with name %q", name)
+		}
+		return false, p.parseCall()
+	}
+
+	if p.tok.typ == tokenLeftBrace {
+		return true, p.parseSelector(true)
+	}
+
+	return true, nil
+}
+
+// parseAggregation parses an aggregation, with its grouping either before or after its arguments.
+func (p *parser) parseAggregation(hasParam bool) error {
+	name := p.tok.val
+	if err := p.advance(); err != nil {
+		return err
+	}
+
+	grouped := false
+	if k := p.keyword(); k == "by" || k == "without" {
+		if err := p.advance(); err != nil {
+			return err
+		}
+		if err := p.parseLabels(); err != nil {
+			return err
+		}
+		grouped = true
+	}
+
+	if err := p.expect(tokenLeftParen, "\"(\""); err != nil {
+		return err
+	}
+
+	if hasParam {
+		if err := p.parseExpr(0); err != nil {
+			return err
+		}
+		if p.tok.typ != tokenComma {
+			return newError(p.tok.pos, "wrong number of arguments for aggregate expression provided, expected 2")
+		}
+		if err := p.advance(); err != nil {
+			return err
+		}
+	}
+
+	if p.tok.typ == tokenRightParen {
+		return newError(p.tok.pos, "no arguments for aggregate expression %q provided", name)
+	}
+	if err := p.parseExpr(0); err != nil {
+		return err
+	}
+	if p.tok.typ == tokenComma {
+		return newError(p.tok.pos, "too many arguments for aggregate expression %q", name)
+	}
+	if err := p.expect(tokenRightParen, "\")\""); err != nil {
+		return err
+	}
+
+	if k := p.keyword(); k == "by" || k == "without" {
+		if grouped {
+			return newError(p.tok.pos, "aggregation %q has more than one grouping", name)
+		}
+		if err := p.advance(); err != nil {
+			return err
+		}
+		return p.parseLabels()
+	}
+
+	return nil
+}
+
+func (p *parser) parseCall() error {
+	if err := p.advance(); err != nil {
+		return err
+	}
+
+	if p.tok.typ == tokenRightParen {
+		return p.advance()
+	}
+
+	for {
+		if err := p.parseExpr(0); err != nil {
+			return err
+		}
+		if p.tok.typ != tokenComma {
+			return p.expect(tokenRightParen, "\",\" or \")\"")
+		}
+		if err := p.advance(); err != nil {
+			return err
+		}
+	}
+}
+
+// parseLabels parses a parenthesized list of label names.
+func (p *parser) parseLabels() error {
+	if err := p.expect(tokenLeftParen, "\"(\""); err != nil {
+		return err
+	}
+
+	for p.tok.typ != tokenRightParen {
+		if p.tok.typ != tokenIdentifier && p.tok.typ != tokenString {
+			return p.unexpected("label name")
+		}
+		if err := p.advance(); err != nil {
+			return err
+		}
+		if p.tok.typ != tokenComma {
+			break
+		}
+		if err := p.advance(); err != nil {
+			return err
+		}
+	}
+
+	return p.expect(tokenRightParen, "\",\" or \")\"")
+}
+
+// parseSelector parses the label matchers of a vector selector, at least one matcher is required
+// if the selector has no metric name.
+func (p *parser) parseSelector(hasName bool) error {
+	pos := p.tok.pos
+	if err := p.advance(); err != nil {
+		return err
+	}
+
+	matchers := 0
+	for p.tok.typ != tokenRightBrace {
+		if p.tok.typ != tokenIdentifier && p.tok.typ != tokenString {
+			return p.unexpected("label matcher")
+		}
+		if err := p.advance(); err != nil {
+			return err
+		}
+
+		if p.tok.typ == tokenComma || p.tok.typ == tokenRightBrace {
+			// a quoted metric name, such as {"metric.name"}
+			matchers++
+		} else {
+			op := p.tok.val
+			// "!=" is lexed as a comparison operator, it is a matching operator in a selector
+			if p.tok.typ == tokenOperator && op == "!=" {
+				p.tok.typ = tokenMatchOperator
+			}
+			if err := p.expect(tokenMatchOperator, "label matching operator"); err != nil {
+				return err
+			}
+
+			if p.tok.typ != tokenString {
+				return p.unexpected("label value string")
+			}
+			if op == "=~" || op == "!~" {
+				if _, err := regexp.Compile("^(?:" + p.tok.val + ")$"); err != nil {
+					return newError(p.tok.pos, "invalid regular expression %q: %v", p.tok.val, err)
+				}
+			}
+			if err := p.advance(); err != nil {
+				return err
+			}
+			matchers++
+		}
+
+		if p.tok.typ != tokenComma {
+			break
+		}
+		if err := p.advance(); err != nil {
+			return err
+		}
+	}
+
+	if err := p.expect(tokenRightBrace, "\",\" or \"}\""); err != nil {
+		return err
+	}
+
+	if !hasName && matchers == 0 {
+		return newError(pos, "vector selector must contain at least one non-empty matcher")
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package promql_test
+
+import (
+	"testing"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/promql"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := []string{
+		`up`,
+		`up == 0`,
+		`1 + 2 * -3 ^ 2`,
+		`0x1F > 1e-3`,
+		`-Inf < NaN`,
+		`"string"`,
+		`up{job="prometheus", instance!~"localhost:.*",}`,
+		`rate(controller_runtime_reconcile_total{controller="dscinitialization-controller", result!="success"}[15m])`,
+		`{__name__=~"job:.*"}`,
+		`haproxy_backend_http_responses_total:burnrate5m{route=~"rhods-dashboard"}`,
+		`rate(http_requests_total{code=~'5..'}[5m])`,
+		`sum(rate(http_requests_total[1h30m])) by (job, instance) / ignoring(code) group_left sum(rate(http_requests_total[1h]))`,
+		`sum by (job) (rate(http_requests_total[5m] offset 1d))`,
+		`sum without (instance) (up) > bool 0`,
+		`topk(5, sum by (job) (up))`,
+		`count_values("version", build_version)`,
+		`quantile(0.9, rate(latency_seconds[5m]))`,
+		`histogram_quantile(0.99, sum(rate(latency_bucket[5m])) by (le))`,
+		`max_over_time(rate(http_requests_total[5m])[30m:1m])`,
+		`max_over_time(up[30m:])`,
+		`up @ 1609746000`,
+		`up @ start()`,
+		`up offset -5m`,
+		`absent(up{job="missing"})`,
+		`time() - process_start_time_seconds > 3600`,
+		`label_replace(up, "host", "$1", "instance", "(.*):.*")`,
+		`up and on(instance) node_up or vector(0) unless up`,
+		`up AND up`,
+		`1 atan2 2 > 1`,
+		"sum(\n  up # comment\n)",
+		"up{job=`raw`}",
+		`kubelet_volume_stats_used_bytes{persistentvolumeclaim=~".*jupyterhub-nb-.*"} / kubelet_volume_stats_capacity_bytes > 0.9`,
+	}
+
+	for _, expr := range valid {
+		t.Run(expr, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(promql.Validate(expr)).Should(Succeed())
+		})
+	}
+
+	invalid := []string{
+		``,
+		`   `,
+		`up ==`,
+		`sum(up`,
+		`up)`,
+		`up{job="prometheus"`,
+		`up{job=prometheus}`,
+		`up{job~"prometheus"}`,
+		`up{job=~"("}`,
+		`{}`,
+		`rate(up[5])`,
+		`rate(up[5x])`,
+		`sum(up)[5m]`,
+		`unknown_function(up)`,
+		`topk(up)`,
+		`sum()`,
+		`sum(up, up)`,
+		`sum by (job) (up) by (job)`,
+		`up and bool up`,
+		`up and on(job) group_left up`,
+		`up offset`,
+		`5m`,
+		`up "string"`,
+		`up{job="unterminated}`,
+		`1.2.3`,
+		`up !`,
+		`by`,
+	}
+
+	for _, expr := range invalid {
+		t.Run(expr, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			err := promql.Validate(expr)
+			g.Expect(err).Should(HaveOccurred())
+			g.Expect(err).Should(BeAssignableToTypeOf(&promql.Error{}))
+		})
+	}
+}
@@ -153,6 +153,40 @@ func IngressHost(r routev1.Route) string {
 	return ""
 }
 
+// HTTPRouteAccepted returns true when an HTTPRoute is accepted by all of its parent Gateways.
+func HTTPRouteAccepted(r unstructured.Unstructured) bool {
+	parents, _, err := unstructured.NestedSlice(r.Object, "status", "parents")
+	if err != nil || len(parents) == 0 {
+		return false
+	}
+
+	for _, parent := range parents {
+		parentMap, ok := parent.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		conditions, _, err := unstructured.NestedSlice(parentMap, "conditions")
+		if err != nil {
+			return false
+		}
+
+		accepted := false
+		for _, condition := range conditions {
+			conditionMap, ok := condition.(map[string]any)
+			if ok && conditionMap["type"] == "Accepted" && conditionMap["status"] == string(metav1.ConditionTrue) {
+				accepted = true
+			}
+		}
+
+		if !accepted {
+			return false
+		}
+	}
+
+	return true
+}
+
 func HasLabel(obj client.Object, k string, values ...string) bool {
 	if obj == nil {
 		return false
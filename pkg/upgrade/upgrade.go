@@ -220,7 +220,7 @@ func CreateDefaultDSC(ctx context.Context, cli client.Client) error {
 // CreateDefaultDSCI creates a default instance of DSCI
 // If there exists default-dsci instance already, it will not update DSCISpec on it.
 // Note: DSCI CR modifcations are not supported, as it is the initial prereq setting for the components.
-func CreateDefaultDSCI(ctx context.Context, cli client.Client, _ common.Platform, monNamespace string) error {
+func CreateDefaultDSCI(ctx context.Context, cli client.Client, platform common.Platform, monNamespace string) error {
 	log := logf.FromContext(ctx)
 	defaultDsciSpec := &dsciv1.DSCInitializationSpec{
 		Monitoring: serviceApi.DSCIMonitoring{
@@ -243,6 +243,11 @@ func CreateDefaultDSCI(ctx context.Context, cli client.Client, _ common.Platform
 		},
 	}
 
+	// OpenShift Service Mesh is not available on Kubernetes
+	if platform == cluster.Kubernetes {
+		defaultDsciSpec.ServiceMesh = nil
+	}
+
 	defaultDsci := &dsciv1.DSCInitialization{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "DSCInitialization",
@@ -0,0 +1,100 @@
+package webhookutils
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// ConnectionSchemas maps a connection type, as set in the "opendatahub.io/connection-type-ref"
+// annotation of a connection Secret, to the data keys the Secret must contain for the connection
+// to be usable. Connection types not listed here are not schema-validated.
+var ConnectionSchemas = map[string][]string{
+	"s3": {
+		"AWS_S3_ENDPOINT",
+		"AWS_ACCESS_KEY_ID",
+		"AWS_SECRET_ACCESS_KEY",
+	},
+	"uri-v1": {
+		"URI",
+	},
+	"oci-v1": {
+		corev1.DockerConfigJsonKey,
+	},
+}
+
+// MissingConnectionKeys returns the data keys required by the connection type of the given Secret
+// that are either absent or empty. It returns nil if the Secret has no connection type or if the
+// connection type has no registered schema.
+//
+// Parameters:
+//   - secret: The connection Secret to check.
+//
+// Returns:
+//   - string: The connection type read from the Secret.
+//   - []string: The missing keys, in schema order.
+func MissingConnectionKeys(secret *corev1.Secret) (string, []string) {
+	connectionType := resources.GetAnnotation(secret, annotations.ConnectionTypeRef)
+
+	required, ok := ConnectionSchemas[connectionType]
+	if !ok {
+		return connectionType, nil
+	}
+
+	var missing []string
+	for _, key := range required {
+		if len(secret.Data[key]) == 0 && secret.StringData[key] == "" {
+			missing = append(missing, key)
+		}
+	}
+
+	return connectionType, missing
+}
+
+// ValidateConnectionSecretSchema checks that the given Secret contains every key required by its
+// connection type, and returns an error listing the missing keys otherwise.
+func ValidateConnectionSecretSchema(secret *corev1.Secret) error {
+	connectionType, missing := MissingConnectionKeys(secret)
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("connection secret '%s/%s' of type '%s' is missing required key(s): %s",
+		secret.Namespace, secret.Name, connectionType, strings.Join(missing, ", "))
+}
+
+// ParseConnectionReferences parses the value of the "opendatahub.io/connections" annotation into
+// a list of Secret references. Entries are comma separated and may be either fully qualified
+// (namespace/name), as used by Notebooks, or a bare name, as used by InferenceServices, in which
+// case the given default namespace is used. Malformed entries are skipped.
+func ParseConnectionReferences(value string, defaultNamespace string) []corev1.SecretReference {
+	refs := make([]corev1.SecretReference, 0)
+
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		ref := corev1.SecretReference{Namespace: defaultNamespace, Name: part}
+		if ns, name, found := strings.Cut(part, "/"); found {
+			ref.Namespace = strings.TrimSpace(ns)
+			ref.Name = strings.TrimSpace(name)
+		}
+
+		if ref.Namespace == "" || ref.Name == "" || strings.Contains(ref.Name, "/") {
+			continue
+		}
+
+		if !slices.Contains(refs, ref) {
+			refs = append(refs, ref)
+		}
+	}
+
+	return refs
+}
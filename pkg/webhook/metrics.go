@@ -0,0 +1,59 @@
+package webhookutils
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// AdmissionRequestsTotal is a prometheus counter metrics which holds the total
+	// number of admission requests handled by the operator webhooks. It has four labels.
+	// webhook label refers to the webhook name.
+	// kind label refers to the kind of the admitted object.
+	// mode label refers to the webhook mode (Enforce, Audit, Disabled).
+	// decision label refers to the decision of the webhook (admit, deny, patch, error, skip),
+	// before the mode is applied.
+	AdmissionRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "webhook_admission_requests_total",
+			Help: "Number of admission requests handled by webhook",
+		},
+		[]string{
+			"webhook",
+			"kind",
+			"mode",
+			"decision",
+		},
+	)
+
+	// AdmissionDurationSeconds is a prometheus histogram metrics which holds the latency
+	// of the admission requests handled by the operator webhooks. It has two labels.
+	// webhook label refers to the webhook name.
+	// kind label refers to the kind of the admitted object.
+	AdmissionDurationSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "webhook_admission_duration_seconds",
+			Help:    "Latency of admission requests handled by webhook",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{
+			"webhook",
+			"kind",
+		},
+	)
+)
+
+// init register metrics to the global registry from controller-runtime/pkg/metrics.
+// see https://book.kubebuilder.io/reference/metrics#publishing-additional-metrics
+//
+//nolint:gochecknoinits
+func init() {
+	metrics.Registry.MustRegister(AdmissionRequestsTotal, AdmissionDurationSeconds)
+}
+
+func observeAdmission(webhook string, kind string, mode Mode, decision string, start time.Time) {
+	AdmissionRequestsTotal.WithLabelValues(webhook, kind, string(mode), decision).Inc()
+	AdmissionDurationSeconds.WithLabelValues(webhook, kind).Observe(time.Since(start).Seconds())
+}
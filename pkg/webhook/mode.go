@@ -0,0 +1,238 @@
+package webhookutils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+// Mode defines how the decision of an admission webhook is applied.
+type Mode string
+
+const (
+	// ModeEnforce applies the webhook decision as is. This is the default.
+	ModeEnforce Mode = "Enforce"
+	// ModeAudit always allows the request and drops any patch, reporting what the webhook would have done
+	// as an audit annotation, a warning, an event and a metric.
+	ModeAudit Mode = "Audit"
+	// ModeDisabled allows the request without invoking the webhook.
+	ModeDisabled Mode = "Disabled"
+)
+
+const (
+	// ModeConfigMapName is the name of the ConfigMap, in the operator namespace, holding the mode of the
+	// webhooks keyed by webhook name, e.g. "kueue-validating: Audit". Webhooks without an entry use the
+	// mode set in the ModeConfigDefaultKey entry, or ModeEnforce if there is none.
+	ModeConfigMapName = "odh-webhook-config"
+	// ModeConfigDefaultKey is the ConfigMap entry setting the mode of webhooks without a dedicated entry.
+	ModeConfigDefaultKey = "default"
+)
+
+// Decisions reported in admission metrics.
+const (
+	DecisionAdmit = "admit"
+	DecisionDeny  = "deny"
+	DecisionPatch = "patch"
+	DecisionError = "error"
+	DecisionSkip  = "skip"
+)
+
+// ModeReader returns the current mode of the named webhook.
+type ModeReader func(ctx context.Context, name string) (Mode, error)
+
+// NewConfigMapModeReader returns a ModeReader looking up webhook modes in the ModeConfigMapName ConfigMap
+// of the given namespace on each call, so that modes can be changed at runtime. If the namespace is empty
+// or the ConfigMap does not exist, all webhooks are enforced.
+//
+// Parameters:
+//   - cli: The client used to read the ConfigMap, usually cache backed.
+//   - namespace: The namespace of the ConfigMap.
+//
+// Returns:
+//   - ModeReader: The resulting mode reader.
+func NewConfigMapModeReader(cli client.Reader, namespace string) ModeReader {
+	return func(ctx context.Context, name string) (Mode, error) {
+		if namespace == "" {
+			return ModeEnforce, nil
+		}
+
+		cm := corev1.ConfigMap{}
+		err := cli.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ModeConfigMapName}, &cm)
+		switch {
+		case k8serr.IsNotFound(err):
+			return ModeEnforce, nil
+		case err != nil:
+			return ModeEnforce, fmt.Errorf("failed to get webhook configuration %s/%s: %w", namespace, ModeConfigMapName, err)
+		}
+
+		value, ok := cm.Data[name]
+		if !ok {
+			value, ok = cm.Data[ModeConfigDefaultKey]
+		}
+		if !ok {
+			return ModeEnforce, nil
+		}
+
+		return ParseMode(value)
+	}
+}
+
+// ParseMode converts a case insensitive mode name to a Mode, and returns an error if the name is unknown.
+func ParseMode(value string) (Mode, error) {
+	for _, m := range []Mode{ModeEnforce, ModeAudit, ModeDisabled} {
+		if strings.EqualFold(strings.TrimSpace(value), string(m)) {
+			return m, nil
+		}
+	}
+
+	return ModeEnforce, fmt.Errorf("unknown webhook mode %q, must be one of %s, %s, %s", value, ModeEnforce, ModeAudit, ModeDisabled)
+}
+
+// NewAdmission returns the webhook.Admission to register for the given handler. The handler is wrapped by an
+// ObservedHandler, so that it honours the webhook mode configured in the operator namespace and reports
+// admission metrics.
+//
+// Parameters:
+//   - mgr: The controller-runtime manager the webhook is registered with.
+//   - name: The name of the webhook, used for logging, mode lookup, events and metrics.
+//   - handler: The admission handler implementing the webhook logic.
+//
+// Returns:
+//   - *webhook.Admission: The admission webhook to register with the webhook server.
+func NewAdmission(mgr ctrl.Manager, name string, handler admission.Handler) *webhook.Admission {
+	// The operator namespace is unknown when running outside of the operator (e.g. envtest),
+	// in which case webhooks are always enforced.
+	namespace, _ := cluster.GetOperatorNamespace()
+
+	return &webhook.Admission{
+		Handler: &ObservedHandler{
+			Name:     name,
+			Handler:  handler,
+			Mode:     NewConfigMapModeReader(mgr.GetClient(), namespace),
+			Recorder: mgr.GetEventRecorderFor(name),
+		},
+		LogConstructor: NewWebhookLogConstructor(name),
+	}
+}
+
+// ObservedHandler wraps an admission handler to apply the configured webhook mode and to record
+// admission metrics by webhook, kind and decision.
+type ObservedHandler struct {
+	Name     string
+	Handler  admission.Handler
+	Mode     ModeReader
+	Recorder record.EventRecorder
+}
+
+// Assert that ObservedHandler implements admission.Handler interface.
+var _ admission.Handler = &ObservedHandler{}
+
+// Handle looks up the webhook mode, invokes the wrapped handler unless the webhook is disabled and, in
+// audit mode, turns any denial, error or patch into an allowed response reporting the original decision.
+// If the mode can not be determined, the webhook is enforced.
+//
+// Parameters:
+//   - ctx: Context for the admission request (logger is extracted from here).
+//   - req: The admission.Request containing the operation and object details.
+//
+// Returns:
+//   - admission.Response: The response of the wrapped handler, altered according to the webhook mode.
+func (h *ObservedHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	log := logf.FromContext(ctx)
+	start := time.Now()
+
+	mode := ModeEnforce
+	if h.Mode != nil {
+		m, err := h.Mode(ctx, h.Name)
+		if err != nil {
+			log.Error(err, "failed to read webhook mode, enforcing", "webhook", h.Name)
+		}
+		mode = m
+	}
+
+	if mode == ModeDisabled {
+		observeAdmission(h.Name, req.Kind.Kind, mode, DecisionSkip, start)
+		return admission.Allowed(fmt.Sprintf("Webhook %s is disabled", h.Name))
+	}
+
+	resp := h.Handler.Handle(ctx, req)
+	decision := admissionDecision(resp)
+	observeAdmission(h.Name, req.Kind.Kind, mode, decision, start)
+
+	if mode != ModeAudit || decision == DecisionAdmit {
+		return resp
+	}
+
+	return h.audit(ctx, &req, resp, decision)
+}
+
+// audit builds the allowed response returned in audit mode for a request the wrapped handler did not
+// plainly admit, and emits a warning event on the object.
+func (h *ObservedHandler) audit(ctx context.Context, req *admission.Request, resp admission.Response, decision string) admission.Response {
+	log := logf.FromContext(ctx)
+
+	message := ""
+	if resp.Result != nil {
+		message = resp.Result.Message
+	}
+
+	note := fmt.Sprintf("webhook %s is in %s mode, request would have resulted in %s", h.Name, ModeAudit, decision)
+	if message != "" {
+		note = fmt.Sprintf("%s: %s", note, message)
+	}
+
+	log.Info("audit mode, allowing request", "decision", decision, "message", message)
+
+	if h.Recorder != nil && req.Name != "" {
+		h.Recorder.Event(
+			&corev1.ObjectReference{
+				APIVersion: schema.GroupVersion{Group: req.Kind.Group, Version: req.Kind.Version}.String(),
+				Kind:       req.Kind.Kind,
+				Namespace:  req.Namespace,
+				Name:       req.Name,
+			},
+			corev1.EventTypeWarning,
+			"WebhookAudit",
+			note,
+		)
+	}
+
+	audited := admission.Allowed(note).WithWarnings(note)
+	audited.AuditAnnotations = map[string]string{
+		"audit-decision": decision,
+	}
+	if message != "" {
+		audited.AuditAnnotations["audit-message"] = message
+	}
+
+	return audited
+}
+
+// admissionDecision classifies an admission response for metrics and audit reporting.
+func admissionDecision(resp admission.Response) string {
+	switch {
+	case !resp.Allowed && resp.Result != nil && resp.Result.Code == http.StatusForbidden:
+		return DecisionDeny
+	case !resp.Allowed:
+		return DecisionError
+	case len(resp.Patches) > 0 || len(resp.Patch) > 0:
+		return DecisionPatch
+	default:
+		return DecisionAdmit
+	}
+}
@@ -0,0 +1,256 @@
+package webhookutils_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+	webhookutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/webhook"
+
+	. "github.com/onsi/gomega"
+)
+
+const modeTestNamespace = "operator-ns"
+
+func newModeConfigMap(data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      webhookutils.ModeConfigMapName,
+			Namespace: modeTestNamespace,
+		},
+		Data: data,
+	}
+}
+
+func newModeRequest() admission.Request {
+	return admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Kind:      metav1.GroupVersionKind{Group: "kubeflow.org", Version: "v1", Kind: "Notebook"},
+			Namespace: "test-ns",
+			Name:      "test-notebook",
+		},
+	}
+}
+
+func TestConfigMapModeReader(t *testing.T) {
+	t.Parallel()
+
+	sch, err := scheme.New()
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+
+	cases := []struct {
+		name         string
+		namespace    string
+		existingObjs []client.Object
+		expectedMode webhookutils.Mode
+		expectError  bool
+	}{
+		{
+			name:         "No namespace",
+			namespace:    "",
+			existingObjs: []client.Object{newModeConfigMap(map[string]string{"test-webhook": "Disabled"})},
+			expectedMode: webhookutils.ModeEnforce,
+		},
+		{
+			name:         "No ConfigMap",
+			namespace:    modeTestNamespace,
+			expectedMode: webhookutils.ModeEnforce,
+		},
+		{
+			name:         "Webhook entry",
+			namespace:    modeTestNamespace,
+			existingObjs: []client.Object{newModeConfigMap(map[string]string{"test-webhook": "audit", webhookutils.ModeConfigDefaultKey: "Disabled"})},
+			expectedMode: webhookutils.ModeAudit,
+		},
+		{
+			name:         "Default entry",
+			namespace:    modeTestNamespace,
+			existingObjs: []client.Object{newModeConfigMap(map[string]string{"other-webhook": "Audit", webhookutils.ModeConfigDefaultKey: "Disabled"})},
+			expectedMode: webhookutils.ModeDisabled,
+		},
+		{
+			name:         "No matching entry",
+			namespace:    modeTestNamespace,
+			existingObjs: []client.Object{newModeConfigMap(map[string]string{"other-webhook": "Audit"})},
+			expectedMode: webhookutils.ModeEnforce,
+		},
+		{
+			name:         "Unknown mode",
+			namespace:    modeTestNamespace,
+			existingObjs: []client.Object{newModeConfigMap(map[string]string{"test-webhook": "DryRun"})},
+			expectedMode: webhookutils.ModeEnforce,
+			expectError:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(tc.existingObjs...).Build()
+			mode, err := webhookutils.NewConfigMapModeReader(cli, tc.namespace)(t.Context(), "test-webhook")
+
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(mode).To(Equal(tc.expectedMode))
+		})
+	}
+}
+
+func TestObservedHandler(t *testing.T) {
+	t.Parallel()
+
+	denied := admission.HandlerFunc(func(_ context.Context, _ admission.Request) admission.Response {
+		return admission.Denied("not allowed")
+	})
+	errored := admission.HandlerFunc(func(_ context.Context, _ admission.Request) admission.Response {
+		return admission.Errored(http.StatusInternalServerError, errors.New("boom"))
+	})
+	patched := admission.HandlerFunc(func(_ context.Context, _ admission.Request) admission.Response {
+		return admission.PatchResponseFromRaw([]byte(`{}`), []byte(`{"metadata":{"labels":{"a":"b"}}}`))
+	})
+	allowed := admission.HandlerFunc(func(_ context.Context, _ admission.Request) admission.Response {
+		return admission.Allowed("")
+	})
+
+	modeOf := func(mode webhookutils.Mode) webhookutils.ModeReader {
+		return func(_ context.Context, _ string) (webhookutils.Mode, error) {
+			return mode, nil
+		}
+	}
+
+	cases := []struct {
+		name             string
+		webhook          string
+		handler          admission.Handler
+		mode             webhookutils.ModeReader
+		expectAllowed    bool
+		expectPatches    bool
+		expectEvent      bool
+		expectedDecision string
+	}{
+		{
+			name:             "Enforce deny",
+			webhook:          "test-enforce-deny",
+			handler:          denied,
+			mode:             modeOf(webhookutils.ModeEnforce),
+			expectAllowed:    false,
+			expectedDecision: webhookutils.DecisionDeny,
+		},
+		{
+			name:             "Enforce patch",
+			webhook:          "test-enforce-patch",
+			handler:          patched,
+			mode:             modeOf(webhookutils.ModeEnforce),
+			expectAllowed:    true,
+			expectPatches:    true,
+			expectedDecision: webhookutils.DecisionPatch,
+		},
+		{
+			name:             "Audit deny",
+			webhook:          "test-audit-deny",
+			handler:          denied,
+			mode:             modeOf(webhookutils.ModeAudit),
+			expectAllowed:    true,
+			expectEvent:      true,
+			expectedDecision: webhookutils.DecisionDeny,
+		},
+		{
+			name:             "Audit error",
+			webhook:          "test-audit-error",
+			handler:          errored,
+			mode:             modeOf(webhookutils.ModeAudit),
+			expectAllowed:    true,
+			expectEvent:      true,
+			expectedDecision: webhookutils.DecisionError,
+		},
+		{
+			name:             "Audit patch",
+			webhook:          "test-audit-patch",
+			handler:          patched,
+			mode:             modeOf(webhookutils.ModeAudit),
+			expectAllowed:    true,
+			expectEvent:      true,
+			expectedDecision: webhookutils.DecisionPatch,
+		},
+		{
+			name:             "Audit admit",
+			webhook:          "test-audit-admit",
+			handler:          allowed,
+			mode:             modeOf(webhookutils.ModeAudit),
+			expectAllowed:    true,
+			expectedDecision: webhookutils.DecisionAdmit,
+		},
+		{
+			name:             "Disabled",
+			webhook:          "test-disabled",
+			handler:          denied,
+			mode:             modeOf(webhookutils.ModeDisabled),
+			expectAllowed:    true,
+			expectedDecision: webhookutils.DecisionSkip,
+		},
+		{
+			name:    "Mode error enforces",
+			webhook: "test-mode-error",
+			handler: denied,
+			mode: func(_ context.Context, _ string) (webhookutils.Mode, error) {
+				return webhookutils.ModeEnforce, errors.New("unavailable")
+			},
+			expectAllowed:    false,
+			expectedDecision: webhookutils.DecisionDeny,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			recorder := record.NewFakeRecorder(10)
+			h := &webhookutils.ObservedHandler{
+				Name:     tc.webhook,
+				Handler:  tc.handler,
+				Mode:     tc.mode,
+				Recorder: recorder,
+			}
+
+			resp := h.Handle(t.Context(), newModeRequest())
+
+			g.Expect(resp.Allowed).To(Equal(tc.expectAllowed))
+			if tc.expectPatches {
+				g.Expect(resp.Patches).ToNot(BeEmpty())
+			} else {
+				g.Expect(resp.Patches).To(BeEmpty())
+			}
+
+			if tc.expectEvent {
+				g.Expect(recorder.Events).To(Receive(ContainSubstring("WebhookAudit")))
+				g.Expect(resp.Warnings).ToNot(BeEmpty())
+				g.Expect(resp.AuditAnnotations).To(HaveKeyWithValue("audit-decision", tc.expectedDecision))
+			} else {
+				g.Expect(recorder.Events).To(BeEmpty())
+			}
+
+			mode, _ := tc.mode(t.Context(), tc.webhook)
+			g.Expect(testutil.ToFloat64(
+				webhookutils.AdmissionRequestsTotal.WithLabelValues(tc.webhook, "Notebook", string(mode), tc.expectedDecision),
+			)).To(Equal(float64(1)))
+		})
+	}
+}
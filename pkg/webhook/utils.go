@@ -7,6 +7,7 @@ import (
 	"slices"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -16,7 +17,6 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
-	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
@@ -147,6 +147,8 @@ func DecodeUnstructured(decoder admission.Decoder, req admission.Request) (*unst
 // If the annotation exists and has a non-empty value, it validates that the value references
 // a valid secret in the same namespace. Additionally, it checks the secret's connection type
 // annotation and rejects requests with invalid configurations. (see allowedTypes)
+// For allowed connection types, the secret must also contain the keys required by the type
+// (see ConnectionSchemas), otherwise the request is denied with the list of missing keys.
 // If the annotation doesn't exist or is empty, it allows the operation.
 //
 // Parameters:
@@ -181,9 +183,9 @@ func ValidateInferenceServiceConnectionAnnotation(ctx context.Context,
 		}
 	}
 
-	// Get the secret's metadata only (PartialObjectMetadata) to check annotations
-	secretMeta := resources.GvkToPartial(gvk.Secret)
-	if err := cli.Get(ctx, types.NamespacedName{Name: annotationValue, Namespace: req.Namespace}, secretMeta); err != nil {
+	// Get the whole secret, as its data is needed for schema validation
+	secret := &corev1.Secret{}
+	if err := cli.Get(ctx, types.NamespacedName{Name: annotationValue, Namespace: req.Namespace}, secret); err != nil {
 		if k8serr.IsNotFound(err) {
 			return admission.Denied(fmt.Sprintf("Secret '%s' referenced in annotation '%s' not found in namespace '%s'",
 				annotationValue, annotations.Connection, req.Namespace)), ConnectionActionNone, "", ""
@@ -193,7 +195,7 @@ func ValidateInferenceServiceConnectionAnnotation(ctx context.Context,
 	}
 
 	// Additional validation: check the secret's connections-type-ref annotation exists and has a non-empty value
-	connectionType := resources.GetAnnotation(secretMeta, annotations.ConnectionTypeRef)
+	connectionType := resources.GetAnnotation(secret, annotations.ConnectionTypeRef)
 	if connectionType == "" {
 		return admission.Allowed(fmt.Sprintf("Secret '%s' does not have '%s' annotation", annotationValue, annotations.ConnectionTypeRef)), ConnectionActionNone, "", ""
 	}
@@ -208,8 +210,13 @@ func ValidateInferenceServiceConnectionAnnotation(ctx context.Context,
 			annotations.Connection, annotationValue, connectionType, req.Namespace)), ConnectionActionNone, "", ""
 	}
 
+	// Validate that the secret carries every key required by its connection type
+	if err := ValidateConnectionSecretSchema(secret); err != nil {
+		return admission.Denied(err.Error()), ConnectionActionNone, "", ""
+	}
+
 	// Allow the operation and indicate that injection should be performed
-	return admission.Allowed("Connection annotation validation passed"), ConnectionActionInject, secretMeta.Name, connectionType
+	return admission.Allowed("Connection annotation validation passed"), ConnectionActionInject, secret.Name, connectionType
 }
 
 // GetOrCreateNestedMap safely retrieves or creates a nested map within an unstructured object.
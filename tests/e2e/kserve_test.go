@@ -505,8 +505,9 @@ func (tc *KserveTestCtx) createConnectionSecret(secretName, namespace string) {
 				return err
 			}
 
+			// OCI connections must carry a docker config, base64 of {"auths":{}}
 			return unstructured.SetNestedStringMap(obj.Object, map[string]string{
-				"credential": "mysecretjson",
+				corev1.DockerConfigJsonKey: "eyJhdXRocyI6e319",
 			}, "data")
 		}),
 		WithCustomErrorMsg("Failed to create connection secret"),
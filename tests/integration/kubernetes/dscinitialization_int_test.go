@@ -0,0 +1,72 @@
+package kubernetes_test
+
+import (
+	"context"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	dsciName             = "default-dsci"
+	applicationNamespace = "opendatahub"
+)
+
+var _ = Describe("Kubernetes platform", func() {
+	It("should be detected when the OpenShift APIs are not served", func() {
+		Expect(cluster.IsKubernetes()).To(BeTrue())
+		Expect(cluster.GetRelease().Name).To(Equal(cluster.Kubernetes))
+		Expect(cluster.GetClusterInfo().Type).To(Equal(cluster.ClusterTypeKubernetes))
+		Expect(cluster.GetClusterInfo().ID).ToNot(BeEmpty())
+	})
+
+	Context("DSCInitialization", func() {
+		BeforeEach(func(ctx context.Context) {
+			dsci := &dsciv1.DSCInitialization{
+				ObjectMeta: metav1.ObjectMeta{Name: dsciName},
+				Spec: dsciv1.DSCInitializationSpec{
+					ApplicationsNamespace: applicationNamespace,
+					Monitoring: serviceApi.DSCIMonitoring{
+						ManagementSpec: common.ManagementSpec{ManagementState: operatorv1.Removed},
+					},
+					TrustedCABundle: &dsciv1.TrustedCABundleSpec{
+						ManagementState: operatorv1.Managed,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, dsci)).To(Succeed())
+
+			DeferCleanup(func(ctx context.Context) {
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, dsci))).To(Succeed())
+			})
+		})
+
+		It("should be reconciled without the OpenShift APIs", func(ctx context.Context) {
+			dsci := &dsciv1.DSCInitialization{}
+			Eventually(func(g Gomega, ctx context.Context) {
+				g.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: dsciName}, dsci)).To(Succeed())
+				g.Expect(dsci.Status.Phase).To(Equal(status.PhaseReady))
+			}).
+				WithContext(ctx).
+				WithTimeout(timeout).
+				WithPolling(interval).
+				Should(Succeed())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Name: applicationNamespace}, &corev1.Namespace{})).To(Succeed())
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Name: applicationNamespace, Namespace: applicationNamespace}, &networkingv1.NetworkPolicy{})).To(Succeed())
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Name: serviceApi.AuthInstanceName}, &serviceApi.Auth{})).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,124 @@
+package kubernetes_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/tests/envtestutil"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// This suite runs the operator against an API server serving only the operator CRDs, as on upstream Kubernetes:
+// neither the OpenShift CRDs nor the OpenShift types are registered.
+
+var (
+	k8sClient client.Client
+	testEnv   *envtest.Environment
+	gCtx      context.Context
+	gCancel   context.CancelFunc
+)
+
+const (
+	operatorNamespace = "opendatahub-operator-system"
+	timeout           = 30 * time.Second
+	interval          = 250 * time.Millisecond
+)
+
+var testScheme = runtime.NewScheme()
+
+func TestKubernetesPlatform(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	RunSpecs(t, "Kubernetes Platform Suite")
+}
+
+var _ = BeforeSuite(func() {
+	// can't use suite's context as the manager should survive the function
+	//nolint:fatcontext
+	gCtx, gCancel = context.WithCancel(context.Background())
+
+	logf.SetLogger(zap.New(zap.WriteTo(GinkgoWriter), zap.UseDevMode(true)))
+
+	By("bootstrapping test environment without the OpenShift CRDs")
+	rootPath, pathErr := envtestutil.FindProjectRoot()
+	Expect(pathErr).ToNot(HaveOccurred(), pathErr)
+
+	utilruntime.Must(clientgoscheme.AddToScheme(testScheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(testScheme))
+	utilruntime.Must(dsciv1.AddToScheme(testScheme))
+	utilruntime.Must(dscv1.AddToScheme(testScheme))
+	utilruntime.Must(serviceApi.AddToScheme(testScheme))
+
+	testEnv = &envtest.Environment{
+		CRDInstallOptions: envtest.CRDInstallOptions{
+			Scheme: testScheme,
+			Paths: []string{
+				filepath.Join(rootPath, "config", "crd", "bases"),
+			},
+			ErrorIfPathMissing: true,
+			CleanUpAfterUse:    false,
+		},
+	}
+
+	cfg, err := testEnv.Start()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(cfg).NotTo(BeNil())
+
+	k8sClient, err = client.New(cfg, client.Options{Scheme: testScheme})
+	Expect(err).NotTo(HaveOccurred())
+
+	By("detecting the platform")
+	GinkgoT().Setenv("OPERATOR_NAMESPACE", operatorNamespace)
+	GinkgoT().Setenv("ODH_PLATFORM_TYPE", "")
+
+	// the controllers are set up for the detected platform, so it must be detected before
+	Expect(cluster.Init(gCtx, k8sClient)).To(Succeed())
+
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+		Scheme:         testScheme,
+		LeaderElection: false,
+		Metrics: ctrlmetrics.Options{
+			BindAddress: "0",
+		},
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	err = (&dscictrl.DSCInitializationReconciler{
+		Client:   k8sClient,
+		Scheme:   testScheme,
+		Recorder: mgr.GetEventRecorderFor("dscinitialization-controller"),
+	}).SetupWithManager(gCtx, mgr)
+	Expect(err).ToNot(HaveOccurred())
+
+	go func() {
+		defer GinkgoRecover()
+		err := mgr.Start(gCtx)
+		Expect(err).ToNot(HaveOccurred(), "Failed to run manager")
+	}()
+})
+
+var _ = AfterSuite(func() {
+	gCancel()
+	By("tearing down the test environment")
+	Expect(testEnv.Stop()).To(Succeed())
+})